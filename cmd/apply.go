@@ -81,4 +81,7 @@ func init() {
 	addValidateSchemaFlag(applyCmd, &validateSchema)
 	addExportReportToYAMLFlag(applyCmd, &exportReportToYAML)
 	addDisableUdashReportFlag(applyCmd, &disableUdashReport)
+	addParallelismFlag(applyCmd, &parallelism)
+	addResourceParallelismFlag(applyCmd, &resourceParallelism)
+	addHTTPCacheDirFlag(applyCmd, &httpCacheDir)
 }
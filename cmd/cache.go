@@ -0,0 +1,10 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var (
+	cacheCmd = &cobra.Command{
+		Use:   "cache",
+		Short: "cache manages data persisted by Updatecli across executions.",
+	}
+)
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	cachePruneOlderThan time.Duration
+
+	cachePruneCmd = &cobra.Command{
+		Use:     "prune",
+		Short:   "prune removes entries from the persistent HTTP cache",
+		Example: "updatecli cache prune --http-cache-dir ~/.cache/updatecli/http --older-than 168h",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := run("cache/prune")
+			if err != nil {
+				logrus.Errorf("command failed: %s", err)
+				os.Exit(1)
+			}
+		},
+	}
+)
+
+func init() {
+	addHTTPCacheDirFlag(cachePruneCmd, &httpCacheDir)
+	cachePruneCmd.Flags().DurationVar(&cachePruneOlderThan, "older-than", 0, "Only remove entries not refreshed for this duration like '--older-than=72h', by default every entry is removed")
+
+	cacheCmd.AddCommand(cachePruneCmd)
+}
@@ -84,6 +84,9 @@ func init() {
 	addValidateSchemaFlag(composeApplyCmd, &validateSchema)
 	addExportReportToYAMLFlag(composeApplyCmd, &exportReportToYAML)
 	addDisableUdashReportFlag(composeApplyCmd, &disableUdashReport)
+	addParallelismFlag(composeApplyCmd, &parallelism)
+	addResourceParallelismFlag(composeApplyCmd, &resourceParallelism)
+	addHTTPCacheDirFlag(composeApplyCmd, &httpCacheDir)
 
 	composeCmd.AddCommand(composeApplyCmd)
 }
@@ -73,6 +73,9 @@ func init() {
 	addValidateSchemaFlag(composeDiffCmd, &validateSchema)
 	addExportReportToYAMLFlag(composeDiffCmd, &exportReportToYAML)
 	addDisableUdashReportFlag(composeDiffCmd, &disableUdashReport)
+	addParallelismFlag(composeDiffCmd, &parallelism)
+	addResourceParallelismFlag(composeDiffCmd, &resourceParallelism)
+	addHTTPCacheDirFlag(composeDiffCmd, &httpCacheDir)
 
 	composeCmd.AddCommand(composeDiffCmd)
 }
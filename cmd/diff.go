@@ -64,4 +64,7 @@ func init() {
 	addValidateSchemaFlag(diffCmd, &validateSchema)
 	addExportReportToYAMLFlag(diffCmd, &exportReportToYAML)
 	addDisableUdashReportFlag(diffCmd, &disableUdashReport)
+	addParallelismFlag(diffCmd, &parallelism)
+	addResourceParallelismFlag(diffCmd, &resourceParallelism)
+	addHTTPCacheDirFlag(diffCmd, &httpCacheDir)
 }
@@ -14,6 +14,12 @@ const DisableVersionCheckEnvVar = "UPDATECLI_DISABLE_VERSION_CHECK"
 
 const ValidateSchemaEnvVar = "UPDATECLI_VALIDATE_SCHEMA"
 
+const ParallelismEnvVar = "UPDATECLI_PARALLELISM"
+
+const ResourceParallelismEnvVar = "UPDATECLI_RESOURCE_PARALLELISM"
+
+const HTTPCacheDirEnvVar = "UPDATECLI_HTTP_CACHE_DIR"
+
 // getEnvBoolOrDefault reads a boolean environment variable.
 // It returns defaultValue when the variable is unset or invalid.
 func getEnvBoolOrDefault(envVar string, defaultValue bool) bool {
@@ -35,3 +41,25 @@ func getEnvBoolOrDefault(envVar string, defaultValue bool) bool {
 
 	return parsed
 }
+
+// getEnvIntOrDefault reads an integer environment variable.
+// It returns defaultValue when the variable is unset or invalid.
+func getEnvIntOrDefault(envVar string, defaultValue int) int {
+	value, ok := os.LookupEnv(envVar)
+	if !ok {
+		return defaultValue
+	}
+
+	parsed, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		logrus.Debugf(
+			"invalid integer value for environment variable %q: %q, defaulting to %d",
+			envVar,
+			value,
+			defaultValue,
+		)
+		return defaultValue
+	}
+
+	return parsed
+}
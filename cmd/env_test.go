@@ -97,3 +97,59 @@ func TestGetEnvBoolOrDefault(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEnvIntOrDefault(t *testing.T) {
+	tests := []struct {
+		name         string
+		envVar       string
+		envValue     string
+		setEnv       bool
+		defaultValue int
+		expected     int
+	}{
+		{
+			name:         "not_set_returns_default",
+			envVar:       "UNDEFINED_INT_VAR",
+			defaultValue: 1,
+			expected:     1,
+		},
+		{
+			name:         "set_to_8",
+			envVar:       "TEST_INT_VAR_8",
+			envValue:     "8",
+			setEnv:       true,
+			defaultValue: 1,
+			expected:     8,
+		},
+		{
+			name:         "whitespace_trimmed",
+			envVar:       "TEST_INT_VAR_SPACE",
+			envValue:     " 4 ",
+			setEnv:       true,
+			defaultValue: 1,
+			expected:     4,
+		},
+		{
+			name:         "invalid_returns_default",
+			envVar:       "TEST_INT_VAR_INVALID",
+			envValue:     "many",
+			setEnv:       true,
+			defaultValue: 1,
+			expected:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setEnv {
+				t.Setenv(tt.envVar, tt.envValue)
+			}
+
+			result := getEnvIntOrDefault(tt.envVar, tt.defaultValue)
+
+			if result != tt.expected {
+				t.Errorf("got %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package cmd
 
-import "github.com/spf13/cobra"
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
 
 // addDisableChangelogFlag registers the shared --disable-changelog flag on the
 // provided command, using the value from UPDATECLI_DISABLE_CHANGELOG as the
@@ -48,3 +52,40 @@ func addDisableUdashReportFlag(cmd *cobra.Command, dest *bool) {
 		"Disable publishing pipeline reports to Udash",
 	)
 }
+
+// addParallelismFlag registers the shared --parallelism flag on the provided
+// command, using the value from UPDATECLI_PARALLELISM as the default when the
+// flag is not explicitly passed.
+func addParallelismFlag(cmd *cobra.Command, dest *int) {
+	cmd.Flags().IntVar(
+		dest,
+		"parallelism",
+		getEnvIntOrDefault(ParallelismEnvVar, 1),
+		"Maximum number of pipelines executed concurrently (env: "+ParallelismEnvVar+")",
+	)
+}
+
+// addResourceParallelismFlag registers the shared --resource-parallelism flag on the
+// provided command, using the value from UPDATECLI_RESOURCE_PARALLELISM as the default
+// when the flag is not explicitly passed. It's distinct from --parallelism as both limits
+// multiply, each pipeline running its own resources concurrently.
+func addResourceParallelismFlag(cmd *cobra.Command, dest *int) {
+	cmd.Flags().IntVar(
+		dest,
+		"resource-parallelism",
+		getEnvIntOrDefault(ResourceParallelismEnvVar, 1),
+		"Maximum number of independent resources executed concurrently within each pipeline. Work on a same git repository is still done one at a time (env: "+ResourceParallelismEnvVar+")",
+	)
+}
+
+// addHTTPCacheDirFlag registers the shared --http-cache-dir flag on the provided
+// command, using the value from UPDATECLI_HTTP_CACHE_DIR as the default when the
+// flag is not explicitly passed. The persistent HTTP cache is opt-in.
+func addHTTPCacheDirFlag(cmd *cobra.Command, dest *string) {
+	cmd.Flags().StringVar(
+		dest,
+		"http-cache-dir",
+		os.Getenv(HTTPCacheDirEnvVar),
+		"Persist HTTP responses in this directory to reuse them across executions (env: "+HTTPCacheDirEnvVar+")",
+	)
+}
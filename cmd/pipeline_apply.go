@@ -73,6 +73,9 @@ func init() {
 	addValidateSchemaFlag(pipelineApplyCmd, &validateSchema)
 	addExportReportToYAMLFlag(pipelineApplyCmd, &exportReportToYAML)
 	addDisableUdashReportFlag(pipelineApplyCmd, &disableUdashReport)
+	addParallelismFlag(pipelineApplyCmd, &parallelism)
+	addResourceParallelismFlag(pipelineApplyCmd, &resourceParallelism)
+	addHTTPCacheDirFlag(pipelineApplyCmd, &httpCacheDir)
 
 	pipelineCmd.AddCommand(pipelineApplyCmd)
 }
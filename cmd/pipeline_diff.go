@@ -60,6 +60,9 @@ func init() {
 	addValidateSchemaFlag(pipelineDiffCmd, &validateSchema)
 	addExportReportToYAMLFlag(pipelineDiffCmd, &exportReportToYAML)
 	addDisableUdashReportFlag(pipelineDiffCmd, &disableUdashReport)
+	addParallelismFlag(pipelineDiffCmd, &parallelism)
+	addResourceParallelismFlag(pipelineDiffCmd, &resourceParallelism)
+	addHTTPCacheDirFlag(pipelineDiffCmd, &httpCacheDir)
 
 	pipelineCmd.AddCommand(pipelineDiffCmd)
 }
@@ -12,6 +12,7 @@ import (
 	"golang.org/x/exp/slices"
 
 	"github.com/updatecli/updatecli/pkg/core/cmdoptions"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
 	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/registry"
 	"github.com/updatecli/updatecli/pkg/core/telemetry"
@@ -44,6 +45,9 @@ var (
 	disableVersionCheck bool
 	exportReportToYAML  bool
 	disableUdashReport  bool
+	parallelism         int
+	resourceParallelism int
+	httpCacheDir        string
 
 	rootCmd = &cobra.Command{
 		Use:   "updatecli",
@@ -133,6 +137,7 @@ func init() {
 		prepareCmd,
 		manifestCmd,
 		pipelineCmd,
+		cacheCmd,
 		udashCmd,
 		showCmd,
 		composeCmd,
@@ -173,6 +178,9 @@ func run(command string) error {
 
 	e.Options.ExportToYAML = exportReportToYAML
 	e.Options.DisableUdashReport = disableUdashReport
+	e.Options.Parallelism = parallelism
+	e.Options.Pipeline.Parallelism = resourceParallelism
+	e.Options.HTTPCacheDir = httpCacheDir
 
 	switch command {
 	case "apply", "compose/apply", "pipeline/apply":
@@ -301,6 +309,15 @@ func run(command string) error {
 			return err
 		}
 
+	case "cache/prune":
+		removed, err := httpclient.PruneHTTPCache(httpCacheDir, cachePruneOlderThan)
+		if err != nil {
+			logrus.Errorf("%s %s", result.FAILURE, err)
+			return err
+		}
+
+		logrus.Infof("%d HTTP cache entries removed from %q", removed, httpCacheDir)
+
 	case "udash/config":
 		configFilePath, err := udash.ConfigFilePath()
 		if err != nil {
@@ -8,6 +8,8 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"golang.org/x/sync/singleflight"
 )
 
 // SourceEntry stores the cached result of a source execution.
@@ -25,13 +27,12 @@ type SourceEntry struct {
 // The cache lives for the duration of one updatecli execution and is shared
 // across all pipelines, allowing identical sources to be executed only once.
 //
-// NOTE: today pipelines run sequentially and DAG nodes are serialized by a
-// mutex, so concurrent cache misses for the same key cannot happen. If pipeline
-// execution is ever parallelized, consider adding a singleflight.Group to
-// coalesce concurrent lookups for the same key.
+// Pipelines may run concurrently, so Resolve coalesces concurrent cache misses
+// for the same key into a single source execution.
 type SourceCache struct {
 	mu      sync.RWMutex
 	entries map[string]SourceEntry
+	group   singleflight.Group
 }
 
 // NewSourceCache creates a new empty source cache.
@@ -107,6 +108,42 @@ func (c *SourceCache) Set(key string, entry SourceEntry) {
 	c.entries[key] = entry
 }
 
+// Resolve returns the cached entry for key, or calls fn to compute it.
+// Concurrent callers asking for the same key while fn is running wait for that
+// single execution and share its outcome instead of executing fn again.
+// Only successful entries are stored. The returned boolean reports whether the
+// entry was produced by another caller, either from the cache or from an
+// in-flight execution.
+func (c *SourceCache) Resolve(key string, fn func() (SourceEntry, error)) (SourceEntry, bool, error) {
+	if key == "" {
+		entry, err := fn()
+		return entry, false, err
+	}
+
+	if entry, ok := c.Get(key); ok {
+		return entry, true, nil
+	}
+
+	executed := false
+	v, err, _ := c.group.Do(key, func() (any, error) {
+		// The entry may have been stored between the Get above and this call.
+		if entry, ok := c.Get(key); ok {
+			return entry, nil
+		}
+
+		executed = true
+		entry, err := fn()
+		if err == nil && entry.Result == result.SUCCESS {
+			c.Set(key, entry)
+		}
+		return entry, err
+	})
+
+	entry, _ := v.(SourceEntry)
+
+	return entry, !executed, err
+}
+
 // Len returns the number of entries currently held in the cache.
 func (c *SourceCache) Len() int {
 	c.mu.RLock()
@@ -1,12 +1,16 @@
 package cache
 
 import (
+	"errors"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
+	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/shell"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/shell/success/exitcode"
 )
@@ -153,6 +157,80 @@ func TestSourceCache_ConcurrentAccess(t *testing.T) {
 	wg.Wait()
 }
 
+// TestSourceCache_ResolveCoalescesConcurrentMisses verifies that concurrent
+// callers resolving the same key only execute the source once.
+func TestSourceCache_ResolveCoalescesConcurrentMisses(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	c := NewSourceCache()
+	const workers = 20
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	fn := func() (SourceEntry, error) {
+		calls.Add(1)
+		<-release
+		return SourceEntry{Information: "v1.2.3", Result: result.SUCCESS}, nil
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	results := make([]SourceEntry, workers)
+
+	// Act
+	for i := range workers {
+		go func(i int) {
+			defer wg.Done()
+			entry, _, err := c.Resolve("shared-key", fn)
+			assert.NoError(t, err)
+			results[i] = entry
+		}(i)
+	}
+
+	// Give every worker a chance to join the in-flight call before releasing it.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// Assert
+	assert.Equal(t, int32(1), calls.Load())
+	for _, entry := range results {
+		assert.Equal(t, "v1.2.3", entry.Information)
+	}
+	assert.Equal(t, 1, c.Len())
+}
+
+// TestSourceCache_ResolveHitAndFailure verifies that Resolve reports cache hits
+// and does not store failed executions.
+func TestSourceCache_ResolveHitAndFailure(t *testing.T) {
+	// Arrange
+	c := NewSourceCache()
+	c.Set("cached", SourceEntry{Information: "cached-value", Result: result.SUCCESS})
+
+	// Act
+	got, shared, err := c.Resolve("cached", func() (SourceEntry, error) {
+		t.Fatal("fn must not be called on cache hit")
+		return SourceEntry{}, nil
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, shared)
+	assert.Equal(t, "cached-value", got.Information)
+
+	// Act: a failing execution is returned but not cached
+	_, shared, err = c.Resolve("failing", func() (SourceEntry, error) {
+		return SourceEntry{Result: result.FAILURE}, errors.New("boom")
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.False(t, shared)
+	_, ok := c.Get("failing")
+	assert.False(t, ok)
+}
+
 // TestKey_EmptyKind verifies that Key returns an empty string when the
 // ResourceConfig has no Kind. GetReportConfig cannot resolve an unknown plugin,
 // so Key returns the empty-string sentinel that callers treat as a cache miss.
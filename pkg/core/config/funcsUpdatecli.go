@@ -7,6 +7,7 @@ import (
 	"strings"
 	"text/template"
 
+	"github.com/go-viper/mapstructure/v2"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
@@ -29,6 +30,9 @@ func updatecliFuncMap() template.FuncMap {
 		"source": func(s string) (string, error) {
 			return fmt.Sprintf(`{{ source %q }}`, s), nil
 		},
+		"scmBranch": func(s string) (string, error) {
+			return fmt.Sprintf(`{{ scmBranch %q }}`, s), nil
+		},
 	}
 }
 
@@ -88,3 +92,27 @@ func updatecliRuntimeFuncMap(data interface{}) template.FuncMap {
 		},
 	}
 }
+
+// scmBranch returns the branch defined by a scm of the configuration.
+// It's used at runtime as the branch of a scm declaring multiple branches is only known
+// once Updatecli generated one pipeline per branch.
+func (config *Config) scmBranch(scmID string) (string, error) {
+	scmConfig, ok := config.Spec.SCMs[scmID]
+	if !ok {
+		return "", fmt.Errorf("scm %q not found", scmID)
+	}
+
+	spec := struct {
+		Branch string
+	}{}
+
+	if err := mapstructure.Decode(scmConfig.Spec, &spec); err != nil {
+		return "", fmt.Errorf("decoding scm %q spec: %w", scmID, err)
+	}
+
+	if spec.Branch == "" {
+		return "", fmt.Errorf("scm %q doesn't define a branch", scmID)
+	}
+
+	return spec.Branch, nil
+}
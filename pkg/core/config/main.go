@@ -21,6 +21,7 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/source"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/target"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/when"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/core/text"
 	"github.com/updatecli/updatecli/pkg/core/version"
@@ -697,6 +698,10 @@ func (config *Config) validateTargets() error {
 			}
 		}
 
+		if err := config.validateWhen("target", id, t.When); err != nil {
+			return err
+		}
+
 		if t.DisableConditions && len(t.DeprecatedConditionIDs) > 0 {
 			logrus.Errorf("target %q has 'disableconditions' set to true and 'conditionids' defined (%v), it's not possible to disable conditions and define conditions at the same time", id, t.DeprecatedConditionIDs)
 			return ErrBadConfig
@@ -755,6 +760,10 @@ func (config *Config) validateConditions() error {
 				return ErrBadConfig
 			}
 		}
+		if err := config.validateWhen("condition", id, c.When); err != nil {
+			return err
+		}
+
 		// Only check/guess the sourceID if the user did not disable it (default is enabled)
 		if !c.DisableSourceInput {
 			// Try to guess SourceID
@@ -779,6 +788,50 @@ func (config *Config) validateConditions() error {
 	return nil
 }
 
+// validateWhen ensures that every resource referenced by a "when" expression exists
+func (config *Config) validateWhen(category, id, expression string) error {
+	if expression == "" {
+		return nil
+	}
+
+	e, err := when.Parse(expression)
+	if err != nil {
+		logrus.Errorf("%s %q: %s", category, id, err)
+		return ErrBadConfig
+	}
+
+	undefinedResources := []string{}
+	for _, operand := range e.Operands() {
+		operandCategory, operandID, _ := strings.Cut(operand, "#")
+
+		found := false
+		switch operandCategory {
+		case "source":
+			_, found = config.Spec.Sources[operandID]
+		case "condition":
+			_, found = config.Spec.Conditions[operandID]
+		case "target":
+			_, found = config.Spec.Targets[operandID]
+		}
+
+		if !found {
+			undefinedResources = append(undefinedResources, operand)
+		}
+
+		if operandCategory == category && operandID == id {
+			logrus.Errorf("%s %q references itself in its when expression %q", category, id, expression)
+			return ErrBadConfig
+		}
+	}
+
+	if len(undefinedResources) > 0 {
+		logrus.Errorf("%s %q has undefined resources in its when expression %q: %v", category, id, expression, undefinedResources)
+		return ErrBadConfig
+	}
+
+	return nil
+}
+
 // Validate run various validation test on the configuration and update fields if necessary
 func (config *Config) Validate() error {
 	var errs []error
@@ -873,7 +926,10 @@ func (config *Config) Update(data interface{}) (err error) {
 		return err
 	}
 
-	tmpl, err := template.New("cfg").Funcs(updatecliRuntimeFuncMap(data)).Parse(string(content))
+	funcMap := updatecliRuntimeFuncMap(data)
+	funcMap["scmBranch"] = config.scmBranch
+
+	tmpl, err := template.New("cfg").Funcs(funcMap).Parse(string(content))
 	if err != nil {
 		return err
 	}
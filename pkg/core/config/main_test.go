@@ -793,6 +793,29 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestUpdateScmBranch(t *testing.T) {
+	config := Config{
+		Spec: Spec{
+			Name: "Bump version on {{ scmBranch \"default\" }}",
+			SCMs: map[string]scm.Config{
+				"default": {
+					Kind: "git",
+					Spec: map[string]interface{}{
+						"url":    "https://github.com/updatecli/updatecli.git",
+						"branch": "release-1.x",
+					},
+				},
+			},
+		},
+	}
+
+	require.NoError(t, config.Update(context{}))
+	assert.Equal(t, "Bump version on release-1.x", config.Spec.Name)
+
+	config.Spec.Name = "{{ scmBranch \"unknown\" }}"
+	require.Error(t, config.Update(context{}))
+}
+
 func TestChecksum(t *testing.T) {
 	got, err := FileChecksum("./checksum.example")
 	expected := "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
@@ -817,6 +840,87 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestValidateWhen(t *testing.T) {
+	spec := Spec{
+		Sources: map[string]source.Config{
+			"latest": {},
+		},
+		Conditions: map[string]condition.Config{
+			"c1": {},
+			"c2": {},
+		},
+		Targets: map[string]target.Config{
+			"t1": {},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		category   string
+		id         string
+		expression string
+		wantErr    bool
+	}{
+		{
+			name:     "no expression",
+			category: "target",
+			id:       "t1",
+		},
+		{
+			name:       "known resources",
+			category:   "target",
+			id:         "t1",
+			expression: "(c1 && source#latest) || !condition#c2",
+		},
+		{
+			name:       "condition depending on a target",
+			category:   "condition",
+			id:         "c2",
+			expression: "c1 && target#t1",
+		},
+		{
+			name:       "undefined condition",
+			category:   "target",
+			id:         "t1",
+			expression: "c1 && c3",
+			wantErr:    true,
+		},
+		{
+			name:       "undefined source",
+			category:   "condition",
+			id:         "c1",
+			expression: "source#missing",
+			wantErr:    true,
+		},
+		{
+			name:       "self reference",
+			category:   "condition",
+			id:         "c1",
+			expression: "c1 || c2",
+			wantErr:    true,
+		},
+		{
+			name:       "invalid expression",
+			category:   "target",
+			id:         "t1",
+			expression: "c1 &&",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := Config{Spec: spec}
+			err := config.validateWhen(tt.category, tt.id, tt.expression)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrBadConfig)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestIsTemplatedString(t *testing.T) {
 	type templatedStringData struct {
 		Key            string
@@ -135,6 +135,23 @@ func (e *Engine) LoadConfigurations() error {
 				continue
 			}
 
+			// Generate one pipeline configuration per branch for scms declaring multiple branches
+			loadedConfigurations, err = expandScmBranches(loadedConfigurations)
+			if err != nil {
+
+				formatErr()
+
+				errs = append(errs, err)
+				e.Reports = append(e.Reports,
+					reports.Report{
+						Name:   fmt.Sprintf("Loading manifest %q", manifestFile),
+						Result: result.FAILURE,
+						Err:    err.Error(),
+					},
+				)
+				continue
+			}
+
 			// Load special scm configuration such as githubsearch that can generate multiple scm configurations
 			// the generated scm configured must be ready before Updatecli start doing any operation such as
 			// clone git repositories, using the autoddiscovery to detect potienial updates.
@@ -26,4 +26,9 @@ type Options struct {
 	ExportToYAML bool
 	// DisableUdashReport defines whether to skip publishing pipeline reports to Udash
 	DisableUdashReport bool
+	// Parallelism defines the maximum number of pipelines executed concurrently
+	Parallelism int
+	// HTTPCacheDir defines the directory used to persist HTTP responses across executions.
+	// When empty, HTTP responses are only cached in memory for the current execution.
+	HTTPCacheDir string
 }
@@ -3,6 +3,7 @@ package engine
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/cache"
@@ -31,25 +32,18 @@ func (e *Engine) Run(ctx context.Context) (err error) {
 
 	e.sourceCache = cache.NewSourceCache()
 
-	httpclient.EnableHTTPCache()
-	defer httpclient.DisableHTTPCache()
-
-	for i := range e.Pipelines {
-		pipeline := e.Pipelines[i]
-		pipeline.SourceCache = e.sourceCache
-
-		err := pipeline.Run(ctx)
-		if err != nil {
-			errs = append(errs, fmt.Errorf("pipeline %q failed: %w", pipeline.Name, err))
-			span.AddEvent("pipeline.failed", trace.WithAttributes(
-				attribute.String("pipeline.name", pipeline.Name),
-				attribute.String("error", telemetry.SanitizeError(err)),
-			))
-			logrus.Printf("Pipeline %q failed\n", pipeline.Name)
-			logrus.Printf("Skipping due to:\n\t%s\n", err)
-			continue
+	switch e.Options.HTTPCacheDir {
+	case "":
+		httpclient.EnableHTTPCache()
+	default:
+		if err := httpclient.EnablePersistentHTTPCache(e.Options.HTTPCacheDir); err != nil {
+			logrus.Warningf("persistent HTTP cache disabled, falling back to in-memory cache: %s", err)
+			httpclient.EnableHTTPCache()
 		}
 	}
+	defer httpclient.DisableHTTPCache()
+
+	errs = append(errs, e.runPipelines(ctx, span)...)
 
 	if !e.Options.Pipeline.Target.DryRun && e.Options.Pipeline.Target.Push {
 		_, pushSpan := tracer.Start(ctx, "updatecli.push_commits")
@@ -115,3 +109,59 @@ func (e *Engine) Run(ctx context.Context) (err error) {
 
 	return nil
 }
+
+// runPipelines executes every pipeline, using up to Options.Parallelism workers.
+// Pipelines sharing an SCM working directory are serialized so that a git
+// clone is never modified by two pipelines at the same time.
+func (e *Engine) runPipelines(ctx context.Context, span trace.Span) []error {
+	parallelism := e.Options.Parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	span.SetAttributes(attribute.Int("updatecli.parallelism", parallelism))
+
+	var mu sync.Mutex
+	errs := []error{}
+
+	locker := newSCMLocker()
+	wg := sync.WaitGroup{}
+	channel := make(chan int, parallelism)
+
+	for i := range e.Pipelines {
+		pipeline := e.Pipelines[i]
+		pipeline.SourceCache = e.sourceCache
+
+		channel <- 1
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-channel
+				wg.Done()
+			}()
+
+			unlock := locker.Lock(pipelineSCMDirectories(pipeline))
+			defer unlock()
+
+			err := pipeline.Run(ctx)
+			if err == nil {
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			errs = append(errs, fmt.Errorf("pipeline %q failed: %w", pipeline.Name, err))
+			span.AddEvent("pipeline.failed", trace.WithAttributes(
+				attribute.String("pipeline.name", pipeline.Name),
+				attribute.String("error", telemetry.SanitizeError(err)),
+			))
+			logrus.Printf("Pipeline %q failed\n", pipeline.Name)
+			logrus.Printf("Skipping due to:\n\t%s\n", err)
+		}()
+	}
+
+	wg.Wait()
+
+	return errs
+}
@@ -0,0 +1,97 @@
+package engine
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/config"
+	"go.yaml.in/yaml/v3"
+)
+
+// expandScmBranches generates one pipeline configuration per branch for every scm
+// declaring multiple branches. When several scms of a pipeline declare branches,
+// one pipeline configuration is generated per combination of branches.
+func expandScmBranches(configurations []config.Config) ([]config.Config, error) {
+	var expandedConfigurations []config.Config
+
+	for _, configuration := range configurations {
+		newConfigurations := []config.Config{configuration}
+
+		scmIDs := slices.Sorted(maps.Keys(configuration.Spec.SCMs))
+
+		for _, scmID := range scmIDs {
+			scmConfig := configuration.Spec.SCMs[scmID]
+
+			branches, err := scmConfig.ResolveBranches(configuration.Spec.PipelineID)
+			if err != nil {
+				return nil, fmt.Errorf("resolving branches of scm %q: %w", scmID, err)
+			}
+
+			if branches == nil {
+				continue
+			}
+
+			logrus.Debugf("scm %q generates one pipeline configuration per branch %v", scmID, branches)
+
+			var branchConfigurations []config.Config
+			for _, newConfiguration := range newConfigurations {
+				for _, branch := range branches {
+					branchConfiguration := newConfiguration
+
+					// Each branch pipeline renders its own spec, so nothing can be shared
+					// with the other branch pipelines
+					branchSpec, err := copySpec(newConfiguration.Spec)
+					if err != nil {
+						return nil, fmt.Errorf("copying pipeline %q for branch %q: %w", newConfiguration.Spec.Name, branch, err)
+					}
+					branchConfiguration.Spec = branchSpec
+					branchConfiguration.Spec.SCMs[scmID] = *scmConfig.WithBranch(branch)
+
+					// Each branch has its own report entry
+					branchConfiguration.Spec.Name = fmt.Sprintf("%s (%s)", newConfiguration.Spec.Name, branch)
+
+					// Each branch is a distinct pipeline, so reports, actions, and published results
+					// of one branch don't overwrite the ones of another branch
+					branchConfiguration.Spec.PipelineID = branchPipelineID(newConfiguration.Spec.PipelineID, scmID, branch)
+					branchConfiguration.SetManifestID(fmt.Sprintf("%s/%s/%s", newConfiguration.ManifestID(), scmID, branch))
+
+					branchConfigurations = append(branchConfigurations, branchConfiguration)
+				}
+			}
+
+			newConfigurations = branchConfigurations
+		}
+
+		expandedConfigurations = append(expandedConfigurations, newConfigurations...)
+	}
+
+	return expandedConfigurations, nil
+}
+
+// branchPipelineID returns a deterministic pipeline ID derived from the original
+// pipeline ID and the scm branch.
+func branchPipelineID(pipelineID, scmID, branch string) string {
+	hash := sha256.New()
+	_, _ = io.WriteString(hash, pipelineID+"/"+scmID+"/"+branch)
+	return fmt.Sprintf("%x", hash.Sum(nil))
+}
+
+// copySpec returns a deep copy of a pipeline spec.
+func copySpec(spec config.Spec) (config.Spec, error) {
+	var result config.Spec
+
+	content, err := yaml.Marshal(spec)
+	if err != nil {
+		return result, err
+	}
+
+	if err := yaml.Unmarshal(content, &result); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,153 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/config"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/target"
+)
+
+func TestExpandScmBranches(t *testing.T) {
+	configurations := []config.Config{
+		{
+			Spec: config.Spec{
+				Name:       "Bump version",
+				PipelineID: "bump-version",
+				SCMs: map[string]scm.Config{
+					"app": {
+						Kind: "git",
+						Spec: map[string]interface{}{
+							"url":      "https://github.com/updatecli/updatecli.git",
+							"branches": []interface{}{"main", "release-1.x"},
+						},
+					},
+					"docs": {
+						Kind: "git",
+						Spec: map[string]interface{}{
+							"url":      "https://github.com/updatecli/website.git",
+							"branches": []interface{}{"master", "v1"},
+						},
+					},
+					"local": {
+						Kind: "git",
+						Spec: map[string]interface{}{
+							"url":    "https://github.com/updatecli/updatecli.git",
+							"branch": "main",
+						},
+					},
+				},
+			},
+		},
+		{
+			Spec: config.Spec{
+				Name: "Without branches",
+			},
+		},
+	}
+
+	gotConfigurations, err := expandScmBranches(configurations)
+	require.NoError(t, err)
+	require.Len(t, gotConfigurations, 5)
+
+	expected := []struct {
+		name       string
+		appBranch  string
+		docsBranch string
+	}{
+		{name: "Bump version (main) (master)", appBranch: "main", docsBranch: "master"},
+		{name: "Bump version (main) (v1)", appBranch: "main", docsBranch: "v1"},
+		{name: "Bump version (release-1.x) (master)", appBranch: "release-1.x", docsBranch: "master"},
+		{name: "Bump version (release-1.x) (v1)", appBranch: "release-1.x", docsBranch: "v1"},
+	}
+
+	pipelineIDs := map[string]bool{}
+	manifestIDs := map[string]bool{}
+
+	for i, e := range expected {
+		gotSpec := gotConfigurations[i].Spec
+
+		// Every branch combination is a distinct pipeline with a stable ID
+		assert.NotEqual(t, "bump-version", gotSpec.PipelineID)
+		assert.Equal(t,
+			branchPipelineID(branchPipelineID("bump-version", "app", e.appBranch), "docs", e.docsBranch),
+			gotSpec.PipelineID)
+		pipelineIDs[gotSpec.PipelineID] = true
+		manifestIDs[gotConfigurations[i].ManifestID()] = true
+
+		assert.Equal(t, e.name, gotSpec.Name)
+		assert.Equal(t, map[string]interface{}{
+			"url":    "https://github.com/updatecli/updatecli.git",
+			"branch": e.appBranch,
+		}, gotSpec.SCMs["app"].Spec)
+		assert.Equal(t, map[string]interface{}{
+			"url":    "https://github.com/updatecli/website.git",
+			"branch": e.docsBranch,
+		}, gotSpec.SCMs["docs"].Spec)
+		assert.Equal(t, configurations[0].Spec.SCMs["local"], gotSpec.SCMs["local"])
+	}
+
+	assert.Len(t, pipelineIDs, 4)
+	assert.Len(t, manifestIDs, 4)
+
+	assert.Equal(t, "Without branches", gotConfigurations[4].Spec.Name)
+
+	// The original configuration must not be modified
+	assert.Contains(t, configurations[0].Spec.SCMs["app"].Spec, "branches")
+	assert.Equal(t, "bump-version", configurations[0].Spec.PipelineID)
+
+	// Expanding the same configuration again generates the same pipeline IDs
+	againConfigurations, err := expandScmBranches(configurations)
+	require.NoError(t, err)
+	for i := range gotConfigurations {
+		assert.Equal(t, gotConfigurations[i].Spec.PipelineID, againConfigurations[i].Spec.PipelineID)
+	}
+}
+
+func TestExpandScmBranchesUpdate(t *testing.T) {
+	configurations := []config.Config{
+		{
+			Spec: config.Spec{
+				Name: "Bump version",
+				SCMs: map[string]scm.Config{
+					"app": {
+						Kind: "git",
+						Spec: map[string]interface{}{
+							"url":      "https://github.com/updatecli/updatecli.git",
+							"branches": []interface{}{"main", "v1"},
+						},
+					},
+				},
+				Targets: map[string]target.Config{
+					"version": {
+						ResourceConfig: resource.ResourceConfig{
+							Kind: "file",
+							Spec: map[string]interface{}{
+								"file":    `{{ scmBranch "app" }}.txt`,
+								"content": "1.0.0",
+							},
+							SCMID: "app",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	gotConfigurations, err := expandScmBranches(configurations)
+	require.NoError(t, err)
+	require.Len(t, gotConfigurations, 2)
+
+	require.NoError(t, gotConfigurations[0].Update(struct{}{}))
+	assert.Equal(t, "main.txt", gotConfigurations[0].Spec.Targets["version"].Spec.(map[string]interface{})["file"])
+
+	// Rendering one branch pipeline must not affect the other ones, nor the original configuration
+	assert.Equal(t, `{{ scmBranch "app" }}.txt`, gotConfigurations[1].Spec.Targets["version"].Spec.(map[string]interface{})["file"])
+	assert.Equal(t, `{{ scmBranch "app" }}.txt`, configurations[0].Spec.Targets["version"].Spec.(map[string]interface{})["file"])
+
+	require.NoError(t, gotConfigurations[1].Update(struct{}{}))
+	assert.Equal(t, "v1.txt", gotConfigurations[1].Spec.Targets["version"].Spec.(map[string]interface{})["file"])
+}
@@ -0,0 +1,62 @@
+package engine
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/updatecli/updatecli/pkg/core/pipeline"
+)
+
+// scmLocker serializes pipelines sharing an SCM working directory so that two
+// pipelines running concurrently never mutate the same git clone at once.
+type scmLocker struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func newSCMLocker() *scmLocker {
+	return &scmLocker{
+		locks: make(map[string]*sync.Mutex),
+	}
+}
+
+// Lock acquires the lock of every directory and returns a function releasing them.
+// Directories are locked in a sorted order to avoid deadlocks between pipelines
+// sharing several repositories.
+func (l *scmLocker) Lock(directories []string) (unlock func()) {
+	directories = slices.Clone(directories)
+	slices.Sort(directories)
+	directories = slices.Compact(directories)
+
+	acquired := make([]*sync.Mutex, 0, len(directories))
+	for _, directory := range directories {
+		l.mu.Lock()
+		m, ok := l.locks[directory]
+		if !ok {
+			m = &sync.Mutex{}
+			l.locks[directory] = m
+		}
+		l.mu.Unlock()
+
+		m.Lock()
+		acquired = append(acquired, m)
+	}
+
+	return func() {
+		for i := len(acquired) - 1; i >= 0; i-- {
+			acquired[i].Unlock()
+		}
+	}
+}
+
+// pipelineSCMDirectories returns the working directories of every enabled scm used by the pipeline.
+func pipelineSCMDirectories(p *pipeline.Pipeline) []string {
+	directories := []string{}
+	for id := range p.SCMs {
+		if p.SCMs[id].Handler == nil {
+			continue
+		}
+		directories = append(directories, p.SCMs[id].Handler.GetDirectory())
+	}
+	return directories
+}
@@ -0,0 +1,67 @@
+package engine
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSCMLockerSerializesSharedDirectories(t *testing.T) {
+	locker := newSCMLocker()
+
+	var running, maxRunning atomic.Int32
+	wg := sync.WaitGroup{}
+
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			// Alternate the directory order to ensure sorting prevents deadlocks.
+			directories := []string{"/tmp/a", "/tmp/b"}
+			if i%2 == 0 {
+				directories = []string{"/tmp/b", "/tmp/a", "/tmp/b"}
+			}
+
+			unlock := locker.Lock(directories)
+			defer unlock()
+
+			current := running.Add(1)
+			for {
+				previous := maxRunning.Load()
+				if current <= previous || maxRunning.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(1), maxRunning.Load())
+}
+
+func TestSCMLockerAllowsDistinctDirectories(t *testing.T) {
+	locker := newSCMLocker()
+
+	unlockA := locker.Lock([]string{"/tmp/a"})
+	defer unlockA()
+
+	done := make(chan struct{})
+	go func() {
+		unlockB := locker.Lock([]string{"/tmp/b"})
+		unlockB()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("locking an unrelated directory must not block")
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"io"
 	"net/http"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
@@ -20,19 +22,30 @@ type cachedResponse struct {
 
 // cachingTransport is an http.RoundTripper that caches successful GET responses
 // in memory. Non-GET requests and non-2xx responses are passed through uncached.
+// When a disk store is configured, responses are also persisted across executions
+// and revalidated with conditional requests once they are stale.
 type cachingTransport struct {
 	transport http.RoundTripper
 	mu        sync.RWMutex
 	entries   map[string]cachedResponse
+	disk      *diskStore
+	now       func() time.Time
 }
 
 func newCachingTransport(transport http.RoundTripper) *cachingTransport {
 	return &cachingTransport{
 		transport: transport,
 		entries:   make(map[string]cachedResponse),
+		now:       time.Now,
 	}
 }
 
+func newPersistentCachingTransport(transport http.RoundTripper, directory string) *cachingTransport {
+	c := newCachingTransport(transport)
+	c.disk = &diskStore{directory: directory}
+	return c
+}
+
 func (c *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	if req.Method != http.MethodGet {
 		return c.transport.RoundTrip(req)
@@ -46,14 +59,11 @@ func (c *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error)
 
 	if ok {
 		logrus.Debugf("http cache hit: %s", redact.URL(key))
-		return &http.Response{
-			StatusCode:    entry.StatusCode,
-			Status:        entry.Status,
-			Header:        entry.Header.Clone(),
-			Body:          io.NopCloser(bytes.NewReader(entry.Body)),
-			ContentLength: int64(len(entry.Body)),
-			Request:       req,
-		}, nil
+		return entry.response(req), nil
+	}
+
+	if c.isPersistable(req) {
+		return c.roundTripPersistent(req, key)
 	}
 
 	resp, err := c.transport.RoundTrip(req)
@@ -72,14 +82,12 @@ func (c *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error)
 
 		resp.Body = io.NopCloser(bytes.NewReader(body))
 
-		c.mu.Lock()
-		c.entries[key] = cachedResponse{
+		c.store(key, cachedResponse{
 			StatusCode: resp.StatusCode,
 			Status:     resp.Status,
 			Header:     resp.Header.Clone(),
 			Body:       body,
-		}
-		c.mu.Unlock()
+		})
 
 		logrus.Debugf("http cache store: %s", redact.URL(key))
 	}
@@ -87,6 +95,170 @@ func (c *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error)
 	return resp, nil
 }
 
+// persistableHeaders lists the request headers which don't prevent a response from being persisted,
+// as they don't carry credentials. Conditional headers are excluded as they are left to the caller.
+var persistableHeaders = map[string]bool{
+	"Accept":          true,
+	"Accept-Encoding": true,
+	"Accept-Language": true,
+	"Cache-Control":   true,
+	"Content-Type":    true,
+	"User-Agent":      true,
+}
+
+// credentialQueryParameters lists, lowercased, the query parameters, or parts of them, which
+// commonly carry credentials.
+var credentialQueryParameters = []string{
+	"token",
+	"key",
+	"secret",
+	"password",
+	"passwd",
+	"signature",
+	"credential",
+	"auth",
+	"session",
+}
+
+// isPersistable reports whether a request may be served from, and stored in, the disk cache.
+// As the disk cache key is only the URL, requests which may be authenticated, using
+// a header, a query parameter, or credentials embedded in the URL, are never written to disk.
+func (c *cachingTransport) isPersistable(req *http.Request) bool {
+	if c.disk == nil || req.URL.User != nil {
+		return false
+	}
+
+	for name := range req.Header {
+		if !persistableHeaders[http.CanonicalHeaderKey(name)] {
+			return false
+		}
+	}
+
+	for name := range req.URL.Query() {
+		name = strings.ToLower(name)
+		for _, credential := range credentialQueryParameters {
+			if strings.Contains(name, credential) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// roundTripPersistent serves a request from the disk cache when the stored
+// response is still fresh, otherwise it revalidates it using the ETag and
+// Last-Modified validators.
+func (c *cachingTransport) roundTripPersistent(req *http.Request, key string) (*http.Response, error) {
+	entry, found := c.disk.load(key)
+	// Entries written before a response became non storable are ignored
+	found = found && isStorable(entry.Header)
+
+	if found && entry.isFresh(c.now()) {
+		logrus.Debugf("http disk cache hit: %s", redact.URL(key))
+		c.store(key, entry.cachedResponse)
+		return entry.response(req), nil
+	}
+
+	outReq := req
+	if found {
+		etag := entry.Header.Get("ETag")
+		lastModified := entry.Header.Get("Last-Modified")
+		if etag != "" || lastModified != "" {
+			outReq = req.Clone(req.Context())
+			if etag != "" {
+				outReq.Header.Set("If-None-Match", etag)
+			}
+			if lastModified != "" {
+				outReq.Header.Set("If-Modified-Since", lastModified)
+			}
+		}
+	}
+
+	resp, err := c.transport.RoundTrip(outReq)
+	if err != nil {
+		return resp, err
+	}
+
+	if found && resp.StatusCode == http.StatusNotModified {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		logrus.Debugf("http disk cache revalidated: %s", redact.URL(key))
+
+		// A 304 response carries the updated caching headers of the stored response
+		for name, values := range resp.Header {
+			entry.Header[name] = values
+		}
+		entry.StoredAt = c.now()
+
+		if isStorable(entry.Header) {
+			c.persist(key, entry)
+		}
+		c.store(key, entry.cachedResponse)
+
+		return entry.response(req), nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	cached := cachedResponse{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+	}
+
+	c.store(key, cached)
+
+	if isStorable(resp.Header) {
+		c.persist(key, diskEntry{
+			cachedResponse: cached,
+			URL:            key,
+			StoredAt:       c.now(),
+		})
+	}
+
+	return resp, nil
+}
+
+// store saves a response in the in-memory cache.
+func (c *cachingTransport) store(key string, entry cachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = entry
+}
+
+// persist saves a response in the disk cache, failures only cost a future cache miss.
+func (c *cachingTransport) persist(key string, entry diskEntry) {
+	if err := c.disk.save(key, entry); err != nil {
+		logrus.Debugf("http disk cache store failed for %s: %s", redact.URL(key), err)
+		return
+	}
+	logrus.Debugf("http disk cache store: %s", redact.URL(key))
+}
+
+// response builds a new http.Response replaying the cached response.
+func (r cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		StatusCode:    r.StatusCode,
+		Status:        r.Status,
+		Header:        r.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
 // Len returns the number of cached responses (for testing).
 func (c *cachingTransport) Len() int {
 	c.mu.RLock()
@@ -0,0 +1,180 @@
+package httpclient
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// diskEntry is the on-disk representation of a cached HTTP response.
+type diskEntry struct {
+	cachedResponse
+	URL      string
+	StoredAt time.Time
+}
+
+// diskStore persists cached responses in a directory so they can be reused
+// across Updatecli executions. Each response is stored in its own file named
+// after the SHA256 of the request URL.
+type diskStore struct {
+	directory string
+}
+
+func (d *diskStore) path(key string) string {
+	return filepath.Join(d.directory, fmt.Sprintf("%x.json", sha256.Sum256([]byte(key))))
+}
+
+// load returns the entry stored for key, or false if none could be read.
+func (d *diskStore) load(key string) (diskEntry, bool) {
+	data, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return diskEntry{}, false
+	}
+
+	var entry diskEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return diskEntry{}, false
+	}
+
+	// Guard against hash collisions
+	if entry.URL != key {
+		return diskEntry{}, false
+	}
+
+	return entry, true
+}
+
+// save writes the entry atomically so that concurrent readers never see a partial file.
+func (d *diskStore) save(key string, entry diskEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(d.directory, ".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return os.Rename(f.Name(), d.path(key))
+}
+
+// isFresh reports whether the entry can be served without revalidation,
+// based on the Cache-Control max-age directive and the Age header.
+func (e diskEntry) isFresh(now time.Time) bool {
+	directives := parseCacheControl(e.Header.Get("Cache-Control"))
+
+	if _, ok := directives["no-cache"]; ok {
+		return false
+	}
+
+	maxAge, err := strconv.Atoi(directives["max-age"])
+	if err != nil || maxAge <= 0 {
+		return false
+	}
+
+	age, err := strconv.Atoi(e.Header.Get("Age"))
+	if err != nil || age < 0 {
+		age = 0
+	}
+
+	return now.Sub(e.StoredAt) < time.Duration(maxAge-age)*time.Second
+}
+
+// parseCacheControl returns the Cache-Control directives, lowercased, with their optional value.
+func parseCacheControl(value string) map[string]string {
+	directives := map[string]string{}
+	for _, directive := range strings.Split(value, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		if name == "" {
+			continue
+		}
+		directives[strings.ToLower(name)] = strings.Trim(arg, `"`)
+	}
+	return directives
+}
+
+// isStorable reports whether a response may be persisted on disk.
+// As the disk cache key is only the URL, responses varying on request headers are never persisted,
+// except on Accept-Encoding which is negotiated and decoded by the Go transport.
+func isStorable(header http.Header) bool {
+	directives := parseCacheControl(header.Get("Cache-Control"))
+	if _, noStore := directives["no-store"]; noStore {
+		return false
+	}
+	if _, private := directives["private"]; private {
+		return false
+	}
+
+	for _, value := range header.Values("Vary") {
+		for _, name := range strings.Split(value, ",") {
+			name = strings.TrimSpace(name)
+			if name != "" && !strings.EqualFold(name, "Accept-Encoding") {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// PruneHTTPCache removes entries from the persistent HTTP cache directory that
+// were stored or revalidated more than olderThan ago. A zero duration removes
+// every entry. It returns the number of removed entries.
+func PruneHTTPCache(directory string, olderThan time.Duration) (int, error) {
+	if directory == "" {
+		return 0, errors.New("no HTTP cache directory specified")
+	}
+
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	deadline := time.Now().Add(-olderThan)
+	removed := 0
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" && !strings.HasPrefix(entry.Name(), ".tmp-") {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return removed, err
+		}
+
+		if olderThan > 0 && info.ModTime().After(deadline) {
+			continue
+		}
+
+		if err := os.Remove(filepath.Join(directory, entry.Name())); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,366 @@
+package httpclient
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getBody(t *testing.T, client *http.Client, url string) string {
+	t.Helper()
+	resp, err := client.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	return string(body)
+}
+
+func TestPersistentCachingTransport_ReusedAcrossExecutions(t *testing.T) {
+	// Arrange
+	srv, hits := newCountingServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Cache-Control", "public, max-age=600")
+		_, _ = w.Write([]byte("persisted"))
+	})
+	dir := t.TempDir()
+
+	// Act: two transports simulate two distinct Updatecli executions
+	first := &http.Client{Transport: newPersistentCachingTransport(http.DefaultTransport, dir)}
+	second := &http.Client{Transport: newPersistentCachingTransport(http.DefaultTransport, dir)}
+
+	body1 := getBody(t, first, srv.URL+"/resource")
+	body2 := getBody(t, second, srv.URL+"/resource")
+
+	// Assert
+	assert.Equal(t, "persisted", body1)
+	assert.Equal(t, "persisted", body2)
+	assert.Equal(t, int64(1), hits.Load(), "fresh response must be served from disk")
+}
+
+func TestPersistentCachingTransport_RevalidatesStaleEntries(t *testing.T) {
+	// Arrange
+	srv, hits := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Cache-Control", "max-age=60")
+		_, _ = w.Write([]byte("etag body"))
+	})
+	dir := t.TempDir()
+
+	first := newPersistentCachingTransport(http.DefaultTransport, dir)
+	second := newPersistentCachingTransport(http.DefaultTransport, dir)
+	// The second execution happens after the entry expired
+	second.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+
+	// Act
+	body1 := getBody(t, &http.Client{Transport: first}, srv.URL+"/etag")
+	body2 := getBody(t, &http.Client{Transport: second}, srv.URL+"/etag")
+
+	// Assert
+	assert.Equal(t, "etag body", body1)
+	assert.Equal(t, "etag body", body2, "304 must be answered with the stored body")
+	assert.Equal(t, int64(2), hits.Load())
+}
+
+func TestPersistentCachingTransport_LastModified(t *testing.T) {
+	// Arrange
+	lastModified := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Format(http.TimeFormat)
+	var conditional string
+	srv, _ := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		conditional = r.Header.Get("If-Modified-Since")
+		if conditional == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", lastModified)
+		_, _ = w.Write([]byte("dated body"))
+	})
+	dir := t.TempDir()
+
+	// Act
+	_ = getBody(t, &http.Client{Transport: newPersistentCachingTransport(http.DefaultTransport, dir)}, srv.URL)
+	body := getBody(t, &http.Client{Transport: newPersistentCachingTransport(http.DefaultTransport, dir)}, srv.URL)
+
+	// Assert
+	assert.Equal(t, lastModified, conditional)
+	assert.Equal(t, "dated body", body)
+}
+
+func TestPersistentCachingTransport_SkipsNoStoreAndAuthenticated(t *testing.T) {
+	// Arrange
+	srv, hits := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/nostore" {
+			w.Header().Set("Cache-Control", "no-store")
+		} else {
+			w.Header().Set("Cache-Control", "max-age=600")
+		}
+		_, _ = w.Write([]byte("secret"))
+	})
+	dir := t.TempDir()
+	client := &http.Client{Transport: newPersistentCachingTransport(http.DefaultTransport, dir)}
+
+	// Act
+	_ = getBody(t, client, srv.URL+"/nostore")
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/private", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// Assert
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files, "no-store and authenticated responses must not be written to disk")
+	assert.Equal(t, int64(2), hits.Load())
+}
+
+func TestPersistentCachingTransport_SkipsVaryPrivateAndCookies(t *testing.T) {
+	// Arrange
+	srv, hits := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=600")
+		switch r.URL.Path {
+		case "/vary":
+			w.Header().Set("Vary", "Accept-Encoding, Accept")
+		case "/private":
+			w.Header().Set("Cache-Control", "private, max-age=600")
+		}
+		_, _ = w.Write([]byte("negotiated"))
+	})
+	dir := t.TempDir()
+
+	// Act: two transports simulate two distinct Updatecli executions
+	for _, transport := range []*cachingTransport{
+		newPersistentCachingTransport(http.DefaultTransport, dir),
+		newPersistentCachingTransport(http.DefaultTransport, dir),
+	} {
+		client := &http.Client{Transport: transport}
+		_ = getBody(t, client, srv.URL+"/vary")
+		_ = getBody(t, client, srv.URL+"/private")
+
+		req, err := http.NewRequest(http.MethodGet, srv.URL+"/cookie", nil)
+		require.NoError(t, err)
+		req.Header.Set("Cookie", "session=secret")
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	// Assert
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files, "vary, private, and cookie responses must not be written to disk")
+	assert.Equal(t, int64(6), hits.Load())
+}
+
+func TestPersistentCachingTransport_SkipsCredentials(t *testing.T) {
+	// Arrange
+	srv, hits := newCountingServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=600")
+		_, _ = w.Write([]byte("secret"))
+	})
+	dir := t.TempDir()
+	client := &http.Client{Transport: newPersistentCachingTransport(http.DefaultTransport, dir)}
+
+	userinfoURL, err := url.Parse(srv.URL + "/userinfo")
+	require.NoError(t, err)
+	userinfoURL.User = url.UserPassword("user", "pass")
+
+	tests := []struct {
+		name   string
+		url    string
+		header http.Header
+	}{
+		{name: "gitlab token header", url: srv.URL + "/gitlab", header: http.Header{"Private-Token": {"secret"}}},
+		{name: "artifactory api key header", url: srv.URL + "/artifactory", header: http.Header{"X-Jfrog-Art-Api": {"secret"}}},
+		{name: "api key header", url: srv.URL + "/header", header: http.Header{"X-Api-Key": {"secret"}}},
+		{name: "access token query", url: srv.URL + "/query?access_token=secret"},
+		{name: "signed query", url: srv.URL + "/query?X-Amz-Signature=secret"},
+		{name: "api key query", url: srv.URL + "/query?apikey=secret"},
+		{name: "userinfo", url: userinfoURL.String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			require.NoError(t, err)
+			for name, values := range tt.header {
+				req.Header[name] = values
+			}
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			// Assert
+			files, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			assert.Empty(t, files, "responses to requests carrying credentials must not be written to disk")
+		})
+	}
+
+	assert.Equal(t, int64(len(tests)), hits.Load())
+}
+
+func TestPersistentCachingTransport_PersistsAllowedHeaders(t *testing.T) {
+	// Arrange
+	srv, _ := newCountingServer(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=600")
+		_, _ = w.Write([]byte("public"))
+	})
+	dir := t.TempDir()
+	client := &http.Client{Transport: newPersistentCachingTransport(http.DefaultTransport, dir)}
+
+	// Act
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/versions?page=2", nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "updatecli")
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// Assert
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+}
+
+func TestIsStorable(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   http.Header
+		expected bool
+	}{
+		{
+			name:     "public response",
+			header:   http.Header{"Cache-Control": {"public, max-age=600"}},
+			expected: true,
+		},
+		{
+			name:     "vary on accept-encoding only",
+			header:   http.Header{"Vary": {"accept-encoding"}},
+			expected: true,
+		},
+		{
+			name:   "vary on accept",
+			header: http.Header{"Vary": {"Accept-Encoding", "Accept"}},
+		},
+		{
+			name:   "vary on everything",
+			header: http.Header{"Vary": {"*"}},
+		},
+		{
+			name:   "no-store",
+			header: http.Header{"Cache-Control": {"no-store"}},
+		},
+		{
+			name:   "private",
+			header: http.Header{"Cache-Control": {"Private, max-age=60"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isStorable(tt.header))
+		})
+	}
+}
+
+func TestDiskEntryIsFresh(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		header   http.Header
+		storedAt time.Time
+		expected bool
+	}{
+		{
+			name:     "no cache-control",
+			header:   http.Header{},
+			storedAt: now,
+			expected: false,
+		},
+		{
+			name:     "within max-age",
+			header:   http.Header{"Cache-Control": []string{"public, max-age=300"}},
+			storedAt: now.Add(-time.Minute),
+			expected: true,
+		},
+		{
+			name:     "expired max-age",
+			header:   http.Header{"Cache-Control": []string{"max-age=30"}},
+			storedAt: now.Add(-time.Minute),
+			expected: false,
+		},
+		{
+			name:     "age header reduces lifetime",
+			header:   http.Header{"Cache-Control": []string{"max-age=120"}, "Age": []string{"90"}},
+			storedAt: now.Add(-time.Minute),
+			expected: false,
+		},
+		{
+			name:     "no-cache forces revalidation",
+			header:   http.Header{"Cache-Control": []string{"no-cache, max-age=300"}},
+			storedAt: now,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := diskEntry{
+				cachedResponse: cachedResponse{Header: tt.header},
+				StoredAt:       tt.storedAt,
+			}
+			assert.Equal(t, tt.expected, entry.isFresh(now))
+		})
+	}
+}
+
+func TestPruneHTTPCache(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	store := &diskStore{directory: dir}
+	require.NoError(t, store.save("https://example.com/old", diskEntry{URL: "https://example.com/old"}))
+	require.NoError(t, store.save("https://example.com/new", diskEntry{URL: "https://example.com/new"}))
+
+	old := time.Now().Add(-48 * time.Hour)
+	require.NoError(t, os.Chtimes(store.path("https://example.com/old"), old, old))
+
+	// An unrelated file must be left untouched
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("keep"), 0o600))
+
+	// Act
+	removed, err := PruneHTTPCache(dir, 24*time.Hour)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	_, found := store.load("https://example.com/new")
+	assert.True(t, found)
+
+	// Act: remove everything
+	removed, err = PruneHTTPCache(dir, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	assert.FileExists(t, filepath.Join(dir, "README"))
+
+	// A missing directory is not an error
+	removed, err = PruneHTTPCache(filepath.Join(dir, "missing"), 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, removed)
+}
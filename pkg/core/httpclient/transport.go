@@ -1,7 +1,9 @@
 package httpclient
 
 import (
+	"fmt"
 	"net/http"
+	"os"
 	"sync"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -23,6 +25,24 @@ func EnableHTTPCache() {
 	}))
 }
 
+// EnablePersistentHTTPCache activates the HTTP cache like EnableHTTPCache and
+// additionally persists GET responses in directory so they can be reused by
+// later executions. Stale responses are revalidated using conditional requests
+// and the Cache-Control max-age directive is honoured.
+func EnablePersistentHTTPCache(directory string) error {
+	if err := os.MkdirAll(directory, 0o700); err != nil {
+		return fmt.Errorf("creating HTTP cache directory %q: %w", directory, err)
+	}
+
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	activeCache = newPersistentCachingTransport(otelhttp.NewTransport(&http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+	}), directory)
+
+	return nil
+}
+
 // DisableHTTPCache deactivates the HTTP cache so that new clients no longer
 // use it. Already-created clients may still hold a reference to the previous
 // cache until they are garbage collected. Safe to call even if caching was
@@ -15,20 +15,26 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/telemetry"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/argocd"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/bazel"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/bundler"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/cargo"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/composer"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/dockercompose"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/dockerfile"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/fleet"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/flux"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/githubaction"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/golang"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/gradle"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/helm"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/helmfile"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/ko"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/kubernetes"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/kustomize"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/maven"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/nomad"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/npm"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/nuget"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/pip"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/plugin"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/precommit"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/pyproject"
@@ -142,12 +148,26 @@ var crawlerMap = map[string]struct {
 		},
 		spec: bazel.Spec{},
 	},
+	"bundler": {
+		newFunc: func(spec any, rootDir string, scmID string, actionID, pluginName string) (Crawler, error) {
+			return bundler.New(spec, rootDir, scmID, actionID)
+		},
+		spec:  bundler.Spec{},
+		alias: []string{"ruby/bundler"},
+	},
 	"cargo": {
 		newFunc: func(spec any, rootDir string, scmID string, actionID, pluginName string) (Crawler, error) {
 			return cargo.New(spec, rootDir, scmID, actionID)
 		},
 		spec: cargo.Spec{},
 	},
+	"composer": {
+		newFunc: func(spec any, rootDir string, scmID string, actionID, pluginName string) (Crawler, error) {
+			return composer.New(spec, rootDir, scmID, actionID)
+		},
+		spec:  composer.Spec{},
+		alias: []string{"php/composer"},
+	},
 	"dockercompose": {
 		newFunc: func(spec any, rootDir string, scmID string, actionID, pluginName string) (Crawler, error) {
 			return dockercompose.New(spec, rootDir, scmID, actionID)
@@ -191,6 +211,12 @@ var crawlerMap = map[string]struct {
 		spec:  golang.Spec{},
 		alias: []string{"go", "golang/gomod"},
 	},
+	"gradle": {
+		newFunc: func(spec any, rootDir string, scmID string, actionID, pluginName string) (Crawler, error) {
+			return gradle.New(spec, rootDir, scmID, actionID)
+		},
+		spec: gradle.Spec{},
+	},
 	"helm": {
 		newFunc: func(spec any, rootDir string, scmID string, actionID, pluginName string) (Crawler, error) {
 			return helm.New(spec, rootDir, scmID, actionID)
@@ -215,6 +241,12 @@ var crawlerMap = map[string]struct {
 		},
 		spec: kubernetes.Spec{},
 	},
+	"kustomize": {
+		newFunc: func(spec any, rootDir string, scmID string, actionID, pluginName string) (Crawler, error) {
+			return kustomize.New(spec, rootDir, scmID, actionID)
+		},
+		spec: kustomize.Spec{},
+	},
 	"maven": {
 		newFunc: func(spec any, rootDir string, scmID string, actionID, pluginName string) (Crawler, error) {
 			return maven.New(spec, rootDir, scmID, actionID)
@@ -234,6 +266,20 @@ var crawlerMap = map[string]struct {
 		},
 		spec: npm.Spec{},
 	},
+	"nuget": {
+		newFunc: func(spec any, rootDir string, scmID string, actionID, pluginName string) (Crawler, error) {
+			return nuget.New(spec, rootDir, scmID, actionID)
+		},
+		spec:  nuget.Spec{},
+		alias: []string{"dotnet"},
+	},
+	"pipfile": {
+		newFunc: func(spec any, rootDir string, scmID string, actionID, pluginName string) (Crawler, error) {
+			return pip.New(spec, rootDir, scmID, actionID, pip.FlavorPipfile)
+		},
+		spec:  pip.Spec{},
+		alias: []string{"python/pipenv"},
+	},
 	"precommit": {
 		newFunc: func(spec any, rootDir string, scmID string, actionID, pluginName string) (Crawler, error) {
 			return precommit.New(spec, rootDir, scmID, actionID)
@@ -267,6 +313,13 @@ var crawlerMap = map[string]struct {
 		},
 		spec: fleet.Spec{},
 	},
+	"requirements": {
+		newFunc: func(spec any, rootDir string, scmID string, actionID, pluginName string) (Crawler, error) {
+			return pip.New(spec, rootDir, scmID, actionID, pip.FlavorRequirements)
+		},
+		spec:  pip.Spec{},
+		alias: []string{"python/pip"},
+	},
 	"terraform": {
 		newFunc: func(spec any, rootDir string, scmID string, actionID, pluginName string) (Crawler, error) {
 			return terraform.New(spec, rootDir, scmID, actionID)
@@ -0,0 +1,54 @@
+package pipeline
+
+import (
+	"sync"
+
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// initConcurrency lazily initializes the structures used to run resources concurrently.
+func (p *Pipeline) initConcurrency() {
+	p.concurrencyOnce.Do(func() {
+		parallelism := p.Options.Parallelism
+		if parallelism < 1 {
+			parallelism = 1
+		}
+		p.slots = make(chan struct{}, parallelism)
+		p.scmLocks = make(map[string]*sync.Mutex)
+	})
+}
+
+// acquireSlot blocks until the pipeline can execute one more resource
+// and returns a function releasing the slot.
+func (p *Pipeline) acquireSlot() (release func()) {
+	p.initConcurrency()
+
+	p.slots <- struct{}{}
+	return func() {
+		<-p.slots
+	}
+}
+
+// lockSCM serializes resources sharing the same scm working directory,
+// as a git repository can't be safely checked out or modified concurrently.
+// It returns a function releasing the lock. A nil scm is a no-op.
+func (p *Pipeline) lockSCM(s *scm.ScmHandler) (unlock func()) {
+	if s == nil || *s == nil {
+		return func() {}
+	}
+
+	p.initConcurrency()
+
+	directory := (*s).GetDirectory()
+
+	p.scmLocksMu.Lock()
+	m, ok := p.scmLocks[directory]
+	if !ok {
+		m = &sync.Mutex{}
+		p.scmLocks[directory] = m
+	}
+	p.scmLocksMu.Unlock()
+
+	m.Lock()
+	return m.Unlock
+}
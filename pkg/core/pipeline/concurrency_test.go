@@ -0,0 +1,68 @@
+package pipeline
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+func TestAcquireSlotBoundsConcurrency(t *testing.T) {
+	p := Pipeline{Options: Options{Parallelism: 2}}
+
+	var running, maxRunning atomic.Int32
+	wg := sync.WaitGroup{}
+
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release := p.acquireSlot()
+			defer release()
+
+			current := running.Add(1)
+			for {
+				previous := maxRunning.Load()
+				if current <= previous || maxRunning.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(2), maxRunning.Load())
+}
+
+func TestLockSCMSerializesSameDirectory(t *testing.T) {
+	p := Pipeline{Options: Options{Parallelism: 4}}
+
+	var handler scm.ScmHandler = &scm.MockScm{WorkingDir: "/tmp/updatecli/repo"}
+
+	// A nil scm must never block
+	p.lockSCM(nil)()
+
+	unlock := p.lockSCM(&handler)
+
+	acquired := make(chan struct{})
+	go func() {
+		other := p.lockSCM(&handler)
+		close(acquired)
+		other()
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("a second resource using the same scm must wait")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlock()
+	<-acquired
+}
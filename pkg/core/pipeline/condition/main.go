@@ -13,6 +13,7 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/jsonschema"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/when"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
@@ -43,6 +44,23 @@ type Config struct {
 	DisableSourceInput bool `yaml:",omitempty"`
 	// FailWhen allows to reverse a condition expected result from true to false.
 	FailWhen bool `yaml:",omitempty"`
+	// when defines a boolean expression of resources which must be true to run the condition.
+	// If the expression is false, the condition is skipped.
+	//
+	// The expression combines resource identifiers using the format "(resourceType#)resourceID"
+	// with the operators "&&", "||", "!", and parentheses.
+	// The resourceType is "condition" by default.
+	//
+	// An operand is true when:
+	//   * a source or a condition succeeded
+	//   * a target changed something
+	//
+	// example:
+	//   when: "(c1 && c2) || !source#latest"
+	//
+	// remark:
+	//   The resources used in the expression are evaluated before the current resource, like with "dependson".
+	When string `yaml:",omitempty"`
 }
 
 // Run tests if a specific condition is true
@@ -193,6 +211,13 @@ func (c *Config) Validate() error {
 		gotError = true
 	}
 
+	if len(c.When) > 0 {
+		if _, err := when.Parse(c.When); err != nil {
+			logrus.Errorln(err)
+			gotError = true
+		}
+	}
+
 	if len(missingParameters) > 0 {
 		logrus.Errorf("missing value for parameter(s) [%q]", strings.Join(missingParameters, ","))
 		gotError = true
@@ -8,12 +8,23 @@ func (p *Pipeline) updateCondition(id, result string) {
 	p.Conditions[id] = condition
 }
 
+// RunCondition runs a condition by id.
+// The pipeline lock must not be held by the caller, it's only acquired
+// to access the pipeline state so that conditions can run concurrently.
 func (p *Pipeline) RunCondition(ctx context.Context, id string) (r string, err error) {
+	p.mu.Lock()
 	condition := p.Conditions[id]
 	condition.Config = p.Config.Spec.Conditions[id]
 	condition.Result.Name = condition.Config.Name
+	source := p.Sources[condition.Config.SourceID].Output
+	p.mu.Unlock()
 
-	err = condition.Run(ctx, p.Sources[condition.Config.SourceID].Output)
+	unlock := p.lockSCM(condition.Scm)
+	err = condition.Run(ctx, source)
+	unlock()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
 
 	p.Conditions[id] = condition
 
@@ -53,6 +53,12 @@ type Pipeline struct {
 	// injected by the engine before the pipeline runs.
 	SourceCache *cache.SourceCache
 	tracer      trace.Tracer
+
+	// concurrencyOnce, slots and scmLocks bound and serialize resources executed concurrently
+	concurrencyOnce sync.Once
+	slots           chan struct{}
+	scmLocksMu      sync.Mutex
+	scmLocks        map[string]*sync.Mutex
 }
 
 // Init initialize an updatecli context based on its configuration
@@ -99,6 +105,8 @@ func (p *Pipeline) Init(config *config.Config, options Options) error {
 		}
 	}
 
+	p.setSparseCheckoutPaths()
+
 	// Init actions
 	for id, actionConfig := range config.Spec.Actions {
 		var err error
@@ -394,6 +402,13 @@ func (p *Pipeline) runFlowCallbackWithCtx(ctx context.Context, d *dag.DAG, id st
 	)
 	defer span.End()
 
+	// Each DAG node runs in its own goroutine, the number of slots bounds
+	// how many of them are executed concurrently.
+	release := p.acquireSlot()
+	defer release()
+
+	// p.mu guards the pipeline state, it is released while a resource is
+	// executed so that independent resources can run concurrently.
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
@@ -506,7 +521,9 @@ func (p *Pipeline) runFlowCallbackWithCtx(ctx context.Context, d *dag.DAG, id st
 		switch leaf.Category {
 		case sourceCategory:
 			sourceId := strings.ReplaceAll(id, "source#", "")
+			p.mu.Unlock()
 			r, e := p.RunSource(ctx, sourceId)
+			p.mu.Lock()
 			if e != nil {
 				displayError(e)
 				err = e
@@ -519,7 +536,9 @@ func (p *Pipeline) runFlowCallbackWithCtx(ctx context.Context, d *dag.DAG, id st
 
 		case conditionCategory:
 			conditionId := strings.ReplaceAll(id, "condition#", "")
+			p.mu.Unlock()
 			r, e := p.RunCondition(ctx, conditionId)
+			p.mu.Lock()
 			if e != nil {
 				displayError(e)
 				err = e
@@ -532,7 +551,9 @@ func (p *Pipeline) runFlowCallbackWithCtx(ctx context.Context, d *dag.DAG, id st
 
 		case targetCategory:
 			targetId := strings.ReplaceAll(id, "target#", "")
+			p.mu.Unlock()
 			r, changed, e := p.RunTarget(ctx, targetId, depsSourceIDs)
+			p.mu.Lock()
 			if e != nil {
 				displayError(e)
 				err = e
@@ -687,6 +708,8 @@ func (p *Pipeline) Update() error {
 		}
 	}
 
+	p.setSparseCheckoutPaths()
+
 	// Update scm pointer for each actions
 	for id := range p.Config.Spec.Actions {
 		action := p.Actions[id]
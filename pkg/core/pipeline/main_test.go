@@ -2,6 +2,7 @@ package pipeline
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/sirupsen/logrus"
@@ -53,37 +54,40 @@ func TestRun(t *testing.T) {
 		},
 	}
 
-	for _, data := range testdata {
-		c, _ := config.New(config.Option{
-			ManifestFile: data.confPath,
-		}, []string{}, map[string]string{})
-		p := Pipeline{}
-		_ = p.Init(&c[0], Options{
-			Target: target.Options{
-				DryRun: true,
-			},
-		})
-		t.Run(p.Config.Spec.Name, func(t *testing.T) {
-			err := p.Run(context.Background())
-			if err != nil {
-				logrus.Errorf("Got error running test: %s", err)
-			}
-			require.NoError(t, err)
+	// Results must not depend on whether independent resources run concurrently.
+	for _, parallelism := range []int{1, 4} {
+		for _, data := range testdata {
+			c, _ := config.New(config.Option{
+				ManifestFile: data.confPath,
+			}, []string{}, map[string]string{})
+			p := Pipeline{}
+			_ = p.Init(&c[0], Options{
+				Target: target.Options{
+					DryRun: true,
+				},
+				Parallelism: parallelism,
+			})
+			t.Run(fmt.Sprintf("%s/parallelism-%d", p.Config.Spec.Name, parallelism), func(t *testing.T) {
+				err := p.Run(context.Background())
+				if err != nil {
+					logrus.Errorf("Got error running test: %s", err)
+				}
+				require.NoError(t, err)
 
-			require.Equal(t, len(data.expectedSourcesResult), len(p.Sources))
-			for id, result := range p.Sources {
-				require.Equal(t, data.expectedSourcesResult[id], result.Result.Result)
-			}
-			require.Equal(t, len(data.expectedConditionsResult), len(p.Conditions))
-			for id, result := range p.Conditions {
-				require.Equal(t, data.expectedConditionsResult[id], result.Result.Result)
-			}
-			require.Equal(t, len(data.expectedTargetsResult), len(p.Targets))
-			for id, result := range p.Targets {
-				require.Equal(t, data.expectedTargetsResult[id], result.Result.Result)
-			}
-			require.Equal(t, data.expectedPipelineResult, p.Report.Result)
-		})
+				require.Equal(t, len(data.expectedSourcesResult), len(p.Sources))
+				for id, result := range p.Sources {
+					require.Equal(t, data.expectedSourcesResult[id], result.Result.Result)
+				}
+				require.Equal(t, len(data.expectedConditionsResult), len(p.Conditions))
+				for id, result := range p.Conditions {
+					require.Equal(t, data.expectedConditionsResult[id], result.Result.Result)
+				}
+				require.Equal(t, len(data.expectedTargetsResult), len(p.Targets))
+				for id, result := range p.Targets {
+					require.Equal(t, data.expectedTargetsResult[id], result.Result.Result)
+				}
+				require.Equal(t, data.expectedPipelineResult, p.Report.Result)
+			})
+		}
 	}
-
 }
@@ -10,4 +10,7 @@ type Options struct {
 	Target target.Options
 	// DisableChangelog disables changelog retrieval for targets.
 	DisableChangelog bool
+	// Parallelism defines the maximum number of resources executed concurrently.
+	// Resources bound to the same scm are always executed one at a time.
+	Parallelism int
 }
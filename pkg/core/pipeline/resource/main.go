@@ -9,9 +9,14 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/core/transformer"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/awsami"
+	azureDevOpsBranch "github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/branch"
+	azureDevOpsTag "github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/tag"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/bazelmod"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/bazelregistry"
+	bitbucketBranch "github.com/updatecli/updatecli/pkg/plugins/resources/bitbucket/branch"
+	bitbucketTag "github.com/updatecli/updatecli/pkg/plugins/resources/bitbucket/tag"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/cargopackage"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/composer"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/csv"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/dockerdigest"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/dockerfile"
@@ -22,6 +27,8 @@ import (
 	giteaBranch "github.com/updatecli/updatecli/pkg/plugins/resources/gitea/branch"
 	giteaRelease "github.com/updatecli/updatecli/pkg/plugins/resources/gitea/release"
 	giteaTag "github.com/updatecli/updatecli/pkg/plugins/resources/gitea/tag"
+	githubBranch "github.com/updatecli/updatecli/pkg/plugins/resources/github/branch"
+	githubTag "github.com/updatecli/updatecli/pkg/plugins/resources/github/tag"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/githubrelease"
 	gitlabBranch "github.com/updatecli/updatecli/pkg/plugins/resources/gitlab/branch"
 	gitlabRelease "github.com/updatecli/updatecli/pkg/plugins/resources/gitlab/release"
@@ -35,10 +42,15 @@ import (
 	"github.com/updatecli/updatecli/pkg/plugins/resources/jenkins"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/json"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/maven"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/nodejs"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/npm"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/nuget"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/pypi"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/rubygems"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/schedule"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/shell"
 	stashBranch "github.com/updatecli/updatecli/pkg/plugins/resources/stash/branch"
+	stashRelease "github.com/updatecli/updatecli/pkg/plugins/resources/stash/release"
 	stashTag "github.com/updatecli/updatecli/pkg/plugins/resources/stash/tag"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/systemd"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/temurin"
@@ -71,6 +83,7 @@ type ResourceConfig struct {
 	//remarks:
 	//  * The parameters "sourceid" and "conditionsids" affect the order of resource execution.
 	//  * To avoid circular dependencies, the depended resource may need to remove any conditionids or set "disablesourceinput to true".
+	//  * Conditions and targets also accept a "when" boolean expression, such as "(c1 && c2) || !c3", to combine more than two resources.
 	DependsOn []string `yaml:",omitempty"`
 	// name specifies the resource name
 	Name string `yaml:",omitempty"`
@@ -103,6 +116,14 @@ func New(rs ResourceConfig) (resource Resource, err error) {
 
 		return awsami.New(rs.Spec)
 
+	case "azuredevops/branch":
+
+		return azureDevOpsBranch.New(rs.Spec)
+
+	case "azuredevops/tag":
+
+		return azureDevOpsTag.New(rs.Spec)
+
 	case "bazelmod":
 
 		return bazelmod.New(rs.Spec)
@@ -111,10 +132,22 @@ func New(rs ResourceConfig) (resource Resource, err error) {
 
 		return bazelregistry.New(rs.Spec)
 
+	case "bitbucket/branch":
+
+		return bitbucketBranch.New(rs.Spec)
+
+	case "bitbucket/tag":
+
+		return bitbucketTag.New(rs.Spec)
+
 	case "cargopackage":
 
 		return cargopackage.New(rs.Spec, rs.SCMID != "")
 
+	case "composer":
+
+		return composer.New(rs.Spec)
+
 	case "csv":
 
 		return csv.New(rs.Spec)
@@ -151,6 +184,14 @@ func New(rs ResourceConfig) (resource Resource, err error) {
 
 		return giteaRelease.New(rs.Spec)
 
+	case "github/branch":
+
+		return githubBranch.New(rs.Spec)
+
+	case "github/tag":
+
+		return githubTag.New(rs.Spec)
+
 	case "githubrelease":
 
 		return githubrelease.New(rs.Spec)
@@ -211,14 +252,30 @@ func New(rs ResourceConfig) (resource Resource, err error) {
 
 		return maven.New(rs.Spec)
 
+	case "nodejs":
+
+		return nodejs.New(rs.Spec)
+
 	case "npm":
 
 		return npm.New(rs.Spec)
 
+	case "nuget":
+
+		return nuget.New(rs.Spec)
+
 	case "pypi":
 
 		return pypi.New(rs.Spec)
 
+	case "rubygems":
+
+		return rubygems.New(rs.Spec)
+
+	case "schedule":
+
+		return schedule.New(rs.Spec)
+
 	case "shell":
 
 		return shell.New(rs.Spec)
@@ -227,6 +284,10 @@ func New(rs ResourceConfig) (resource Resource, err error) {
 
 		return stashBranch.New(rs.Spec)
 
+	case "stash/release":
+
+		return stashRelease.New(rs.Spec)
+
 	case "stash/tag":
 
 		return stashTag.New(rs.Spec)
@@ -297,9 +358,14 @@ type Resource interface {
 func GetResourceMapping() map[string]interface{} {
 	return map[string]interface{}{
 		"aws/ami":            &awsami.Spec{},
+		"azuredevops/branch": &azureDevOpsBranch.Spec{},
+		"azuredevops/tag":    &azureDevOpsTag.Spec{},
 		"bazelmod":           &bazelmod.Spec{},
 		"bazelregistry":      &bazelregistry.Spec{},
+		"bitbucket/branch":   &bitbucketBranch.Spec{},
+		"bitbucket/tag":      &bitbucketTag.Spec{},
 		"cargopackage":       &cargopackage.Spec{},
+		"composer":           &composer.Spec{},
 		"csv":                &csv.Spec{},
 		"dockerdigest":       &dockerdigest.Spec{},
 		"dockerfile":         &dockerfile.Spec{},
@@ -314,6 +380,8 @@ func GetResourceMapping() map[string]interface{} {
 		"gitlab/branch":      &gitlabBranch.Spec{},
 		"gitlab/release":     &gitlabRelease.Spec{},
 		"gitlab/tag":         &gitlabTag.Spec{},
+		"github/branch":      &githubBranch.Spec{},
+		"github/tag":         &githubTag.Spec{},
 		"githubrelease":      &githubrelease.Spec{},
 		"golang":             &golang.Spec{},
 		"golang/gomod":       &gomod.Spec{},
@@ -324,10 +392,15 @@ func GetResourceMapping() map[string]interface{} {
 		"jenkins":            &jenkins.Spec{},
 		"json":               &json.Spec{},
 		"maven":              &maven.Spec{},
+		"nodejs":             &nodejs.Spec{},
 		"npm":                &npm.Spec{},
+		"nuget":              &nuget.Spec{},
 		"pypi":               &pypi.Spec{},
+		"rubygems":           &rubygems.Spec{},
+		"schedule":           &schedule.Spec{},
 		"shell":              &shell.Spec{},
 		"stash/branch":       &stashBranch.Spec{},
+		"stash/release":      &stashRelease.Spec{},
 		"stash/tag":          &stashTag.Spec{},
 		"systemd":            &systemd.Spec{},
 		"temurin":            &temurin.Spec{},
@@ -0,0 +1,169 @@
+package scm
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// branchesKey is the scm spec key listing the branches to work on
+	branchesKey = "branches"
+	// branchKey is the scm spec key defining the branch to work on
+	branchKey = "branch"
+	// branchRegexPrefix identifies a branch defined as a regular expression
+	branchRegexPrefix = "regex:"
+)
+
+// ErrNoBranchMatched is returned when none of the scm branches match a remote branch
+var ErrNoBranchMatched = errors.New("no remote branch matching the scm branches")
+
+// BranchPatterns returns the branch names, glob patterns, or regular expressions
+// defined by the scm "branches" setting.
+func (c Config) BranchPatterns() ([]string, error) {
+	spec, ok := c.Spec.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	for key, value := range spec {
+		if !strings.EqualFold(key, branchesKey) {
+			continue
+		}
+
+		var patterns []string
+		if err := mapstructure.Decode(value, &patterns); err != nil {
+			return nil, fmt.Errorf("decoding scm branches: %w", err)
+		}
+
+		return patterns, nil
+	}
+
+	return nil, nil
+}
+
+// ResolveBranches returns the branches the scm works on, based on its "branches" setting.
+// Glob patterns and regular expressions are resolved against the remote branches.
+// It returns nil if the scm doesn't define any branches.
+func (c Config) ResolveBranches(pipelineID string) ([]string, error) {
+	patterns, err := c.BranchPatterns()
+	if err != nil || len(patterns) == 0 {
+		return nil, err
+	}
+
+	if !slices.ContainsFunc(patterns, isBranchPattern) {
+		return MatchBranches(patterns, nil)
+	}
+
+	s, err := New(c.WithBranch(""), pipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Handler == nil {
+		return nil, fmt.Errorf("scm of kind %q doesn't support branches", c.Kind)
+	}
+
+	remoteBranches, err := s.Handler.GetRemoteBranches()
+	if err != nil {
+		return nil, fmt.Errorf("retrieving remote branches from %q: %w", s.Handler.GetURL(), err)
+	}
+
+	return MatchBranches(patterns, remoteBranches)
+}
+
+// WithBranch returns a copy of the scm configuration working on a single branch.
+// An empty branch only removes the "branches" setting.
+func (c Config) WithBranch(branch string) *Config {
+	newConfig := c
+
+	spec, ok := c.Spec.(map[string]interface{})
+	if !ok {
+		return &newConfig
+	}
+
+	newSpec := make(map[string]interface{}, len(spec))
+	for key, value := range spec {
+		switch {
+		case strings.EqualFold(key, branchesKey):
+			continue
+		case strings.EqualFold(key, branchKey) && branch != "":
+			continue
+		}
+		newSpec[key] = value
+	}
+
+	if branch != "" {
+		newSpec[branchKey] = branch
+	}
+
+	newConfig.Spec = newSpec
+
+	return &newConfig
+}
+
+// MatchBranches returns the branches matching at least one of the patterns, in the patterns order.
+// A pattern is either an exact branch name, a glob pattern, or a regular expression prefixed by "regex:".
+// Exact branch names are returned even if they are not part of the remote branches.
+func MatchBranches(patterns, remoteBranches []string) ([]string, error) {
+	var branches []string
+
+	for _, pattern := range patterns {
+		var matches []string
+
+		switch {
+		case strings.HasPrefix(pattern, branchRegexPrefix):
+			re, err := regexp.Compile(strings.TrimPrefix(pattern, branchRegexPrefix))
+			if err != nil {
+				return nil, fmt.Errorf("invalid branch regular expression %q: %w", pattern, err)
+			}
+
+			for _, remoteBranch := range remoteBranches {
+				if re.MatchString(remoteBranch) {
+					matches = append(matches, remoteBranch)
+				}
+			}
+
+		case isBranchPattern(pattern):
+			for _, remoteBranch := range remoteBranches {
+				match, err := path.Match(pattern, remoteBranch)
+				if err != nil {
+					return nil, fmt.Errorf("invalid branch pattern %q: %w", pattern, err)
+				}
+				if match {
+					matches = append(matches, remoteBranch)
+				}
+			}
+
+		case pattern != "":
+			matches = []string{pattern}
+		}
+
+		if len(matches) == 0 {
+			logrus.Warningf("No remote branch matching %q", pattern)
+		}
+
+		for _, match := range matches {
+			if !slices.Contains(branches, match) {
+				branches = append(branches, match)
+			}
+		}
+	}
+
+	if len(branches) == 0 {
+		return nil, ErrNoBranchMatched
+	}
+
+	return branches, nil
+}
+
+// isBranchPattern returns true if the branch needs to be resolved against the remote branches
+func isBranchPattern(branch string) bool {
+	return strings.HasPrefix(branch, branchRegexPrefix) || strings.ContainsAny(branch, "*?[")
+}
@@ -0,0 +1,125 @@
+package scm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchBranches(t *testing.T) {
+	remoteBranches := []string{"main", "release-1.x", "release-2.x", "release/3.x", "feature-1"}
+
+	tests := []struct {
+		name             string
+		patterns         []string
+		expectedBranches []string
+		wantErr          bool
+	}{
+		{
+			name:             "exact branch names",
+			patterns:         []string{"main", "release-1.x"},
+			expectedBranches: []string{"main", "release-1.x"},
+		},
+		{
+			name:             "exact branch name not part of the remote branches",
+			patterns:         []string{"develop"},
+			expectedBranches: []string{"develop"},
+		},
+		{
+			name:             "glob pattern",
+			patterns:         []string{"main", "release-*"},
+			expectedBranches: []string{"main", "release-1.x", "release-2.x"},
+		},
+		{
+			name:             "glob pattern with a slash",
+			patterns:         []string{"release/*"},
+			expectedBranches: []string{"release/3.x"},
+		},
+		{
+			name:             "regular expression",
+			patterns:         []string{`regex:^release[-/][2-3]\.x$`},
+			expectedBranches: []string{"release-2.x", "release/3.x"},
+		},
+		{
+			name:             "duplicated matches",
+			patterns:         []string{"release-2.x", "release-*"},
+			expectedBranches: []string{"release-2.x", "release-1.x"},
+		},
+		{
+			name:     "no matching branch",
+			patterns: []string{"hotfix-*"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid regular expression",
+			patterns: []string{"regex:release-("},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBranches, err := MatchBranches(tt.patterns, remoteBranches)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedBranches, gotBranches)
+		})
+	}
+}
+
+func TestWithBranch(t *testing.T) {
+	config := Config{
+		Kind: "github",
+		Spec: map[string]interface{}{
+			"owner":    "updatecli",
+			"branch":   "main",
+			"Branches": []interface{}{"main", "release-*"},
+		},
+	}
+
+	patterns, err := config.BranchPatterns()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main", "release-*"}, patterns)
+
+	gotConfig := config.WithBranch("release-1.x")
+	assert.Equal(t, map[string]interface{}{
+		"owner":  "updatecli",
+		"branch": "release-1.x",
+	}, gotConfig.Spec)
+
+	gotConfig = config.WithBranch("")
+	assert.Equal(t, map[string]interface{}{
+		"owner":  "updatecli",
+		"branch": "main",
+	}, gotConfig.Spec)
+
+	// The original configuration must not be modified
+	assert.Len(t, config.Spec, 3)
+}
+
+func TestResolveBranchesWithoutPatterns(t *testing.T) {
+	config := Config{
+		Kind: "git",
+		Spec: map[string]interface{}{
+			"url": "https://github.com/updatecli/updatecli.git",
+		},
+	}
+
+	branches, err := config.ResolveBranches("")
+	require.NoError(t, err)
+	assert.Nil(t, branches)
+
+	config.Spec = map[string]interface{}{
+		"url":      "https://github.com/updatecli/updatecli.git",
+		"branches": []interface{}{"main", "v1"},
+	}
+
+	// Exact branch names don't require to retrieve the remote branches
+	branches, err = config.ResolveBranches("")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main", "v1"}, branches)
+}
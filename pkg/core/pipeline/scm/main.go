@@ -44,7 +44,9 @@ type ScmHandler interface {
 	IsRemoteWorkingBranchExist() (bool, error)
 	GetBranches() (sourceBranch, workingBranch, targetBranch string)
 	GetURL() string
+	GetRemoteBranches() ([]string, error)
 	Summary() string
+	SetSparseCheckoutPaths(paths []string)
 }
 
 func New(config *Config, pipelineID string) (Scm, error) {
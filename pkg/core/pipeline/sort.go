@@ -7,6 +7,7 @@ import (
 
 	"github.com/heimdalr/dag"
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/when"
 	"go.yaml.in/yaml/v3"
 )
 
@@ -45,9 +46,11 @@ type Node struct {
 	DependsOnChange bool
 	Result          string
 	Changed         bool
+	// When is the boolean expression of resources which must be true to run the resource
+	When *when.Expression
 }
 
-func addResourceToDag(dag *dag.DAG, id, Category string, DependsOn []string, DependsOnChange bool, additionalDependencies []string) (err error) {
+func addResourceToDag(dag *dag.DAG, id, Category string, DependsOn []string, DependsOnChange bool, When string, additionalDependencies []string) (err error) {
 	// Add the category to the id
 	ID := fmt.Sprintf("%s#%s", Category, id)
 	// Craft the dendencies
@@ -63,8 +66,15 @@ func addResourceToDag(dag *dag.DAG, id, Category string, DependsOn []string, Dep
 	for _, dependency := range additionalDependencies {
 		deps = append(deps, Dependency{ID: dependency, Operator: andBooleanOperator})
 	}
+	var whenExpression *when.Expression
+	if When != "" {
+		whenExpression, err = when.Parse(When)
+		if err != nil {
+			return err
+		}
+	}
 	// Add the node to the graph
-	node := Node{ID: ID, Category: Category, DependsOn: deps, DependsOnChange: DependsOnChange}
+	node := Node{ID: ID, Category: Category, DependsOn: deps, DependsOnChange: DependsOnChange, When: whenExpression}
 	err = dag.AddVertexByID(ID, node)
 	if err != nil {
 		return nil
@@ -86,7 +96,15 @@ func handleResourceDependencies(dag *dag.DAG, ID, Category string) (err error) {
 		return fmt.Errorf("could not reconstruct node")
 	}
 
-	for _, dep := range node.DependsOn {
+	dependencies := node.DependsOn
+	if node.When != nil {
+		// Resources used in the when expression must be evaluated first
+		for _, operand := range node.When.Operands() {
+			dependencies = append(dependencies, Dependency{ID: operand})
+		}
+	}
+
+	for _, dep := range dependencies {
 		_, err = dag.GetVertex(dep.ID)
 		if err != nil {
 			return ErrNotValidDependsOn
@@ -104,6 +122,7 @@ func handleResourceDependencies(dag *dag.DAG, ID, Category string) (err error) {
 				// 2. SourceID (For `conditions` and `targets`)
 				// 3. ConditionIds (For `targets`)
 				// 4. RunTime Deps
+				// 5. When
 				// We can ignore this
 				err = nil
 			} else {
@@ -140,7 +159,7 @@ func (p *Pipeline) SortedResources() (result *dag.DAG, err error) {
 		if err != nil {
 			return result, err
 		}
-		err = addResourceToDag(d, id, sourceCategory, resource.Config.DependsOn, false, additionalDepIds)
+		err = addResourceToDag(d, id, sourceCategory, resource.Config.DependsOn, false, "", additionalDepIds)
 		if err != nil {
 			return result, err
 		}
@@ -159,7 +178,7 @@ func (p *Pipeline) SortedResources() (result *dag.DAG, err error) {
 		if resource.Config.SourceID != "" {
 			additionalDepIds = append(additionalDepIds, fmt.Sprintf("source#%s", resource.Config.SourceID))
 		}
-		err = addResourceToDag(d, id, conditionCategory, resource.Config.DependsOn, false, additionalDepIds)
+		err = addResourceToDag(d, id, conditionCategory, resource.Config.DependsOn, false, resource.Config.When, additionalDepIds)
 		if err != nil {
 			return result, err
 		}
@@ -181,13 +200,14 @@ func (p *Pipeline) SortedResources() (result *dag.DAG, err error) {
 		// For targets we need to handle the condition sorting
 		// By default, a target depends on all conditions, and they are treated as an and dependency
 		// This behavior can be deactivated by setting DisableConditions to false
-		if !resource.Config.DisableConditions {
+		// or replaced by a when expression
+		if !resource.Config.DisableConditions && resource.Config.When == "" {
 			// if no condition is defined, we evaluate all conditions
 			for conditionID := range p.Conditions {
 				additionalDepIds = append(additionalDepIds, fmt.Sprintf("condition#%s", conditionID))
 			}
 		}
-		err = addResourceToDag(d, id, targetCategory, resource.Config.DependsOn, resource.Config.DependsOnChange, additionalDepIds)
+		err = addResourceToDag(d, id, targetCategory, resource.Config.DependsOn, resource.Config.DependsOnChange, resource.Config.When, additionalDepIds)
 		if err != nil {
 			return result, err
 		}
@@ -848,6 +848,51 @@ func TestSortedResourcesKeys(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Scenario 13: When expression",
+			Conditions: map[string]condition.Config{
+				"1": {
+					ResourceConfig: resource.ResourceConfig{
+						Kind: "shell",
+					},
+					DisableSourceInput: true,
+				},
+				"2": {
+					ResourceConfig: resource.ResourceConfig{
+						Kind: "shell",
+					},
+					DisableSourceInput: true,
+				},
+				"3": {
+					ResourceConfig: resource.ResourceConfig{
+						Kind: "shell",
+					},
+					DisableSourceInput: true,
+					When:               "1 && !2",
+				},
+			},
+			Targets: map[string]target.Config{
+				"1": {
+					ResourceConfig: resource.ResourceConfig{
+						Kind: "shell",
+					},
+					DisableSourceInput: true,
+					When:               "3 || condition#1",
+				},
+			},
+			ExpectedResult: [][]ResultLeaf{
+				{
+					{Id: "condition#1", Parents: []string{"root"}},
+					{Id: "condition#2", Parents: []string{"root"}},
+				},
+				{
+					{Id: "condition#3", Parents: []string{"root", "condition#1", "condition#2"}},
+				},
+				{
+					{Id: "target#1", Parents: []string{"root", "condition#1", "condition#3"}},
+				},
+			},
+		},
 	}
 
 	for i := range testdata {
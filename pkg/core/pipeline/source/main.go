@@ -16,6 +16,7 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
 // Source defines how a value is retrieved from a specific source
@@ -67,23 +68,12 @@ func (s *Source) Run(ctx context.Context, sourceCache *cache.SourceCache) (err e
 	}
 
 	cacheKey := cache.Key(s.Config.ResourceConfig, scmIdentity)
-	cacheHit := false
 
-	if sourceCache != nil {
-		if entry, ok := sourceCache.Get(cacheKey); ok {
-			logrus.Infof("source cache hit for %q", s.Config.Name)
-			s.Result.Information = entry.Information
-			s.Result.Description = entry.Description
-			s.Result.Result = entry.Result
-			cacheHit = true
-		}
-	}
-
-	if !cacheHit {
+	execute := func() (cache.SourceEntry, error) {
 		source, err := resource.New(s.Config.ResourceConfig)
 		if err != nil {
 			s.Result.Result = result.FAILURE
-			return err
+			return cache.SourceEntry{Result: s.Result.Result}, err
 		}
 
 		workingDir := ""
@@ -93,7 +83,7 @@ func (s *Source) Run(ctx context.Context, sourceCache *cache.SourceCache) (err e
 			pwd, err := os.Getwd()
 			if err != nil {
 				s.Result.Result = result.FAILURE
-				return err
+				return cache.SourceEntry{Result: s.Result.Result}, err
 			}
 
 			workingDir = pwd
@@ -103,17 +93,51 @@ func (s *Source) Run(ctx context.Context, sourceCache *cache.SourceCache) (err e
 			err = SCM.Checkout()
 			if err != nil {
 				s.Result.Result = result.FAILURE
-				return err
+				return cache.SourceEntry{Result: s.Result.Result}, err
 			}
 
 			workingDir = SCM.GetDirectory()
 		}
 
 		err = source.Source(ctx, workingDir, s.Result)
+		/*
+			Every version is still cooling down, which is an expected state of
+			the versionfilter age rather than a failure, so the source is skipped instead.
+		*/
+		if errors.Is(err, version.ErrNoVersionMatchingAge) {
+			s.Result.Result = result.SKIPPED
+			s.Result.Description = err.Error()
+			err = nil
+		}
+
+		if err != nil {
+			s.Result.Result = result.FAILURE
+		}
+
+		return cache.SourceEntry{
+			Information: s.Result.Information,
+			Description: s.Result.Description,
+			Result:      s.Result.Result,
+		}, err
+	}
+
+	if sourceCache == nil {
+		if _, err = execute(); err != nil {
+			return err
+		}
+	} else {
+		entry, shared, err := sourceCache.Resolve(cacheKey, execute)
 		if err != nil {
 			s.Result.Result = result.FAILURE
 			return err
 		}
+
+		if shared {
+			logrus.Infof("source cache hit for %q", s.Config.Name)
+			s.Result.Information = entry.Information
+			s.Result.Description = entry.Description
+			s.Result.Result = entry.Result
+		}
 	}
 
 	s.Output = s.Result.Information
@@ -129,14 +153,6 @@ func (s *Source) Run(ctx context.Context, sourceCache *cache.SourceCache) (err e
 		}
 	}
 
-	if !cacheHit && sourceCache != nil && s.Result.Result == result.SUCCESS {
-		sourceCache.Set(cacheKey, cache.SourceEntry{
-			Information: s.Result.Information,
-			Description: s.Result.Description,
-			Result:      s.Result.Result,
-		})
-	}
-
 	if len(s.Output) == 0 && s.Result.Result == result.SUCCESS {
 		logrus.Debugln("empty source detected")
 	}
@@ -8,12 +8,22 @@ func (p *Pipeline) updateSource(id, result string) {
 	p.Sources[id] = source
 }
 
+// RunSource runs a source by id.
+// The pipeline lock must not be held by the caller, it's only acquired
+// to access the pipeline state so that sources can run concurrently.
 func (p *Pipeline) RunSource(ctx context.Context, id string) (r string, err error) {
+	p.mu.Lock()
 	source := p.Sources[id]
 	source.Config = p.Config.Spec.Sources[id]
 	source.Result.Name = source.Config.Name
+	p.mu.Unlock()
 
+	unlock := p.lockSCM(source.Scm)
 	err = source.Run(ctx, p.SourceCache)
+	unlock()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
 
 	p.Sources[id] = source
 
@@ -0,0 +1,77 @@
+package pipeline
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
+)
+
+// sparseCheckoutIgnoredKinds lists the resource kinds which don't read files from the scm working directory
+var sparseCheckoutIgnoredKinds = []string{"gitbranch", "gitcommit", "gittag"}
+
+// resourceFiles contains the settings used by resources to reference files
+type resourceFiles struct {
+	File  string
+	Files []string
+}
+
+// setSparseCheckoutPaths provides to each scm the files referenced by the resources depending on it,
+// so the sparse checkout can be automatically defined.
+func (p *Pipeline) setSparseCheckoutPaths() {
+	resources := []resource.ResourceConfig{}
+	for _, s := range p.Config.Spec.Sources {
+		resources = append(resources, s.ResourceConfig)
+	}
+	for _, c := range p.Config.Spec.Conditions {
+		resources = append(resources, c.ResourceConfig)
+	}
+	for _, t := range p.Config.Spec.Targets {
+		resources = append(resources, t.ResourceConfig)
+	}
+
+	for id, s := range p.SCMs {
+		if s.Handler == nil {
+			continue
+		}
+
+		paths, ok := getSparseCheckoutPaths(id, resources)
+		if !ok {
+			logrus.Debugf("a resource depending on scm %q doesn't reference any file, only the configured sparse checkout paths are used", id)
+			paths = nil
+		}
+
+		s.Handler.SetSparseCheckoutPaths(paths)
+	}
+}
+
+// getSparseCheckoutPaths returns the files referenced by the resources depending on the scm.
+// It returns false if one of those resources doesn't reference any file.
+func getSparseCheckoutPaths(scmID string, resources []resource.ResourceConfig) ([]string, bool) {
+	var paths []string
+
+	for _, r := range resources {
+		if r.SCMID != scmID || slices.Contains(sparseCheckoutIgnoredKinds, strings.ToLower(r.Kind)) {
+			continue
+		}
+
+		files := resourceFiles{}
+		if err := mapstructure.Decode(r.Spec, &files); err != nil {
+			return nil, false
+		}
+
+		if files.File != "" {
+			files.Files = append(files.Files, files.File)
+		}
+
+		if len(files.Files) == 0 {
+			return nil, false
+		}
+
+		paths = append(paths, files.Files...)
+	}
+
+	return paths, true
+}
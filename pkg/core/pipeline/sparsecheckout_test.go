@@ -0,0 +1,46 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
+)
+
+func TestGetSparseCheckoutPaths(t *testing.T) {
+	tests := []struct {
+		name          string
+		resources     []resource.ResourceConfig
+		expectedPaths []string
+		expectedOK    bool
+	}{
+		{
+			name: "file and files settings",
+			resources: []resource.ResourceConfig{
+				{Kind: "yaml", SCMID: "default", Spec: map[string]interface{}{"file": "charts/app/values.yaml", "key": "$.image.tag"}},
+				{Kind: "file", SCMID: "default", Spec: map[string]interface{}{"files": []interface{}{"README.md", "docs/index.md"}}},
+				{Kind: "gittag", SCMID: "default", Spec: map[string]interface{}{"versionfilter": map[string]interface{}{"kind": "semver"}}},
+				{Kind: "yaml", SCMID: "other", Spec: map[string]interface{}{"file": "other.yaml"}},
+				{Kind: "dockerimage", Spec: map[string]interface{}{"image": "updatecli/updatecli"}},
+			},
+			expectedPaths: []string{"charts/app/values.yaml", "README.md", "docs/index.md"},
+			expectedOK:    true,
+		},
+		{
+			name: "resource without file",
+			resources: []resource.ResourceConfig{
+				{Kind: "yaml", SCMID: "default", Spec: map[string]interface{}{"file": "values.yaml"}},
+				{Kind: "shell", SCMID: "default", Spec: map[string]interface{}{"command": "make update"}},
+			},
+			expectedOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			paths, ok := getSparseCheckoutPaths("default", tt.resources)
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.ElementsMatch(t, tt.expectedPaths, paths)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/jsonschema"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/when"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
@@ -76,6 +77,24 @@ type Config struct {
 	//  It's possible to only monitor specific conditions by setting disableconditions to true
 	//  and using DependsOn with `condition#conditionid` keys
 	DisableConditions bool `yaml:"disableconditions,omitempty"`
+	// when defines a boolean expression of resources which must be true to run the target.
+	// If the expression is false, the target is skipped.
+	//
+	// The expression combines resource identifiers using the format "(resourceType#)resourceID"
+	// with the operators "&&", "||", "!", and parentheses.
+	// The resourceType is "condition" by default.
+	//
+	// An operand is true when:
+	//   * a source or a condition succeeded
+	//   * a target changed something
+	//
+	// example:
+	//   when: "(c1 && c2) || !source#latest"
+	//
+	// remark:
+	//   * The resources used in the expression are evaluated before the current resource, like with "dependson".
+	//   * When defined, the target doesn't depend on all conditions anymore, only on the ones used in the expression.
+	When string `yaml:",omitempty"`
 }
 
 // Check verifies if mandatory Targets parameters are provided and return false if not.
@@ -333,6 +352,13 @@ func (c *Config) Validate() error {
 		gotError = true
 	}
 
+	if len(c.When) > 0 {
+		if _, err := when.Parse(c.When); err != nil {
+			logrus.Errorln(err)
+			gotError = true
+		}
+	}
+
 	if len(missingParameters) > 0 {
 		logrus.Errorf("missing value for parameter(s) [%q]", strings.Join(missingParameters, ","))
 		gotError = true
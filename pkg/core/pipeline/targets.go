@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
@@ -22,16 +23,23 @@ func (p *Pipeline) updateTarget(id, result string) {
 }
 
 // RunTarget run a target by id
+// The pipeline lock must not be held by the caller, it's only acquired
+// to access the pipeline state so that targets can run concurrently.
 func (p *Pipeline) RunTarget(ctx context.Context, id string, sourceIds []string) (r string, changed bool, err error) {
+	p.mu.Lock()
 	target := p.Targets[id]
 	target.Config = p.Config.Spec.Targets[id]
 	// Ensure the result named contains the up to date target name after templating
 	target.Result.Name = target.Config.Name
 	target.Result.DryRun = target.DryRun
+	sources := maps.Clone(p.Sources)
+	p.mu.Unlock()
+
+	unlock := p.lockSCM(target.Scm)
+	err = target.Run(ctx, sources[target.Config.SourceID].Output, &p.Options.Target)
+	unlock()
 
-	err = target.Run(ctx, p.Sources[target.Config.SourceID].Output, &p.Options.Target)
 	if err != nil {
-		p.Report.Result = result.FAILURE
 		target.Result.Result = result.FAILURE
 		err = fmt.Errorf("%s", err)
 	}
@@ -53,7 +61,7 @@ func (p *Pipeline) RunTarget(ctx context.Context, id string, sourceIds []string)
 		if changelogSourceID != "" {
 			// Once the source is executed, then it can retrieve its changelog
 			// Any error means an empty changelog
-			if source, found := p.Sources[changelogSourceID]; found {
+			if source, found := sources[changelogSourceID]; found {
 				c, err := resource.New(source.Config.ResourceConfig)
 
 				if err == nil {
@@ -73,6 +81,13 @@ func (p *Pipeline) RunTarget(ctx context.Context, id string, sourceIds []string)
 		}
 	}
 
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err != nil {
+		p.Report.Result = result.FAILURE
+	}
+
 	p.Targets[id] = target
 
 	return target.Result.Result, target.Result.Changed, err
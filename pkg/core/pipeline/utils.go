@@ -2,6 +2,7 @@ package pipeline
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"text/template"
 	"text/template/parse"
@@ -24,7 +25,15 @@ import (
 // "and" the boolean operator is optional and can be used to specify that all conditions must be met
 // "or" the boolean operator is optional and can be used to specify that at least one condition must be met
 // if the boolean operator is not provided, it defaults to "and"
+//
+// A resource defining a when expression is also skipped if the expression is false.
 func (p *Pipeline) shouldSkipResource(leaf *Node, depsResults map[string]*Node) bool {
+	if leaf.When != nil && !leaf.When.Evaluate(func(id string) bool {
+		return isWhenOperandTrue(depsResults[id])
+	}) {
+		return true
+	}
+
 	// exit early
 	if len(leaf.DependsOn) == 0 {
 		return false
@@ -79,6 +88,20 @@ func (p *Pipeline) shouldSkipResource(leaf *Node, depsResults map[string]*Node)
 	return shouldSkip
 }
 
+// isWhenOperandTrue returns the value of a resource used in a when expression.
+// A target is true when it changed something, a source or a condition when it succeeded.
+func isWhenOperandTrue(dependencyResult *Node) bool {
+	if dependencyResult == nil {
+		return false
+	}
+
+	if dependencyResult.Category == targetCategory {
+		return dependencyResult.Changed
+	}
+
+	return dependencyResult.Result == result.SUCCESS
+}
+
 // ExtractCustomKeys parses a Go template and extracts custom keys from
 // specific template actions: {{ source "sourceId" }}, {{ condition "conditionid" }},
 // and {{ target "targetid" }}. It returns a map where the keys are the action types
@@ -154,7 +177,7 @@ func (p *Pipeline) traverseAndWriteGraph(d *dag.DAG, node string, graphFlavor st
 		}
 		nodeType := parts[0]
 		name := strings.Join(parts[1:], "#")
-		var shape, color, kind, openingBracket, closingBracket string
+		var shape, color, kind, openingBracket, closingBracket, whenExpression string
 		switch nodeType {
 		case sourceCategory:
 			shape = "ellipse"
@@ -177,6 +200,7 @@ func (p *Pipeline) traverseAndWriteGraph(d *dag.DAG, node string, graphFlavor st
 					name = condition.Config.Name
 				}
 				kind = condition.Config.Kind
+				whenExpression = condition.Config.When
 			}
 		case targetCategory:
 			shape = "box"
@@ -188,30 +212,38 @@ func (p *Pipeline) traverseAndWriteGraph(d *dag.DAG, node string, graphFlavor st
 					name = target.Config.Name
 				}
 				kind = target.Config.Kind
+				whenExpression = target.Config.When
 			}
 		}
 
 		switch graphFlavor {
 		case GraphFlavorDot:
+			label := fmt.Sprintf("%s (%s)", strings.ReplaceAll(name, `"`, `\"`), kind)
+			if whenExpression != "" {
+				label += `\nwhen: ` + strings.ReplaceAll(whenExpression, `"`, `\"`)
+			}
 
 			fmt.Fprintf(
 				graphOutput,
-				"    %q [label=\"%s (%s)\", shape=%s, style=filled, color=%s];\n",
+				"    %q [label=\"%s\", shape=%s, style=filled, color=%s];\n",
 				node,
-				strings.ReplaceAll(name, `"`, `\"`),
-				kind,
+				label,
 				shape,
 				color,
 			)
 
 		case GraphFlavorMermaid:
+			label := fmt.Sprintf("%s (%s)", strings.ReplaceAll(name, `"`, `:#quot;`), kind)
+			if whenExpression != "" {
+				label += "<br/>when: " + strings.ReplaceAll(whenExpression, `"`, `:#quot;`)
+			}
+
 			fmt.Fprintf(
 				graphOutput,
-				"    %s%s\"%s (%s)\"%s\n",
+				"    %s%s\"%s\"%s\n",
 				node,
 				openingBracket,
-				strings.ReplaceAll(name, `"`, `:#quot;`),
-				kind,
+				label,
 				closingBracket,
 			)
 		default:
@@ -220,19 +252,37 @@ func (p *Pipeline) traverseAndWriteGraph(d *dag.DAG, node string, graphFlavor st
 	}
 	for successor := range successors {
 		if node != rootVertex {
+			// Dependencies coming from a when expression are rendered with dashed edges
+			isWhenDependency := false
+			if v, err := d.GetVertex(successor); err == nil {
+				if successorNode, ok := v.(Node); ok && successorNode.When != nil {
+					isWhenDependency = slices.Contains(successorNode.When.Operands(), node)
+				}
+			}
+
 			switch graphFlavor {
 			case GraphFlavorDot:
+				edgeStyle := ""
+				if isWhenDependency {
+					edgeStyle = " [style=dashed]"
+				}
 				fmt.Fprintf(
 					graphOutput,
-					"    %q -> %q;\n",
+					"    %q -> %q%s;\n",
 					node,
 					successor,
+					edgeStyle,
 				)
 			case GraphFlavorMermaid:
+				arrow := "-->"
+				if isWhenDependency {
+					arrow = "-.->"
+				}
 				fmt.Fprintf(
 					graphOutput,
-					"    %s --> %s\n",
+					"    %s %s %s\n",
 					node,
+					arrow,
 					successor,
 				)
 			default:
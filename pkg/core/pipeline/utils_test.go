@@ -5,6 +5,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/condition"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/target"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/when"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
@@ -48,6 +52,12 @@ func TestExtractDepsFromTemplate(t *testing.T) {
 	}
 }
 
+func mustParseWhen(t *testing.T, expression string) *when.Expression {
+	e, err := when.Parse(expression)
+	require.NoError(t, err)
+	return e
+}
+
 func TestShouldSkipResource(t *testing.T) {
 	testdata := []struct {
 		Name           string
@@ -119,6 +129,72 @@ func TestShouldSkipResource(t *testing.T) {
 			},
 			ExpectedResult: false,
 		},
+		{
+			Name: "target with a true when expression runs",
+			Leaf: Node{
+				ID:       "target#mytarget",
+				Category: targetCategory,
+				When:     mustParseWhen(t, "(c1 && c2) || !c3"),
+			},
+			DepsResults: map[string]*Node{
+				"condition#c1": {ID: "condition#c1", Category: conditionCategory, Result: result.SUCCESS},
+				"condition#c2": {ID: "condition#c2", Category: conditionCategory, Result: result.FAILURE},
+				"condition#c3": {ID: "condition#c3", Category: conditionCategory, Result: result.SKIPPED},
+			},
+			ExpectedResult: false,
+		},
+		{
+			Name: "target with a false when expression is skipped",
+			Leaf: Node{
+				ID:       "target#mytarget",
+				Category: targetCategory,
+				When:     mustParseWhen(t, "(c1 && c2) || !c3"),
+			},
+			DepsResults: map[string]*Node{
+				"condition#c1": {ID: "condition#c1", Category: conditionCategory, Result: result.SUCCESS},
+				"condition#c2": {ID: "condition#c2", Category: conditionCategory, Result: result.FAILURE},
+				"condition#c3": {ID: "condition#c3", Category: conditionCategory, Result: result.SUCCESS},
+			},
+			ExpectedResult: true,
+		},
+		{
+			Name: "when expression using a changed target",
+			Leaf: Node{
+				ID:       "target#mytarget",
+				Category: targetCategory,
+				When:     mustParseWhen(t, "target#myothertarget"),
+			},
+			DepsResults: map[string]*Node{
+				"target#myothertarget": {ID: "target#myothertarget", Category: targetCategory, Result: result.ATTENTION, Changed: true},
+			},
+			ExpectedResult: false,
+		},
+		{
+			Name: "when expression using an unchanged target",
+			Leaf: Node{
+				ID:       "target#mytarget",
+				Category: targetCategory,
+				When:     mustParseWhen(t, "target#myothertarget"),
+			},
+			DepsResults: map[string]*Node{
+				"target#myothertarget": {ID: "target#myothertarget", Category: targetCategory, Result: result.SUCCESS},
+			},
+			ExpectedResult: true,
+		},
+		{
+			Name: "true when expression with a failed dependson",
+			Leaf: Node{
+				ID:        "condition#mycondition",
+				Category:  conditionCategory,
+				DependsOn: []Dependency{{ID: "source#mysource", Operator: andBooleanOperator}},
+				When:      mustParseWhen(t, "c1"),
+			},
+			DepsResults: map[string]*Node{
+				"source#mysource": {ID: "source#mysource", Category: sourceCategory, Result: result.FAILURE},
+				"condition#c1":    {ID: "condition#c1", Category: conditionCategory, Result: result.SUCCESS},
+			},
+			ExpectedResult: true,
+		},
 		{
 			Name: "resource without any dependency runs",
 			Leaf: Node{
@@ -137,3 +213,24 @@ func TestShouldSkipResource(t *testing.T) {
 		})
 	}
 }
+
+func TestGraphWhen(t *testing.T) {
+	p := Pipeline{
+		Conditions: map[string]condition.Condition{
+			"c1": {Config: condition.Config{ResourceConfig: resource.ResourceConfig{Kind: "shell"}}},
+		},
+		Targets: map[string]target.Target{
+			"t1": {Config: target.Config{ResourceConfig: resource.ResourceConfig{Kind: "shell"}, When: "!c1"}},
+		},
+	}
+
+	got, err := p.Graph(GraphFlavorDot)
+	require.NoError(t, err)
+	require.Contains(t, got, `"target#t1" [label="t1 (shell)\nwhen: !c1"`)
+	require.Contains(t, got, `"condition#c1" -> "target#t1" [style=dashed];`)
+
+	got, err = p.Graph(GraphFlavorMermaid)
+	require.NoError(t, err)
+	require.Contains(t, got, `target#t1("t1 (shell)<br/>when: !c1")`)
+	require.Contains(t, got, `condition#c1 -.-> target#t1`)
+}
@@ -0,0 +1,263 @@
+package when
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// DefaultCategory is the resource category used by operands without an explicit category
+	DefaultCategory = "condition"
+)
+
+// categories lists the resource categories an operand can refer to
+var categories = []string{"source", "condition", "target"}
+
+// Expression is a parsed boolean expression combining resource results,
+// such as "(c1 && c2) || !target#t3"
+type Expression struct {
+	raw  string
+	root node
+}
+
+// node is an element of the expression syntax tree
+type node interface {
+	evaluate(resolve func(id string) bool) bool
+	operands(ids map[string]bool)
+}
+
+type andNode struct{ left, right node }
+type orNode struct{ left, right node }
+type notNode struct{ operand node }
+
+// operandNode references a resource using its "category#id" identifier
+type operandNode struct{ id string }
+
+func (n andNode) evaluate(resolve func(id string) bool) bool {
+	return n.left.evaluate(resolve) && n.right.evaluate(resolve)
+}
+
+func (n andNode) operands(ids map[string]bool) {
+	n.left.operands(ids)
+	n.right.operands(ids)
+}
+
+func (n orNode) evaluate(resolve func(id string) bool) bool {
+	return n.left.evaluate(resolve) || n.right.evaluate(resolve)
+}
+
+func (n orNode) operands(ids map[string]bool) {
+	n.left.operands(ids)
+	n.right.operands(ids)
+}
+
+func (n notNode) evaluate(resolve func(id string) bool) bool {
+	return !n.operand.evaluate(resolve)
+}
+
+func (n notNode) operands(ids map[string]bool) {
+	n.operand.operands(ids)
+}
+
+func (n operandNode) evaluate(resolve func(id string) bool) bool {
+	return resolve(n.id)
+}
+
+func (n operandNode) operands(ids map[string]bool) {
+	ids[n.id] = true
+}
+
+// Parse parses a boolean expression.
+// Operands are resource identifiers using the format "(category#)id",
+// the category being "condition" by default.
+// Operators are "&&", "||", "!", and parentheses, "!" having the highest precedence and "||" the lowest.
+func Parse(expression string) (*Expression, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", expression, err)
+	}
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("invalid expression %q: empty expression", expression)
+	}
+
+	p := parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", expression, err)
+	}
+
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("invalid expression %q: unexpected %q", expression, p.tokens[p.pos])
+	}
+
+	return &Expression{
+		raw:  strings.TrimSpace(expression),
+		root: root,
+	}, nil
+}
+
+// Evaluate returns the expression result, resolve returning the value of each operand from its "category#id" identifier
+func (e *Expression) Evaluate(resolve func(id string) bool) bool {
+	return e.root.evaluate(resolve)
+}
+
+// Operands returns the sorted list of resource identifiers, using the format "category#id", referenced by the expression
+func (e *Expression) Operands() []string {
+	ids := map[string]bool{}
+	e.root.operands(ids)
+
+	result := make([]string, 0, len(ids))
+	for id := range ids {
+		result = append(result, id)
+	}
+	slices.Sort(result)
+
+	return result
+}
+
+// String returns the expression as defined in the manifest
+func (e *Expression) String() string {
+	return e.raw
+}
+
+// tokenize splits the expression into operators, parentheses, and operands
+func tokenize(expression string) ([]string, error) {
+	var tokens []string
+
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')' || c == '!':
+			tokens = append(tokens, string(c))
+			i++
+		case strings.HasPrefix(expression[i:], "&&"), strings.HasPrefix(expression[i:], "||"):
+			tokens = append(tokens, expression[i:i+2])
+			i += 2
+		case isOperandChar(c):
+			start := i
+			for i < len(expression) && isOperandChar(expression[i]) {
+				i++
+			}
+			tokens = append(tokens, expression[start:i])
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+		}
+	}
+
+	return tokens, nil
+}
+
+// isOperandChar returns true if the character is allowed in a resource identifier
+func isOperandChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z') ||
+		(c >= '0' && c <= '9') ||
+		c == '_' || c == '-' || c == '.' || c == '/' || c == '#'
+}
+
+// parser is a recursive descent parser over the expression tokens
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek() == "||" {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek() == "&&" {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	token := p.peek()
+	switch token {
+	case "":
+		return nil, fmt.Errorf("unexpected end of expression")
+	case "!":
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	case "(":
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return inner, nil
+	case ")", "&&", "||":
+		return nil, fmt.Errorf("unexpected %q", token)
+	}
+
+	p.pos++
+	id, err := parseOperand(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return operandNode{id: id}, nil
+}
+
+// parseOperand returns the "category#id" identifier of an operand
+func parseOperand(token string) (string, error) {
+	category, id, found := strings.Cut(token, "#")
+	if !found {
+		category, id = DefaultCategory, token
+	}
+
+	if id == "" {
+		return "", fmt.Errorf("missing resource id in %q", token)
+	}
+
+	if !slices.Contains(categories, category) {
+		return "", fmt.Errorf("unsupported resource category %q in %q, accepted values are %s", category, token, strings.Join(categories, ", "))
+	}
+
+	return category + "#" + id, nil
+}
@@ -0,0 +1,159 @@
+package when
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name             string
+		expression       string
+		expectedOperands []string
+		wantErr          bool
+	}{
+		{
+			name:             "single operand",
+			expression:       "c1",
+			expectedOperands: []string{"condition#c1"},
+		},
+		{
+			name:             "combined operands",
+			expression:       "(c1 && c2) || !c3",
+			expectedOperands: []string{"condition#c1", "condition#c2", "condition#c3"},
+		},
+		{
+			name:             "operands with category and special characters",
+			expression:       "source#my-source && target#default.yaml || condition#check/version && c1",
+			expectedOperands: []string{"condition#c1", "condition#check/version", "source#my-source", "target#default.yaml"},
+		},
+		{
+			name:             "duplicated operands",
+			expression:       "c1 || (!c1 && condition#c1)",
+			expectedOperands: []string{"condition#c1"},
+		},
+		{
+			name:       "empty expression",
+			expression: "  ",
+			wantErr:    true,
+		},
+		{
+			name:       "missing operand",
+			expression: "c1 &&",
+			wantErr:    true,
+		},
+		{
+			name:       "missing closing parenthesis",
+			expression: "(c1 || c2",
+			wantErr:    true,
+		},
+		{
+			name:       "unexpected closing parenthesis",
+			expression: "c1 || c2)",
+			wantErr:    true,
+		},
+		{
+			name:       "missing operator",
+			expression: "c1 c2",
+			wantErr:    true,
+		},
+		{
+			name:       "single ampersand",
+			expression: "c1 & c2",
+			wantErr:    true,
+		},
+		{
+			name:       "unsupported category",
+			expression: "action#default",
+			wantErr:    true,
+		},
+		{
+			name:       "missing id",
+			expression: "condition#",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.expression)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedOperands, got.Operands())
+			assert.Equal(t, tt.expression, got.String())
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		values     map[string]bool
+		expected   bool
+	}{
+		{
+			name:       "and operator",
+			expression: "c1 && c2",
+			values:     map[string]bool{"condition#c1": true, "condition#c2": false},
+			expected:   false,
+		},
+		{
+			name:       "or operator",
+			expression: "c1 || c2",
+			values:     map[string]bool{"condition#c1": false, "condition#c2": true},
+			expected:   true,
+		},
+		{
+			name:       "not operator",
+			expression: "!c1",
+			values:     map[string]bool{"condition#c1": false},
+			expected:   true,
+		},
+		{
+			name:       "and has precedence over or",
+			expression: "c1 || c2 && c3",
+			values:     map[string]bool{"condition#c1": true, "condition#c2": false, "condition#c3": false},
+			expected:   true,
+		},
+		{
+			name:       "parentheses",
+			expression: "(c1 || c2) && c3",
+			values:     map[string]bool{"condition#c1": true, "condition#c2": false, "condition#c3": false},
+			expected:   false,
+		},
+		{
+			name:       "not has precedence over and",
+			expression: "!c1 && c2",
+			values:     map[string]bool{"condition#c1": true, "condition#c2": true},
+			expected:   false,
+		},
+		{
+			name:       "double negation",
+			expression: "!!target#t1",
+			values:     map[string]bool{"target#t1": true},
+			expected:   true,
+		},
+		{
+			name:       "example from the documentation",
+			expression: "(c1 && c2) || !c3",
+			values:     map[string]bool{"condition#c1": true, "condition#c2": false, "condition#c3": false},
+			expected:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := Parse(tt.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, e.Evaluate(func(id string) bool {
+				return tt.values[id]
+			}))
+		})
+	}
+}
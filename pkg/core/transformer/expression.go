@@ -0,0 +1,176 @@
+package transformer
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+)
+
+const (
+	// expressionValueVariable is the CEL variable holding the transformer input value
+	expressionValueVariable = "value"
+	// expressionSourceVariable is the CEL variable holding the value received by the
+	// transformers list, before any transformation
+	expressionSourceVariable = "source"
+)
+
+// getExpressionEnv returns the CEL environment used to compile the expression transformers.
+// It's initialized once as it's immutable and safe for concurrent use.
+var getExpressionEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable(expressionValueVariable, cel.StringType),
+		cel.Variable(expressionSourceVariable, cel.StringType),
+		ext.Strings(),
+		ext.Encoders(),
+		semverFunctions(),
+	)
+})
+
+// compileExpression parses and type-checks a CEL expression, then returns the program to evaluate
+func compileExpression(expression string) (cel.Program, error) {
+	env, err := getExpressionEnv()
+	if err != nil {
+		return nil, fmt.Errorf("initializing expression environment: %w", err)
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", expression, issues.Err())
+	}
+
+	switch ast.OutputType() {
+	case cel.StringType, cel.IntType, cel.UintType, cel.DoubleType, cel.BoolType, cel.DynType:
+	default:
+		return nil, fmt.Errorf("invalid expression %q: returns a %s while a string is expected", expression, ast.OutputType())
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", expression, err)
+	}
+
+	return program, nil
+}
+
+// applyExpression evaluates a CEL expression against the transformer input value,
+// and the source value received by the transformers list.
+func applyExpression(expression, input, source string) (string, error) {
+	program, err := compileExpression(expression)
+	if err != nil {
+		return "", err
+	}
+
+	out, _, err := program.Eval(map[string]any{
+		expressionValueVariable:  input,
+		expressionSourceVariable: source,
+	})
+	if err != nil {
+		return "", fmt.Errorf("evaluating expression %q: %w", expression, err)
+	}
+
+	output := out.ConvertToType(types.StringType)
+	if types.IsError(output) {
+		return "", fmt.Errorf("converting expression %q result to string: %v", expression, output)
+	}
+
+	return fmt.Sprint(output.Value()), nil
+}
+
+// semverFunctions returns the CEL functions handling semantic versions
+func semverFunctions() cel.EnvOption {
+	return cel.Lib(semverLib{})
+}
+
+// semverLib is a CEL library providing semantic version helper functions
+type semverLib struct{}
+
+func (semverLib) CompileOptions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Function("semverMajor",
+			cel.Overload("semverMajor_string", []*cel.Type{cel.StringType}, cel.IntType,
+				cel.UnaryBinding(semverUnary(func(v *semver.Version) ref.Val {
+					return types.Int(v.Major())
+				})))),
+		cel.Function("semverMinor",
+			cel.Overload("semverMinor_string", []*cel.Type{cel.StringType}, cel.IntType,
+				cel.UnaryBinding(semverUnary(func(v *semver.Version) ref.Val {
+					return types.Int(v.Minor())
+				})))),
+		cel.Function("semverPatch",
+			cel.Overload("semverPatch_string", []*cel.Type{cel.StringType}, cel.IntType,
+				cel.UnaryBinding(semverUnary(func(v *semver.Version) ref.Val {
+					return types.Int(v.Patch())
+				})))),
+		cel.Function("semverPrerelease",
+			cel.Overload("semverPrerelease_string", []*cel.Type{cel.StringType}, cel.StringType,
+				cel.UnaryBinding(semverUnary(func(v *semver.Version) ref.Val {
+					return types.String(v.Prerelease())
+				})))),
+		cel.Function("semverMetadata",
+			cel.Overload("semverMetadata_string", []*cel.Type{cel.StringType}, cel.StringType,
+				cel.UnaryBinding(semverUnary(func(v *semver.Version) ref.Val {
+					return types.String(v.Metadata())
+				})))),
+		cel.Function("semverIsValid",
+			cel.Overload("semverIsValid_string", []*cel.Type{cel.StringType}, cel.BoolType,
+				cel.UnaryBinding(func(value ref.Val) ref.Val {
+					_, err := semver.NewVersion(string(value.(types.String)))
+					return types.Bool(err == nil)
+				}))),
+		cel.Function("semverCompare",
+			cel.Overload("semverCompare_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.IntType,
+				cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+					v1, err := semver.NewVersion(string(lhs.(types.String)))
+					if err != nil {
+						return types.NewErr("wrong semantic version %q", lhs)
+					}
+					v2, err := semver.NewVersion(string(rhs.(types.String)))
+					if err != nil {
+						return types.NewErr("wrong semantic version %q", rhs)
+					}
+					return types.Int(v1.Compare(v2))
+				}))),
+		cel.Function("semverMatches",
+			cel.Overload("semverMatches_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+				cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+					v, err := semver.NewVersion(string(lhs.(types.String)))
+					if err != nil {
+						return types.NewErr("wrong semantic version %q", lhs)
+					}
+					c, err := semver.NewConstraint(string(rhs.(types.String)))
+					if err != nil {
+						return types.NewErr("wrong semantic version constraint %q", rhs)
+					}
+					return types.Bool(c.Check(v))
+				}))),
+		cel.Function("semverInc",
+			cel.Overload("semverInc_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.StringType,
+				cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+					v, err := applySemVerInc(string(lhs.(types.String)), string(rhs.(types.String)))
+					if err != nil {
+						return types.NewErrFromString(err.Error())
+					}
+					return types.String(v)
+				}))),
+	}
+}
+
+func (semverLib) ProgramOptions() []cel.ProgramOption {
+	return nil
+}
+
+// semverUnary returns a CEL unary function parsing its argument as a semantic version
+func semverUnary(fn func(v *semver.Version) ref.Val) func(value ref.Val) ref.Val {
+	return func(value ref.Val) ref.Val {
+		v, err := semver.NewVersion(string(value.(types.String)))
+		if err != nil {
+			return types.NewErr("wrong semantic version %q", value)
+		}
+		return fn(v)
+	}
+}
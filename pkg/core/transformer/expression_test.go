@@ -0,0 +1,144 @@
+package transformer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpression(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		rules          Transformers
+		expectedOutput string
+		wantErr        bool
+	}{
+		{
+			name:  "major.minor only",
+			input: "v1.26.3",
+			rules: Transformers{
+				{Expression: `"%d.%d".format([semverMajor(value), semverMinor(value)])`},
+			},
+			expectedOutput: "1.26",
+		},
+		{
+			name:  "lowercase",
+			input: "Jod",
+			rules: Transformers{
+				{Expression: `value.lowerAscii()`},
+			},
+			expectedOutput: "jod",
+		},
+		{
+			name:  "base64",
+			input: "updatecli",
+			rules: Transformers{
+				{Expression: `base64.encode(bytes(value))`},
+			},
+			expectedOutput: "dXBkYXRlY2xp",
+		},
+		{
+			name:  "conditional formatting",
+			input: "2.0.0-rc.1",
+			rules: Transformers{
+				{Expression: `semverPrerelease(value) == "" ? "stable" : "edge-" + semverPrerelease(value)`},
+			},
+			expectedOutput: "edge-rc.1",
+		},
+		{
+			name:  "access to the source value",
+			input: "1.2.3",
+			rules: Transformers{
+				{AddPrefix: "v"},
+				{Expression: `value + " (from " + source + ")"`},
+			},
+			expectedOutput: "v1.2.3 (from 1.2.3)",
+		},
+		{
+			name:  "semver helpers",
+			input: "1.2.3",
+			rules: Transformers{
+				{Expression: `semverMatches(value, "~1.2") && semverCompare(value, "1.10.0") < 0 ? semverInc(value, "minor") : value`},
+			},
+			expectedOutput: "1.3.0",
+		},
+		{
+			name:  "non string result",
+			input: "1.2.3",
+			rules: Transformers{
+				{Expression: `semverIsValid(value)`},
+			},
+			expectedOutput: "true",
+		},
+		{
+			name:  "invalid semantic version",
+			input: "latest",
+			rules: Transformers{
+				{Expression: `string(semverMajor(value))`},
+			},
+			wantErr: true,
+		},
+		{
+			name:  "undeclared variable",
+			input: "1.2.3",
+			rules: Transformers{
+				{Expression: `vaule.lowerAscii()`},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.rules.Apply(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, got)
+		})
+	}
+}
+
+func TestValidateExpression(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    bool
+	}{
+		{
+			name:       "valid expression",
+			expression: `value.upperAscii()`,
+		},
+		{
+			name:       "syntax error",
+			expression: `value.upperAscii(`,
+			wantErr:    true,
+		},
+		{
+			name:       "unknown function",
+			expression: `semverMajr(value)`,
+			wantErr:    true,
+		},
+		{
+			name:       "list result",
+			expression: `value.split(".")`,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transformer := Transformer{Expression: tt.expression}
+			err := transformer.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
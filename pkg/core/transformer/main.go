@@ -55,10 +55,37 @@ type Transformer struct {
 	// SemvVerInc specifies a comma separated list semantic versioning component that needs to be upgraded.
 	SemVerInc           string `yaml:",omitempty"`
 	DeprecatedSemVerInc string `yaml:"semverInc,omitempty" jsonschema:"-"`
+	// SemVer normalizes a semantic version, or outputs some of its components.
+	// An empty "semver: {}" only normalizes the version, such as "1.2" to "1.2.0"
+	SemVer *SemVer `yaml:",omitempty"`
 	// Quote add quote around the value
 	Quote bool `yaml:",omitempty"`
 	// Unquote remove quotes around the value
 	Unquote bool `yaml:",omitempty"`
+	// Expression defines a CEL (Common Expression Language) expression used to compute the output value.
+	//
+	// The expression has access to the following variables:
+	//   * value: the transformer input value
+	//   * source: the value received by the list of transformers, before any transformation
+	//
+	// On top of the standard CEL functions, the CEL string extensions such as lowerAscii(),
+	// split(), or format(), the base64.encode() and base64.decode() functions, and the following
+	// semantic version functions are available:
+	//   * semverMajor(string) int, semverMinor(string) int, semverPatch(string) int
+	//   * semverPrerelease(string) string, semverMetadata(string) string
+	//   * semverIsValid(string) bool
+	//   * semverCompare(string, string) int
+	//   * semverMatches(string, constraint string) bool
+	//   * semverInc(string, components string) string
+	//
+	// The expression is validated when the manifest is loaded, and must return a string,
+	// a number, or a boolean.
+	//
+	// examples:
+	//   * "%d.%d".format([semverMajor(value), semverMinor(value)])
+	//   * value.lowerAscii()
+	//   * semverPrerelease(value) == "" ? "stable" : "edge"
+	Expression string `yaml:",omitempty"`
 }
 
 // Transformers defines a list of transformer applied in order
@@ -66,6 +93,12 @@ type Transformers []Transformer
 
 // Apply applies a single transformation based on a key
 func (t *Transformer) Apply(input string) (output string, err error) {
+	return t.apply(input, input)
+}
+
+// apply applies a single transformation based on a key.
+// source is the value received by the list of transformers, before any transformation.
+func (t *Transformer) apply(input, source string) (output string, err error) {
 
 	if input == "" {
 		return "", ErrEmptyInput
@@ -122,6 +155,13 @@ func (t *Transformer) Apply(input string) (output string, err error) {
 		}
 	}
 
+	if t.SemVer != nil {
+		output, err = t.SemVer.Apply(output)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	if t.Quote {
 		output = fmt.Sprintf("%q", output)
 	}
@@ -130,6 +170,13 @@ func (t *Transformer) Apply(input string) (output string, err error) {
 		output = strings.Trim(output, "\"")
 	}
 
+	if len(t.Expression) > 0 {
+		output, err = applyExpression(t.Expression, output, source)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	if t.JsonMatch != (JsonMatch{}) {
 		var data any
 		var results []any
@@ -215,7 +262,7 @@ func (t *Transformers) Apply(input string) (string, error) {
 
 	for _, transformer := range *t {
 		previous := output
-		output, err = transformer.Apply(output)
+		output, err = transformer.apply(output, input)
 		if err != nil {
 			return "", err
 		}
@@ -359,6 +406,18 @@ func (t *Transformer) Validate() error {
 		return err
 	}
 
+	if t.SemVer != nil {
+		if err := t.SemVer.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if len(t.Expression) > 0 {
+		if _, err := compileExpression(t.Expression); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
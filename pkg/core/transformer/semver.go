@@ -0,0 +1,116 @@
+package transformer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+const (
+	// semVerPrefixStrip removes the leading "v" from the output
+	semVerPrefixStrip = "strip"
+	// semVerPrefixForce adds a leading "v" to the output
+	semVerPrefixForce = "force"
+)
+
+// SemVer normalizes a semantic version, or outputs some of its components.
+// Loose versions are coerced, so "1.2" is handled as "1.2.0"
+type SemVer struct {
+	// Format defines the output using the placeholders {{major}}, {{minor}}, {{patch}}, {{prerelease}}, and {{metadata}}.
+	//
+	// default:
+	//   The normalized version, such as "1.2.0" for an input "1.2"
+	//
+	// remark:
+	//   As manifests are rendered as Go templates, the placeholders must be escaped
+	//   such as '{{ "{{major}}.{{minor}}" }}'
+	Format string `yaml:",omitempty"`
+	// Prefix defines how to handle the leading "v".
+	//
+	// accepted values:
+	//   * strip: removes the leading "v"
+	//   * force: adds a leading "v"
+	//
+	// default:
+	//   The leading "v" of the input is kept, unless a format is defined
+	Prefix string `yaml:",omitempty"`
+	// TrimPrerelease removes the prerelease, such as "-beta.1"
+	TrimPrerelease bool `yaml:",omitempty"`
+	// TrimMetadata removes the build metadata, such as "+build.1"
+	TrimMetadata bool `yaml:",omitempty"`
+}
+
+// Apply normalizes the input semantic version
+func (s *SemVer) Apply(input string) (string, error) {
+	v, err := semver.NewVersion(input)
+	if err != nil {
+		return "", fmt.Errorf("wrong semantic version input: %q", input)
+	}
+
+	if s.TrimPrerelease {
+		*v, err = v.SetPrerelease("")
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if s.TrimMetadata {
+		*v, err = v.SetMetadata("")
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var output string
+	switch s.Format {
+	case "":
+		output = v.String()
+		if strings.HasPrefix(input, "v") {
+			output = "v" + output
+		}
+	default:
+		output = strings.NewReplacer(
+			"{{major}}", strconv.FormatUint(v.Major(), 10),
+			"{{minor}}", strconv.FormatUint(v.Minor(), 10),
+			"{{patch}}", strconv.FormatUint(v.Patch(), 10),
+			"{{prerelease}}", v.Prerelease(),
+			"{{metadata}}", v.Metadata(),
+		).Replace(s.Format)
+	}
+
+	switch s.Prefix {
+	case semVerPrefixStrip:
+		output = strings.TrimPrefix(output, "v")
+	case semVerPrefixForce:
+		if !strings.HasPrefix(output, "v") {
+			output = "v" + output
+		}
+	}
+
+	return output, nil
+}
+
+// Validate checks the prefix and the format placeholders
+func (s *SemVer) Validate() error {
+	switch s.Prefix {
+	case "", semVerPrefixStrip, semVerPrefixForce:
+	default:
+		return fmt.Errorf("unsupported semver prefix %q, only accept %q or %q", s.Prefix, semVerPrefixStrip, semVerPrefixForce)
+	}
+
+	unknown := strings.NewReplacer(
+		"{{major}}", "",
+		"{{minor}}", "",
+		"{{patch}}", "",
+		"{{prerelease}}", "",
+		"{{metadata}}", "",
+	).Replace(s.Format)
+
+	if strings.Contains(unknown, "{{") {
+		return fmt.Errorf("unsupported semver format %q, only accept the placeholders {{major}}, {{minor}}, {{patch}}, {{prerelease}}, and {{metadata}}", s.Format)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package transformer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+)
+
+func TestSemVer(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		rules          Transformers
+		expectedOutput string
+		wantErr        bool
+	}{
+		{
+			name:           "major.minor only",
+			input:          "v1.26.3",
+			rules:          Transformers{{SemVer: &SemVer{Format: "{{major}}.{{minor}}"}}},
+			expectedOutput: "1.26",
+		},
+		{
+			name:           "normalize only",
+			input:          "1.2",
+			rules:          Transformers{{SemVer: &SemVer{}}},
+			expectedOutput: "1.2.0",
+		},
+		{
+			name:           "coerce loose version",
+			input:          "1.2",
+			rules:          Transformers{{SemVer: &SemVer{TrimMetadata: true}}},
+			expectedOutput: "1.2.0",
+		},
+		{
+			name:           "keep leading v by default",
+			input:          "v1.2",
+			rules:          Transformers{{SemVer: &SemVer{TrimMetadata: true}}},
+			expectedOutput: "v1.2.0",
+		},
+		{
+			name:           "strip leading v",
+			input:          "v1.2.3",
+			rules:          Transformers{{SemVer: &SemVer{Prefix: "strip"}}},
+			expectedOutput: "1.2.3",
+		},
+		{
+			name:           "force leading v",
+			input:          "1.2.3",
+			rules:          Transformers{{SemVer: &SemVer{Prefix: "force"}}},
+			expectedOutput: "v1.2.3",
+		},
+		{
+			name:           "force leading v with format",
+			input:          "v1.2.3",
+			rules:          Transformers{{SemVer: &SemVer{Format: "{{major}}", Prefix: "force"}}},
+			expectedOutput: "v1",
+		},
+		{
+			name:           "drop prerelease and metadata",
+			input:          "1.2.3-beta.1+build.42",
+			rules:          Transformers{{SemVer: &SemVer{TrimPrerelease: true, TrimMetadata: true}}},
+			expectedOutput: "1.2.3",
+		},
+		{
+			name:           "drop prerelease only",
+			input:          "1.2.3-beta.1+build.42",
+			rules:          Transformers{{SemVer: &SemVer{TrimPrerelease: true}}},
+			expectedOutput: "1.2.3+build.42",
+		},
+		{
+			name:           "all placeholders",
+			input:          "1.2.3-rc.1+sha.abc",
+			rules:          Transformers{{SemVer: &SemVer{Format: "{{major}}/{{minor}}/{{patch}}/{{prerelease}}/{{metadata}}"}}},
+			expectedOutput: "1/2/3/rc.1/sha.abc",
+		},
+		{
+			name:    "invalid semantic version",
+			input:   "latest",
+			rules:   Transformers{{SemVer: &SemVer{Prefix: "strip"}}},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported prefix",
+			input:   "1.2.3",
+			rules:   Transformers{{SemVer: &SemVer{Prefix: "add"}}},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported placeholder",
+			input:   "1.2.3",
+			rules:   Transformers{{SemVer: &SemVer{Format: "{{major}}.{{build}}"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.rules.Apply(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedOutput, got)
+		})
+	}
+}
+
+func TestSemVerEmptyBlock(t *testing.T) {
+	var rules Transformers
+	require.NoError(t, yaml.Unmarshal([]byte("- semver: {}\n"), &rules))
+	require.Len(t, rules, 1)
+	require.NotNil(t, rules[0].SemVer)
+
+	// Manifests are marshalled and unmarshalled again when rendered
+	content, err := yaml.Marshal(rules)
+	require.NoError(t, err)
+	rules = nil
+	require.NoError(t, yaml.Unmarshal(content, &rules))
+	require.NotNil(t, rules[0].SemVer)
+
+	got, err := rules.Apply("1.2")
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.0", got)
+}
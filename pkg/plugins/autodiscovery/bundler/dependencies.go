@@ -0,0 +1,175 @@
+package bundler
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+)
+
+func (b Bundler) discoverDependencyManifests() ([][]byte, error) {
+	var manifests [][]byte
+
+	searchFromDir := b.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if b.spec.RootDir != "" && !path.IsAbs(b.spec.RootDir) {
+		searchFromDir = filepath.Join(b.rootDir, b.spec.RootDir)
+	}
+
+	foundFiles, err := searchGemfiles(searchFromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New("manifest").Parse(manifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+		logrus.Debugf("parsing file %q", foundFile)
+
+		dir := filepath.Dir(foundFile)
+
+		relativeFoundFile, err := filepath.Rel(b.rootDir, foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		workdir, err := filepath.Rel(b.rootDir, dir)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		lockedVersions := map[string]string{}
+		hasLockFile := isLockFileDetected(filepath.Join(dir, gemfileLock))
+		if hasLockFile {
+			// It doesn't make sense to update the Gemfile if Updatecli can't update the Gemfile.lock
+			if !b.bundlerAvailable {
+				logrus.Warningf("skipping %q, Gemfile.lock detected but Updatecli couldn't detect the bundle command to update it", relativeFoundFile)
+				continue
+			}
+
+			lockedVersions, err = parseGemfileLock(filepath.Join(dir, gemfileLock))
+			if err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+		}
+
+		gems, err := parseGemfile(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		if len(gems) == 0 {
+			logrus.Debugf("no gem found in %q", relativeFoundFile)
+			continue
+		}
+
+		for _, gem := range gems {
+			gem.LockedVersion = lockedVersions[gem.Name]
+
+			if len(b.spec.Ignore) > 0 && b.spec.Ignore.isMatchingRules(b.rootDir, relativeFoundFile, gem.Name, gem.currentVersion()) {
+				logrus.Debugf("ignoring gem %q from %q, as matching ignore rule(s)", gem.Name, relativeFoundFile)
+				continue
+			}
+
+			if len(b.spec.Only) > 0 && !b.spec.Only.isMatchingRules(b.rootDir, relativeFoundFile, gem.Name, gem.currentVersion()) {
+				logrus.Debugf("ignoring gem %q from %q, as not matching only rule(s)", gem.Name, relativeFoundFile)
+				continue
+			}
+
+			sourceVersionFilterKind, sourceVersionFilterPattern, sourceVersionFilterRegex, err := b.getVersionFilter(gem)
+			if err != nil {
+				logrus.Warningf("skipping gem %q from %q: %s", gem.Name, relativeFoundFile, err)
+				continue
+			}
+
+			pinnedVersion := gem.pinnedVersion()
+
+			params := manifestTemplateParams{
+				ManifestName:               fmt.Sprintf("deps(rubygems): bump %q gem version", gem.Name),
+				ActionID:                   b.actionID,
+				SourceID:                   "rubygems",
+				SourceName:                 fmt.Sprintf("Get latest %q gem version", gem.Name),
+				SourceURL:                  b.spec.URL,
+				SourceVersionFilterKind:    sourceVersionFilterKind,
+				SourceVersionFilterPattern: sourceVersionFilterPattern,
+				SourceVersionFilterRegex:   sourceVersionFilterRegex,
+				GemName:                    gem.Name,
+				TargetID:                   "gemfile",
+				TargetLockID:               "gemfile.lock",
+				TargetName:                 fmt.Sprintf("deps(rubygems): bump %q gem to {{ source \"rubygems\" }}", gem.Name),
+				// The pattern is rendered in a single-quoted yaml string
+				TargetMatchPattern:   strings.ReplaceAll(gemPattern(gem.Name), "'", "''"),
+				TargetGemfileEnabled: pinnedVersion != "",
+				TargetLockEnabled:    hasLockFile,
+				File:                 relativeFoundFile,
+				LockFile:             gemfileLock,
+				Workdir:              workdir,
+				ScmID:                b.scmID,
+			}
+
+			manifest := bytes.Buffer{}
+			if err := tmpl.Execute(&manifest, params); err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			manifests = append(manifests, manifest.Bytes())
+		}
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
+
+// getVersionFilter returns the version filter kind, pattern, and regex used by the generated source.
+//
+// Pattern order
+//  1. Gems declared with a version requirement reuse that requirement
+//  2. Gems pinned to a version, or without requirement, use ">=" the current version
+//  3. Unless a versionfilter is defined in the manifest, in which case its kind and pattern are used
+func (b Bundler) getVersionFilter(gem gemDependency) (kind, pattern, regex string, err error) {
+	kind = "semver"
+	pattern = "*"
+	regex = b.versionFilter.Regex
+
+	if len(gem.Requirements) > 0 && gem.pinnedVersion() == "" {
+		pattern, err = convertRequirementsToConstraint(gem.Requirements)
+		if err != nil {
+			return "", "", "", err
+		}
+		return kind, pattern, regex, nil
+	}
+
+	currentVersion := gem.currentVersion()
+	if currentVersion == "" {
+		if !b.spec.VersionFilter.IsZero() {
+			return b.versionFilter.Kind, b.versionFilter.Pattern, regex, nil
+		}
+		return kind, pattern, regex, nil
+	}
+
+	if b.spec.VersionFilter.IsZero() {
+		return kind, ">=" + currentVersion, regex, nil
+	}
+
+	pattern, err = b.versionFilter.GreaterThanPattern(currentVersion)
+	if err != nil {
+		logrus.Debugf("building version filter pattern: %s", err)
+		pattern = "*"
+	}
+
+	return b.versionFilter.Kind, pattern, regex, nil
+}
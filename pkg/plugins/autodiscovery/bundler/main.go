@@ -0,0 +1,137 @@
+// Package bundler implements the autodiscovery crawler for Ruby projects managed by Bundler.
+//
+// It walks a root directory looking for Gemfile files and generates one manifest per gem
+// declared with `gem "name"`. Gems installed from a git repository or a local path are skipped.
+// The current version of each gem is read from the Gemfile.lock sitting next to the Gemfile.
+//
+//   - Gems pinned to a single version, such as `gem "rails", "7.1.2"`, get a file target
+//     bumping the version in the Gemfile.
+//   - When a Gemfile.lock exists, a shell target running `bundle lock --update` keeps it in
+//     sync. If the bundle command is missing, the whole Gemfile is skipped since Updatecli
+//     cannot re-lock what it would bump.
+//   - Gems declared with a version requirement, such as `gem "rails", "~> 7.1"`, keep their
+//     requirement untouched: only the Gemfile.lock is updated, within that requirement.
+package bundler
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines the parameters which can be provided to the Bundler crawler.
+type Spec struct {
+	// RootDir defines the root directory used to recursively search for Gemfile
+	RootDir string `yaml:",omitempty"`
+	// Ignore allows to specify rule to ignore autodiscovery a specific gem based on a rule
+	Ignore MatchingRules `yaml:",omitempty"`
+	// Only allows to specify rule to only autodiscover manifest for a specific gem based on a rule
+	Only MatchingRules `yaml:",omitempty"`
+	//  `versionfilter` provides parameters to specify the version pattern used when generating manifest.
+	//
+	//  It applies to gems pinned to a single version, or declared without any version requirement.
+	//  Gems declared with a version requirement such as `~> 7.1` always respect that requirement.
+	//
+	//  kind - semver
+	//    versionfilter of kind `semver` uses semantic versioning as version filtering
+	//    pattern accepts one of:
+	//      `prerelease` - Updatecli tries to identify the latest prerelease whatever it means
+	//      `patch` - Updatecli only handles patch version update
+	//      `minor` - Updatecli handles patch AND minor version update
+	//      `minoronly` - Updatecli handles minor version only
+	//      `major` - Updatecli handles patch, minor, AND major version update
+	//      `majoronly` - Updatecli only handles major version update
+	//      `a version constraint` such as `>= 1.0.0`
+	//
+	//  kind - regex
+	//    versionfilter of kind `regex` uses regular expression as version filtering
+	//    pattern accepts a valid regular expression
+	//
+	//  example:
+	//  ```
+	//    versionfilter:
+	//      kind: semver
+	//      pattern: minor
+	//  ```
+	//
+	//  More examples can be found at https://www.updatecli.io/docs/core/versionfilter/
+	VersionFilter version.Filter `yaml:",omitempty"`
+	// URL defines the gem registry url (defaults to `https://rubygems.org/`).
+	// This will be propagated to all generated rubygems resource specs.
+	URL string `yaml:",omitempty"`
+}
+
+// Bundler holds all information needed to generate gem manifests.
+type Bundler struct {
+	// actionID holds the actionID used by the newly generated manifest
+	actionID string
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// rootDir defines the root directory from where looking for Gemfile
+	rootDir string
+	// scmID holds the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+	// bundlerAvailable reports whether the bundle command is present on PATH
+	bundlerAvailable bool
+}
+
+// New return a new valid object.
+func New(spec interface{}, rootDir, scmID, actionID string) (Bundler, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Bundler{}, err
+	}
+
+	if err := s.Ignore.Validate(); err != nil {
+		return Bundler{}, fmt.Errorf("invalid ignore spec: %w", err)
+	}
+
+	if err := s.Only.Validate(); err != nil {
+		return Bundler{}, fmt.Errorf("invalid only spec: %w", err)
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return Bundler{}, fmt.Errorf("no working directory defined")
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		// By default, gems are expected to follow semantic versioning
+		newFilter.Kind = "semver"
+		newFilter.Pattern = "*"
+	}
+
+	return Bundler{
+		actionID:         actionID,
+		spec:             s,
+		rootDir:          dir,
+		scmID:            scmID,
+		versionFilter:    newFilter,
+		bundlerAvailable: isBundlerAvailable(),
+	}, nil
+}
+
+// DiscoverManifests returns updatecli manifests for all gems found under rootDir.
+func (b Bundler) DiscoverManifests() ([][]byte, error) {
+	logrus.Infof("\n\n%s\n", strings.ToTitle("Bundler"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("Bundler")+1))
+
+	return b.discoverDependencyManifests()
+}
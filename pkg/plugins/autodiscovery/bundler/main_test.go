@@ -0,0 +1,220 @@
+package bundler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	testdata := []struct {
+		name              string
+		rootDir           string
+		scmID             string
+		actionID          string
+		spec              Spec
+		bundlerAvailable  bool
+		expectedPipelines []string
+	}{
+		{
+			name:             "Gemfile with lock file",
+			rootDir:          "testdata/simple",
+			scmID:            "default",
+			bundlerAvailable: true,
+			spec: Spec{
+				Only: MatchingRules{
+					{Gems: map[string]string{"rails": "", "puma": ""}},
+				},
+			},
+			expectedPipelines: []string{
+				`name: 'deps(rubygems): bump "rails" gem version'
+sources:
+  rubygems:
+    name: 'Get latest "rails" gem version'
+    kind: 'rubygems'
+    spec:
+      name: 'rails'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=7.1.2, <7.2'
+targets:
+  gemfile.lock:
+    name: 'deps(rubygems): bump "rails" gem to {{ source "rubygems" }}'
+    scmid: 'default'
+    kind: 'shell'
+    spec:
+      command: 'bundle lock --update rails'
+      changedif:
+        kind: file/checksum
+        spec:
+          files:
+            - "Gemfile.lock"
+      environments:
+        - name: PATH
+      workdir: '.'
+    disablesourceinput: true
+`,
+				`name: 'deps(rubygems): bump "puma" gem version'
+sources:
+  rubygems:
+    name: 'Get latest "puma" gem version'
+    kind: 'rubygems'
+    spec:
+      name: 'puma'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=6.4.0'
+targets:
+  gemfile:
+    name: 'deps(rubygems): bump "puma" gem to {{ source "rubygems" }}'
+    scmid: 'default'
+    kind: 'file'
+    spec:
+      file: 'Gemfile'
+      matchpattern: '(gem\s*\(?\s*["'']puma["'']\s*,\s*["''](?:=\s*)?)[^"'']+(["''])'
+      replacepattern: '${1}{{ source "rubygems" }}${2}'
+    sourceid: 'rubygems'
+  gemfile.lock:
+    name: 'deps(rubygems): bump "puma" gem to {{ source "rubygems" }}'
+    scmid: 'default'
+    dependson:
+      - 'target#gemfile'
+    kind: 'shell'
+    spec:
+      command: 'bundle lock --update puma'
+      changedif:
+        kind: file/checksum
+        spec:
+          files:
+            - "Gemfile.lock"
+      environments:
+        - name: PATH
+      workdir: '.'
+    disablesourceinput: true
+`,
+			},
+		},
+		{
+			name:              "Gemfile with lock file but without bundler",
+			rootDir:           "testdata/simple",
+			bundlerAvailable:  false,
+			expectedPipelines: []string{},
+		},
+		{
+			name:             "Gem without requirement using versionfilter",
+			rootDir:          "testdata/simple",
+			actionID:         "default",
+			bundlerAvailable: true,
+			spec: Spec{
+				Ignore: MatchingRules{
+					{Gems: map[string]string{"rails": "", "puma": "", "rubocop": ""}},
+				},
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "minor",
+				},
+				URL: "https://gems.example.com/",
+			},
+			expectedPipelines: []string{
+				`name: 'deps(rubygems): bump "bootsnap" gem version'
+actions:
+  default:
+    title: 'deps(rubygems): bump "bootsnap" gem to {{ source "rubygems" }}'
+
+sources:
+  rubygems:
+    name: 'Get latest "bootsnap" gem version'
+    kind: 'rubygems'
+    spec:
+      name: 'bootsnap'
+      url: 'https://gems.example.com/'
+      versionfilter:
+        kind: 'semver'
+        pattern: '1.x'
+targets:
+  gemfile.lock:
+    name: 'deps(rubygems): bump "bootsnap" gem to {{ source "rubygems" }}'
+    kind: 'shell'
+    spec:
+      command: 'bundle lock --update bootsnap'
+      changedif:
+        kind: file/checksum
+        spec:
+          files:
+            - "Gemfile.lock"
+      environments:
+        - name: PATH
+      workdir: '.'
+    disablesourceinput: true
+`,
+			},
+		},
+		{
+			name:    "Gemfile without lock file",
+			rootDir: "testdata/no_lockfile",
+			expectedPipelines: []string{
+				`name: 'deps(rubygems): bump "sinatra" gem version'
+sources:
+  rubygems:
+    name: 'Get latest "sinatra" gem version'
+    kind: 'rubygems'
+    spec:
+      name: 'sinatra'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=4.0.0'
+targets:
+  gemfile:
+    name: 'deps(rubygems): bump "sinatra" gem to {{ source "rubygems" }}'
+    kind: 'file'
+    spec:
+      file: 'app/Gemfile'
+      matchpattern: '(gem\s*\(?\s*["'']sinatra["'']\s*,\s*["''](?:=\s*)?)[^"'']+(["''])'
+      replacepattern: '${1}{{ source "rubygems" }}${2}'
+    sourceid: 'rubygems'
+`,
+				`name: 'deps(rubygems): bump "rack" gem version'
+sources:
+  rubygems:
+    name: 'Get latest "rack" gem version'
+    kind: 'rubygems'
+    spec:
+      name: 'rack'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=3.0, <4'
+`,
+			},
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := New(tt.spec, tt.rootDir, tt.scmID, tt.actionID)
+			require.NoError(t, err)
+
+			// Override bundlerAvailable so tests are deterministic regardless of
+			// whether the bundle command is installed in the test environment.
+			b.bundlerAvailable = tt.bundlerAvailable
+
+			manifests, err := b.DiscoverManifests()
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.expectedPipelines), len(manifests))
+
+			for i := range manifests {
+				assert.Equal(t, tt.expectedPipelines[i], string(manifests[i]))
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Spec{Only: MatchingRules{{}}}, "testdata/simple", "", "")
+	assert.ErrorContains(t, err, "invalid only spec")
+
+	_, err = New(Spec{}, "", "", "")
+	assert.ErrorContains(t, err, "no working directory defined")
+}
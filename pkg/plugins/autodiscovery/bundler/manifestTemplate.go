@@ -0,0 +1,88 @@
+package bundler
+
+// manifestTemplate is the Go template used to generate updatecli manifests
+// for gem updates discovered via Gemfile.
+var manifestTemplate = `name: '{{ .ManifestName }}'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+    title: '{{ .TargetName }}'
+{{ end }}
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'rubygems'
+    spec:
+      name: '{{ .GemName }}'
+{{- if .SourceURL }}
+      url: '{{ .SourceURL }}'
+{{- end }}
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+{{- if or (eq .SourceVersionFilterKind "regex/semver") (eq .SourceVersionFilterKind "regex/time") }}
+        regex: '{{ .SourceVersionFilterRegex }}'
+{{- end }}
+{{- if or .TargetGemfileEnabled .TargetLockEnabled }}
+targets:
+{{- end }}
+{{- if .TargetGemfileEnabled }}
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    kind: 'file'
+    spec:
+      file: '{{ .File }}'
+      matchpattern: '{{ .TargetMatchPattern }}'
+      replacepattern: '${1}{{ "{{" }} source "{{ .SourceID }}" {{ "}}" }}${2}'
+    sourceid: '{{ .SourceID }}'
+{{- end }}
+{{- if .TargetLockEnabled }}
+  {{ .TargetLockID }}:
+    name: '{{ .TargetName }}'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+{{- if .TargetGemfileEnabled }}
+    dependson:
+      - 'target#{{ .TargetID }}'
+{{- end }}
+    kind: 'shell'
+    spec:
+      command: 'bundle lock --update {{ .GemName }}'
+      changedif:
+        kind: file/checksum
+        spec:
+          files:
+            - "{{ .LockFile }}"
+      environments:
+        - name: PATH
+      workdir: '{{ .Workdir }}'
+    disablesourceinput: true
+{{- end }}
+`
+
+// manifestTemplateParams holds the values injected into manifestTemplate.
+type manifestTemplateParams struct {
+	ManifestName               string
+	ActionID                   string
+	SourceID                   string
+	SourceName                 string
+	SourceURL                  string
+	SourceVersionFilterKind    string
+	SourceVersionFilterPattern string
+	SourceVersionFilterRegex   string
+	GemName                    string
+	TargetID                   string
+	TargetLockID               string
+	TargetName                 string
+	TargetMatchPattern         string
+	TargetGemfileEnabled       bool
+	TargetLockEnabled          bool
+	File                       string
+	LockFile                   string
+	Workdir                    string
+	ScmID                      string
+}
@@ -0,0 +1,76 @@
+package bundler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchingRules(t *testing.T) {
+	tests := []struct {
+		name       string
+		rules      MatchingRules
+		filePath   string
+		gemName    string
+		gemVersion string
+		expected   bool
+	}{
+		{
+			name:     "matching path",
+			rules:    MatchingRules{{Path: "app/*"}},
+			filePath: "app/Gemfile",
+			gemName:  "rails",
+			expected: true,
+		},
+		{
+			name:     "not matching path",
+			rules:    MatchingRules{{Path: "api/*"}},
+			filePath: "app/Gemfile",
+			gemName:  "rails",
+		},
+		{
+			name:       "matching gem version constraint",
+			rules:      MatchingRules{{Gems: map[string]string{"rails": ">=7.0"}}},
+			filePath:   "Gemfile",
+			gemName:    "rails",
+			gemVersion: "7.1.3",
+			expected:   true,
+		},
+		{
+			name:       "not matching gem version constraint",
+			rules:      MatchingRules{{Gems: map[string]string{"rails": "<7.0"}}},
+			filePath:   "Gemfile",
+			gemName:    "rails",
+			gemVersion: "7.1.3",
+		},
+		{
+			name:       "path and gem must both match",
+			rules:      MatchingRules{{Path: "api/*", Gems: map[string]string{"rails": ""}}},
+			filePath:   "app/Gemfile",
+			gemName:    "rails",
+			gemVersion: "7.1.3",
+		},
+		{
+			name: "any rule can match",
+			rules: MatchingRules{
+				{Path: "api/*"},
+				{Gems: map[string]string{"rails": ""}},
+			},
+			filePath: "app/Gemfile",
+			gemName:  "rails",
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rules.isMatchingRules("", tt.filePath, tt.gemName, tt.gemVersion)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestMatchingRulesValidate(t *testing.T) {
+	assert.NoError(t, MatchingRules{{Path: "Gemfile"}}.Validate())
+	assert.Error(t, MatchingRules{{}}.Validate())
+}
@@ -0,0 +1,293 @@
+package bundler
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// gemfile is the file name used by Bundler to declare dependencies
+	gemfile string = "Gemfile"
+	// gemfileLock is the file name used by Bundler to lock dependencies
+	gemfileLock string = "Gemfile.lock"
+)
+
+// skipDirs lists directories that should never be walked for Gemfile files.
+var skipDirs = map[string]bool{
+	".bundle":      true,
+	".git":         true,
+	"node_modules": true,
+	"vendor":       true,
+}
+
+var (
+	// gemRegex parses a Gemfile gem declaration such as
+	//   gem "rails", "~> 7.1", ">= 7.1.2", require: false
+	// Group 1: gem name
+	// Group 2: requirement strings, including their surrounding quotes
+	// Group 3: remaining options
+	gemRegex = regexp.MustCompile(`^\s*gem\s*\(?\s*["']([A-Za-z0-9._-]+)["']((?:\s*,\s*["'][^"']*["'])*)(.*)$`)
+
+	// requirementRegex extracts each requirement string from gemRegex group 2
+	requirementRegex = regexp.MustCompile(`["']([^"']*)["']`)
+
+	// nonRegistryOptionRegex detects gems which aren't installed from a gem registry
+	nonRegistryOptionRegex = regexp.MustCompile(`(^|[\s,(:])(git|github|gitlab|bitbucket|path|source)(:|\s*=>)`)
+
+	// exactVersionRegex matches a requirement pinning a single version such as "1.2.3" or "= 1.2.3"
+	exactVersionRegex = regexp.MustCompile(`^(=\s*)?(\d+(\.[0-9A-Za-z]+)*)$`)
+
+	// requirementOperatorRegex splits a requirement into its operator and version
+	requirementOperatorRegex = regexp.MustCompile(`^(~>|>=|<=|!=|>|<|=)?\s*(\d+(\.[0-9A-Za-z]+)*)$`)
+
+	// lockSpecRegex matches a top level gem entry from a Gemfile.lock specs list such as
+	//     nokogiri (1.16.0-x86_64-linux)
+	lockSpecRegex = regexp.MustCompile(`^ {4}([A-Za-z0-9._-]+) \(([^)]+)\)$`)
+)
+
+// gemDependency holds a gem declaration parsed from a Gemfile
+type gemDependency struct {
+	// Name is the gem name
+	Name string
+	// Requirements lists the version requirements such as "~> 7.1"
+	Requirements []string
+	// LockedVersion is the version resolved in the Gemfile.lock, if any
+	LockedVersion string
+}
+
+// pinnedVersion returns the version when the gem is pinned to a single version
+// such as `gem "rails", "7.1.2"`, or an empty string otherwise.
+func (g gemDependency) pinnedVersion() string {
+	if len(g.Requirements) != 1 {
+		return ""
+	}
+
+	matches := exactVersionRegex.FindStringSubmatch(g.Requirements[0])
+	if matches == nil {
+		return ""
+	}
+
+	return matches[2]
+}
+
+// currentVersion returns the most accurate version known for the gem.
+func (g gemDependency) currentVersion() string {
+	if g.LockedVersion != "" {
+		return g.LockedVersion
+	}
+
+	if v := g.pinnedVersion(); v != "" {
+		return v
+	}
+
+	for _, requirement := range g.Requirements {
+		matches := requirementOperatorRegex.FindStringSubmatch(requirement)
+		if matches == nil {
+			continue
+		}
+		switch matches[1] {
+		case "", "=", "~>", ">=":
+			return matches[2]
+		}
+	}
+
+	return ""
+}
+
+// searchGemfiles walks rootDir recursively and returns every Gemfile found.
+func searchGemfiles(rootDir string) ([]string, error) {
+	var found []string
+
+	err := filepath.WalkDir(rootDir, func(path string, di fs.DirEntry, err error) error {
+		if err != nil {
+			logrus.Errorf("accessing path %q: %v", path, err)
+			return err
+		}
+
+		if di.IsDir() {
+			if skipDirs[di.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if di.Name() == gemfile {
+			found = append(found, path)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Debugf("%d Gemfile(s) found", len(found))
+	for _, f := range found {
+		logrus.Debugf("    * %q", f)
+	}
+
+	return found, nil
+}
+
+// parseGemfile returns the gems declared in a Gemfile which are installed from a gem registry.
+// Gems fetched from a git repository or a local path are skipped.
+func parseGemfile(filename string) ([]gemDependency, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var gems []gemDependency
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		matches := gemRegex.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+
+		name := matches[1]
+		options := matches[3]
+		if i := strings.Index(options, "#"); i != -1 {
+			options = options[:i]
+		}
+
+		if nonRegistryOptionRegex.MatchString(options) {
+			logrus.Debugf("skipping gem %q from %q, not installed from a gem registry", name, filename)
+			continue
+		}
+
+		gem := gemDependency{Name: name}
+		for _, requirement := range requirementRegex.FindAllStringSubmatch(matches[2], -1) {
+			gem.Requirements = append(gem.Requirements, strings.TrimSpace(requirement[1]))
+		}
+
+		gems = append(gems, gem)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %q: %w", filename, err)
+	}
+
+	return gems, nil
+}
+
+// parseGemfileLock returns the gem versions locked in the GEM section of a Gemfile.lock.
+// Platform suffixes such as "-x86_64-linux" are removed from the versions.
+func parseGemfileLock(filename string) (map[string]string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	versions := map[string]string{}
+	inGemSection := false
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		// Sections such as GEM, GIT, PATH, or PLATFORMS start at the beginning of the line
+		if line != "" && !strings.HasPrefix(line, " ") {
+			inGemSection = line == "GEM"
+			continue
+		}
+
+		if !inGemSection {
+			continue
+		}
+
+		matches := lockSpecRegex.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+
+		version, _, _ := strings.Cut(matches[2], "-")
+		versions[matches[1]] = version
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %q: %w", filename, err)
+	}
+
+	return versions, nil
+}
+
+// convertRequirementsToConstraint converts Ruby gem requirements to a semantic versioning constraint.
+// The pessimistic operator "~> 7.1" becomes ">=7.1, <8" and "~> 7.1.2" becomes ">=7.1.2, <7.2".
+func convertRequirementsToConstraint(requirements []string) (string, error) {
+	var constraints []string
+
+	for _, requirement := range requirements {
+		matches := requirementOperatorRegex.FindStringSubmatch(requirement)
+		if matches == nil {
+			return "", fmt.Errorf("unsupported gem requirement %q", requirement)
+		}
+
+		operator, version := matches[1], matches[2]
+
+		switch operator {
+		case "~>":
+			upperBound, err := pessimisticUpperBound(version)
+			if err != nil {
+				return "", err
+			}
+			constraints = append(constraints, ">="+version, "<"+upperBound)
+		case "":
+			constraints = append(constraints, "="+version)
+		default:
+			constraints = append(constraints, operator+version)
+		}
+	}
+
+	return strings.Join(constraints, ", "), nil
+}
+
+// pessimisticUpperBound returns the exclusive upper bound of the pessimistic operator "~>"
+func pessimisticUpperBound(version string) (string, error) {
+	segments := strings.Split(version, ".")
+
+	// "~> 7" is the same as ">= 7, < 8", as the last segment is only dropped when there are several
+	if len(segments) > 1 {
+		segments = segments[:len(segments)-1]
+	}
+
+	last, err := strconv.Atoi(segments[len(segments)-1])
+	if err != nil {
+		return "", fmt.Errorf("unsupported gem requirement \"~> %s\": %w", version, err)
+	}
+	segments[len(segments)-1] = strconv.Itoa(last + 1)
+
+	return strings.Join(segments, "."), nil
+}
+
+// isLockFileDetected reports whether the given lock file exists on disk.
+func isLockFileDetected(lockfile string) bool {
+	_, err := os.Stat(lockfile)
+	return err == nil
+}
+
+// isBundlerAvailable reports whether the bundle command is present on PATH.
+func isBundlerAvailable() bool {
+	return exec.Command("bundle", "--version").Run() == nil
+}
+
+// gemPattern returns the regular expression matching the version of a pinned gem in a Gemfile.
+// The version itself isn't part of the pattern so the generated manifest stays valid once bumped.
+func gemPattern(name string) string {
+	return fmt.Sprintf(`(gem\s*\(?\s*["']%s["']\s*,\s*["'](?:=\s*)?)[^"']+(["'])`, regexp.QuoteMeta(name))
+}
@@ -0,0 +1,84 @@
+package bundler
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGemfile(t *testing.T) {
+	got, err := parseGemfile("testdata/simple/Gemfile")
+	require.NoError(t, err)
+
+	assert.Equal(t, []gemDependency{
+		{Name: "rails", Requirements: []string{"~> 7.1.2"}},
+		{Name: "puma", Requirements: []string{"6.4.0"}},
+		{Name: "bootsnap"},
+		{Name: "rubocop", Requirements: []string{">= 1.50", "< 2.0"}},
+	}, got)
+}
+
+func TestParseGemfileLock(t *testing.T) {
+	got, err := parseGemfileLock("testdata/simple/Gemfile.lock")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"bootsnap": "1.17.0",
+		"msgpack":  "1.7.2",
+		"nokogiri": "1.16.0",
+		"puma":     "6.4.0",
+		"rails":    "7.1.3",
+		"rubocop":  "1.60.2",
+	}, got)
+}
+
+func TestConvertRequirementsToConstraint(t *testing.T) {
+	tests := []struct {
+		requirements []string
+		expected     string
+		wantErr      bool
+	}{
+		{requirements: []string{"~> 7.1"}, expected: ">=7.1, <8"},
+		{requirements: []string{"~> 7.1.2"}, expected: ">=7.1.2, <7.2"},
+		{requirements: []string{"~> 7"}, expected: ">=7, <8"},
+		{requirements: []string{">= 1.50", "< 2.0"}, expected: ">=1.50, <2.0"},
+		{requirements: []string{"!= 1.2.3"}, expected: "!=1.2.3"},
+		{requirements: []string{"1.2.3"}, expected: "=1.2.3"},
+		{requirements: []string{"~> 1.x.0"}, wantErr: true},
+		{requirements: []string{"latest"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.requirements, ", "), func(t *testing.T) {
+			got, err := convertRequirementsToConstraint(tt.requirements)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestGemPattern(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected string
+	}{
+		{line: `gem "puma", "6.4.0"`, expected: `gem "puma", "6.5.0"`},
+		{line: `gem 'puma', '= 6.4.0', require: false`, expected: `gem 'puma', '= 6.5.0', require: false`},
+		{line: `gem("puma", "6.4.0")`, expected: `gem("puma", "6.5.0")`},
+		{line: `gem "puma-status", "6.4.0"`, expected: `gem "puma-status", "6.4.0"`},
+	}
+
+	re := regexp.MustCompile(gemPattern("puma"))
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			assert.Equal(t, tt.expected, re.ReplaceAllString(tt.line, "${1}6.5.0${2}"))
+		})
+	}
+}
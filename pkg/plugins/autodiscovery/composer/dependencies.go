@@ -0,0 +1,176 @@
+package composer
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+
+	composerResource "github.com/updatecli/updatecli/pkg/plugins/resources/composer"
+)
+
+func (c Composer) discoverDependencyManifests() ([][]byte, error) {
+	var manifests [][]byte
+
+	searchFromDir := c.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if c.spec.RootDir != "" && !path.IsAbs(c.spec.RootDir) {
+		searchFromDir = filepath.Join(c.rootDir, c.spec.RootDir)
+	}
+
+	foundFiles, err := searchComposerFiles(searchFromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New("manifest").Parse(manifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+		logrus.Debugf("parsing file %q", foundFile)
+
+		dir := filepath.Dir(foundFile)
+
+		relativeFoundFile, err := filepath.Rel(c.rootDir, foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		workdir, err := filepath.Rel(c.rootDir, dir)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		lockedVersions := map[string]string{}
+		hasLockFile := isLockFileDetected(filepath.Join(dir, composerLock))
+		if hasLockFile {
+			// It doesn't make sense to update the composer.json if Updatecli can't update the composer.lock
+			if !c.composerAvailable {
+				logrus.Warningf("skipping %q, composer.lock detected but Updatecli couldn't detect the composer command to update it", relativeFoundFile)
+				continue
+			}
+
+			lockedVersions, err = parseComposerLock(filepath.Join(dir, composerLock))
+			if err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+		}
+
+		dependencies, err := parseComposerJSON(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		if len(dependencies) == 0 {
+			logrus.Debugf("no Composer package found in %q", relativeFoundFile)
+			continue
+		}
+
+		for _, dependency := range dependencies {
+			dependency.LockedVersion = lockedVersions[strings.ToLower(dependency.Name)]
+
+			if len(c.spec.Ignore) > 0 && c.spec.Ignore.isMatchingRules(c.rootDir, relativeFoundFile, dependency.Name, dependency.currentVersion()) {
+				logrus.Debugf("ignoring Composer package %q from %q, as matching ignore rule(s)", dependency.Name, relativeFoundFile)
+				continue
+			}
+
+			if len(c.spec.Only) > 0 && !c.spec.Only.isMatchingRules(c.rootDir, relativeFoundFile, dependency.Name, dependency.currentVersion()) {
+				logrus.Debugf("ignoring Composer package %q from %q, as not matching only rule(s)", dependency.Name, relativeFoundFile)
+				continue
+			}
+
+			if dependency.isDevConstraint() {
+				logrus.Debugf("skipping Composer package %q from %q, development branch %q required", dependency.Name, relativeFoundFile, dependency.Constraint)
+				continue
+			}
+
+			if _, err := composerResource.ConvertConstraint(dependency.Constraint); err != nil {
+				logrus.Warningf("skipping Composer package %q from %q: %s", dependency.Name, relativeFoundFile, err)
+				continue
+			}
+
+			operator, isSimpleConstraint := dependency.operator()
+
+			if !isSimpleConstraint && !hasLockFile {
+				logrus.Debugf("skipping Composer package %q from %q, constraint %q can't be bumped and no composer.lock found", dependency.Name, relativeFoundFile, dependency.Constraint)
+				continue
+			}
+
+			params := manifestTemplateParams{
+				ManifestName:   fmt.Sprintf("deps(composer): bump %q package version", dependency.Name),
+				ActionID:       c.actionID,
+				SourceID:       "composer",
+				SourceName:     fmt.Sprintf("Get latest %q package version", dependency.Name),
+				SourceURL:      c.spec.URL,
+				SourceUsername: c.spec.Username,
+				SourcePassword: c.spec.Password,
+				SourceToken:    c.spec.Token,
+				PackageName:    dependency.Name,
+				TargetID:       "composer.json",
+				TargetLockID:   "composer.lock",
+				TargetName:     fmt.Sprintf("deps(composer): bump %q package to {{ source \"composer\" }}", dependency.Name),
+				// Composer package names may contain dots which have a different meaning in Dasel query
+				TargetKey:                 fmt.Sprintf("%s.%s", dependency.Section, strings.ReplaceAll(dependency.Name, ".", `\.`)),
+				TargetPrefix:              operator,
+				TargetComposerJSONEnabled: isSimpleConstraint,
+				TargetLockEnabled:         hasLockFile,
+				File:                      relativeFoundFile,
+				LockFile:                  composerLock,
+				Workdir:                   workdir,
+				ScmID:                     c.scmID,
+			}
+
+			params.SourceConstraint, params.SourceVersionFilterKind, params.SourceVersionFilterPattern, params.SourceVersionFilterRegex = c.getVersionFilter(dependency)
+
+			manifest := bytes.Buffer{}
+			if err := tmpl.Execute(&manifest, params); err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			manifests = append(manifests, manifest.Bytes())
+		}
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
+
+// getVersionFilter returns either the Composer constraint, or the version filter kind, pattern,
+// and regex used by the generated source.
+//
+// Pattern order
+//  1. Packages pinned to a version use ">=" the current version
+//  2. Other packages reuse the constraint defined in the composer.json
+//  3. Unless a versionfilter is defined in the manifest, in which case its kind and pattern are used
+//     starting from the current version
+func (c Composer) getVersionFilter(dependency packageDependency) (constraint, kind, pattern, regex string) {
+	currentVersion := dependency.currentVersion()
+
+	if !c.spec.VersionFilter.IsZero() {
+		pattern, err := c.versionFilter.GreaterThanPattern(currentVersion)
+		if err != nil {
+			logrus.Debugf("building version filter pattern: %s", err)
+			pattern = "*"
+		}
+		return "", c.versionFilter.Kind, pattern, c.versionFilter.Regex
+	}
+
+	if dependency.isPinned() {
+		return "", "semver", ">=" + currentVersion, ""
+	}
+
+	return dependency.Constraint, "", "", ""
+}
@@ -0,0 +1,150 @@
+// Package composer implements the autodiscovery crawler for PHP projects managed by Composer.
+//
+// It walks a root directory looking for composer.json files and generates one manifest per
+// package listed in "require" or "require-dev". Platform requirements, such as "php" or
+// "ext-json", are skipped since they aren't published on a Composer repository.
+//
+//   - Packages required with a simple constraint, such as "^6.4", "~6.4.1", ">=6.4", or "6.4.1",
+//     get a json target bumping the constraint in the composer.json while keeping its operator.
+//   - When a composer.lock exists, a shell target running `composer update` keeps it in sync.
+//     If the composer command is missing, the whole composer.json is skipped since Updatecli
+//     cannot re-lock what it would bump.
+//   - Other constraints, such as "^5.4 || ^6.0", are kept untouched: only the composer.lock
+//     is updated, within that constraint.
+package composer
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines the parameters which can be provided to the Composer crawler.
+type Spec struct {
+	// RootDir defines the root directory used to recursively search for composer.json
+	RootDir string `yaml:",omitempty"`
+	// Ignore allows to specify rule to ignore autodiscovery a specific Composer package based on a rule
+	Ignore MatchingRules `yaml:",omitempty"`
+	// Only allows to specify rule to only autodiscover manifest for a specific Composer package based on a rule
+	Only MatchingRules `yaml:",omitempty"`
+	//  `versionfilter` provides parameters to specify the version pattern used when generating manifest.
+	//
+	//  By default, the constraint defined in the composer.json is respected.
+	//  When a versionfilter is defined, it replaces that constraint, starting from the current version.
+	//
+	//  kind - semver
+	//    versionfilter of kind `semver` uses semantic versioning as version filtering
+	//    pattern accepts one of:
+	//      `prerelease` - Updatecli tries to identify the latest prerelease whatever it means
+	//      `patch` - Updatecli only handles patch version update
+	//      `minor` - Updatecli handles patch AND minor version update
+	//      `minoronly` - Updatecli handles minor version only
+	//      `major` - Updatecli handles patch, minor, AND major version update
+	//      `majoronly` - Updatecli only handles major version update
+	//      `a version constraint` such as `>= 1.0.0`
+	//
+	//  kind - regex
+	//    versionfilter of kind `regex` uses regular expression as version filtering
+	//    pattern accepts a valid regular expression
+	//
+	//  example:
+	//  ```
+	//    versionfilter:
+	//      kind: semver
+	//      pattern: minor
+	//  ```
+	//
+	//  More examples can be found at https://www.updatecli.io/docs/core/versionfilter/
+	VersionFilter version.Filter `yaml:",omitempty"`
+	// URL defines the Composer repository url (defaults to `https://repo.packagist.org`).
+	// This will be propagated to all generated composer resource specs.
+	URL string `yaml:",omitempty"`
+	// Username defines the username used to authenticate against a private repository.
+	// This will be propagated to all generated composer resource specs.
+	Username string `yaml:",omitempty"`
+	// Password defines the password used to authenticate against a private repository.
+	// This will be propagated to all generated composer resource specs.
+	Password string `yaml:",omitempty"`
+	// Token defines the bearer token used to authenticate against a private repository.
+	// This will be propagated to all generated composer resource specs.
+	Token string `yaml:",omitempty"`
+}
+
+// Composer holds all information needed to generate Composer manifests.
+type Composer struct {
+	// actionID holds the actionID used by the newly generated manifest
+	actionID string
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// rootDir defines the root directory from where looking for composer.json
+	rootDir string
+	// scmID holds the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+	// composerAvailable reports whether the composer command is present on PATH
+	composerAvailable bool
+}
+
+// New return a new valid object.
+func New(spec interface{}, rootDir, scmID, actionID string) (Composer, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Composer{}, err
+	}
+
+	if err := s.Ignore.Validate(); err != nil {
+		return Composer{}, fmt.Errorf("invalid ignore spec: %w", err)
+	}
+
+	if err := s.Only.Validate(); err != nil {
+		return Composer{}, fmt.Errorf("invalid only spec: %w", err)
+	}
+
+	if s.Token != "" && (s.Username != "" || s.Password != "") {
+		return Composer{}, fmt.Errorf("token and username/password are mutually exclusive")
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return Composer{}, fmt.Errorf("no working directory defined")
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		// By default, Composer packages are expected to follow semantic versioning
+		newFilter.Kind = "semver"
+		newFilter.Pattern = "*"
+	}
+
+	return Composer{
+		actionID:          actionID,
+		spec:              s,
+		rootDir:           dir,
+		scmID:             scmID,
+		versionFilter:     newFilter,
+		composerAvailable: isComposerAvailable(),
+	}, nil
+}
+
+// DiscoverManifests returns updatecli manifests for all Composer packages found under rootDir.
+func (c Composer) DiscoverManifests() ([][]byte, error) {
+	logrus.Infof("\n\n%s\n", strings.ToTitle("Composer"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("Composer")+1))
+
+	return c.discoverDependencyManifests()
+}
@@ -0,0 +1,238 @@
+package composer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	testdata := []struct {
+		name              string
+		rootDir           string
+		scmID             string
+		actionID          string
+		spec              Spec
+		composerAvailable bool
+		expectedPipelines []string
+	}{
+		{
+			name:              "composer.json with lock file",
+			rootDir:           "testdata/simple",
+			scmID:             "default",
+			composerAvailable: true,
+			spec: Spec{
+				Ignore: MatchingRules{
+					{Packages: map[string]string{"phpunit/phpunit": ""}},
+				},
+			},
+			expectedPipelines: []string{
+				`name: 'deps(composer): bump "monolog/monolog" package version'
+sources:
+  composer:
+    name: 'Get latest "monolog/monolog" package version'
+    kind: 'composer'
+    spec:
+      name: 'monolog/monolog'
+      constraint: '^3.5'
+targets:
+  composer.json:
+    name: 'deps(composer): bump "monolog/monolog" package to {{ source "composer" }}'
+    scmid: 'default'
+    kind: 'json'
+    spec:
+      file: 'composer.json'
+      key: 'require.monolog/monolog'
+    sourceid: 'composer'
+    transformers:
+      - addprefix: '^'
+  composer.lock:
+    name: 'deps(composer): bump "monolog/monolog" package to {{ source "composer" }}'
+    scmid: 'default'
+    dependson:
+      - 'target#composer.json'
+    kind: 'shell'
+    spec:
+      command: 'composer update --no-install --no-interaction --no-scripts monolog/monolog'
+      changedif:
+        kind: file/checksum
+        spec:
+          files:
+            - "composer.lock"
+      environments:
+        - name: PATH
+        - name: HOME
+      workdir: '.'
+    disablesourceinput: true
+`,
+				`name: 'deps(composer): bump "symfony/console" package version'
+sources:
+  composer:
+    name: 'Get latest "symfony/console" package version'
+    kind: 'composer'
+    spec:
+      name: 'symfony/console'
+      constraint: '^5.4 || ^6.0'
+targets:
+  composer.lock:
+    name: 'deps(composer): bump "symfony/console" package to {{ source "composer" }}'
+    scmid: 'default'
+    kind: 'shell'
+    spec:
+      command: 'composer update --no-install --no-interaction --no-scripts symfony/console'
+      changedif:
+        kind: file/checksum
+        spec:
+          files:
+            - "composer.lock"
+      environments:
+        - name: PATH
+        - name: HOME
+      workdir: '.'
+    disablesourceinput: true
+`,
+			},
+		},
+		{
+			name:              "pinned package with version filter",
+			rootDir:           "testdata/simple",
+			actionID:          "default",
+			composerAvailable: true,
+			spec: Spec{
+				Only: MatchingRules{
+					{Packages: map[string]string{"phpunit/phpunit": ">=10"}},
+				},
+				URL:   "https://repo.packagist.com/acme",
+				Token: "secret",
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "minor",
+				},
+			},
+			expectedPipelines: []string{
+				`name: 'deps(composer): bump "phpunit/phpunit" package version'
+actions:
+  default:
+    title: 'deps(composer): bump "phpunit/phpunit" package to {{ source "composer" }}'
+
+sources:
+  composer:
+    name: 'Get latest "phpunit/phpunit" package version'
+    kind: 'composer'
+    spec:
+      name: 'phpunit/phpunit'
+      url: 'https://repo.packagist.com/acme'
+      token: 'secret'
+      versionfilter:
+        kind: 'semver'
+        pattern: '10.x'
+targets:
+  composer.json:
+    name: 'deps(composer): bump "phpunit/phpunit" package to {{ source "composer" }}'
+    kind: 'json'
+    spec:
+      file: 'composer.json'
+      key: 'require-dev.phpunit/phpunit'
+    sourceid: 'composer'
+  composer.lock:
+    name: 'deps(composer): bump "phpunit/phpunit" package to {{ source "composer" }}'
+    dependson:
+      - 'target#composer.json'
+    kind: 'shell'
+    spec:
+      command: 'composer update --no-install --no-interaction --no-scripts phpunit/phpunit'
+      changedif:
+        kind: file/checksum
+        spec:
+          files:
+            - "composer.lock"
+      environments:
+        - name: PATH
+        - name: HOME
+      workdir: '.'
+    disablesourceinput: true
+`,
+			},
+		},
+		{
+			name:    "composer.lock without composer command",
+			rootDir: "testdata/simple",
+		},
+		{
+			name:    "composer.json without lock file",
+			rootDir: "testdata/no_lockfile",
+			expectedPipelines: []string{
+				`name: 'deps(composer): bump "guzzlehttp/guzzle" package version'
+sources:
+  composer:
+    name: 'Get latest "guzzlehttp/guzzle" package version'
+    kind: 'composer'
+    spec:
+      name: 'guzzlehttp/guzzle'
+      constraint: '~7.8.1'
+targets:
+  composer.json:
+    name: 'deps(composer): bump "guzzlehttp/guzzle" package to {{ source "composer" }}'
+    kind: 'json'
+    spec:
+      file: 'app/composer.json'
+      key: 'require.guzzlehttp/guzzle'
+    sourceid: 'composer'
+    transformers:
+      - addprefix: '~'
+`,
+				`name: 'deps(composer): bump "laminas/laminas-diactoros" package version'
+sources:
+  composer:
+    name: 'Get latest "laminas/laminas-diactoros" package version'
+    kind: 'composer'
+    spec:
+      name: 'laminas/laminas-diactoros'
+      constraint: '>=3.3'
+targets:
+  composer.json:
+    name: 'deps(composer): bump "laminas/laminas-diactoros" package to {{ source "composer" }}'
+    kind: 'json'
+    spec:
+      file: 'app/composer.json'
+      key: 'require.laminas/laminas-diactoros'
+    sourceid: 'composer'
+    transformers:
+      - addprefix: '>='
+`,
+			},
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(tt.spec, tt.rootDir, tt.scmID, tt.actionID)
+			require.NoError(t, err)
+
+			// Override composerAvailable so tests are deterministic regardless of
+			// whether the composer command is installed in the test environment.
+			c.composerAvailable = tt.composerAvailable
+
+			manifests, err := c.DiscoverManifests()
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.expectedPipelines), len(manifests))
+			for i := range manifests {
+				assert.Equal(t, tt.expectedPipelines[i], string(manifests[i]))
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Spec{Only: MatchingRules{{}}}, "testdata/simple", "", "")
+	assert.ErrorContains(t, err, "invalid only spec")
+
+	_, err = New(Spec{Token: "secret", Username: "token"}, "testdata/simple", "", "")
+	assert.ErrorContains(t, err, "mutually exclusive")
+
+	_, err = New(Spec{}, "", "", "")
+	assert.ErrorContains(t, err, "no working directory defined")
+}
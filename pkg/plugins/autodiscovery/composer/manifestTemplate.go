@@ -0,0 +1,108 @@
+package composer
+
+// manifestTemplate is the Go template used to generate updatecli manifests
+// for package updates discovered via composer.json.
+var manifestTemplate = `name: '{{ .ManifestName }}'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+    title: '{{ .TargetName }}'
+{{ end }}
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'composer'
+    spec:
+      name: '{{ .PackageName }}'
+{{- if .SourceURL }}
+      url: '{{ .SourceURL }}'
+{{- end }}
+{{- if .SourceUsername }}
+      username: '{{ .SourceUsername }}'
+{{- end }}
+{{- if .SourcePassword }}
+      password: '{{ .SourcePassword }}'
+{{- end }}
+{{- if .SourceToken }}
+      token: '{{ .SourceToken }}'
+{{- end }}
+{{- if .SourceConstraint }}
+      constraint: '{{ .SourceConstraint }}'
+{{- else }}
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+{{- if or (eq .SourceVersionFilterKind "regex/semver") (eq .SourceVersionFilterKind "regex/time") }}
+        regex: '{{ .SourceVersionFilterRegex }}'
+{{- end }}
+{{- end }}
+targets:
+{{- if .TargetComposerJSONEnabled }}
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    kind: 'json'
+    spec:
+      file: '{{ .File }}'
+      key: '{{ .TargetKey }}'
+    sourceid: '{{ .SourceID }}'
+{{- if .TargetPrefix }}
+    transformers:
+      - addprefix: '{{ .TargetPrefix }}'
+{{- end }}
+{{- end }}
+{{- if .TargetLockEnabled }}
+  {{ .TargetLockID }}:
+    name: '{{ .TargetName }}'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+{{- if .TargetComposerJSONEnabled }}
+    dependson:
+      - 'target#{{ .TargetID }}'
+{{- end }}
+    kind: 'shell'
+    spec:
+      command: 'composer update --no-install --no-interaction --no-scripts {{ .PackageName }}'
+      changedif:
+        kind: file/checksum
+        spec:
+          files:
+            - "{{ .LockFile }}"
+      environments:
+        - name: PATH
+        - name: HOME
+      workdir: '{{ .Workdir }}'
+    disablesourceinput: true
+{{- end }}
+`
+
+// manifestTemplateParams holds the values injected into manifestTemplate.
+type manifestTemplateParams struct {
+	ManifestName               string
+	ActionID                   string
+	SourceID                   string
+	SourceName                 string
+	SourceURL                  string
+	SourceUsername             string
+	SourcePassword             string
+	SourceToken                string
+	SourceConstraint           string
+	SourceVersionFilterKind    string
+	SourceVersionFilterPattern string
+	SourceVersionFilterRegex   string
+	PackageName                string
+	TargetID                   string
+	TargetLockID               string
+	TargetName                 string
+	TargetKey                  string
+	TargetPrefix               string
+	TargetComposerJSONEnabled  bool
+	TargetLockEnabled          bool
+	File                       string
+	LockFile                   string
+	Workdir                    string
+	ScmID                      string
+}
@@ -0,0 +1,107 @@
+package composer
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// MatchingRule allows to specifies rules to identify manifest
+type MatchingRule struct {
+	// Path specifies a composer.json path pattern, the pattern requires to match all of name, not just a substring.
+	Path string `yaml:",omitempty"`
+	// Packages specifies the list of Composer packages to check, keyed by "vendor/package" name.
+	// The value is a semantic versioning constraint such as ">=7.0" or empty to match any version.
+	// The constraint is checked against the version locked in the composer.lock,
+	// or against the version declared in the composer.json when no lock file exists.
+	Packages map[string]string `yaml:",omitempty"`
+}
+
+// MatchingRules is a slice of MatchingRule.
+type MatchingRules []MatchingRule
+
+// Validate checks that each matching rule has at least one non-empty field.
+func (m MatchingRules) Validate() error {
+	for i, rule := range m {
+		if rule.Path == "" && len(rule.Packages) == 0 {
+			return fmt.Errorf("rule %d has no valid fields (path or packages must be specified)", i+1)
+		}
+	}
+	return nil
+}
+
+// isMatchingRules reports whether the given composer.json/package pair matches any rule in the list.
+// Multiple conditions within one rule are AND-ed; multiple rules are OR-ed.
+func (m MatchingRules) isMatchingRules(rootDir, filePath, packageName, packageVersion string) bool {
+	for _, rule := range m {
+		var ruleResults []bool
+
+		if rule.Path != "" {
+			fp := filePath
+			if filepath.IsAbs(rule.Path) {
+				fp = filepath.Join(rootDir, filePath)
+			}
+
+			match, err := filepath.Match(rule.Path, fp)
+			if err != nil {
+				logrus.Errorf("%s - %q", err, rule.Path)
+				continue
+			}
+			ruleResults = append(ruleResults, match)
+			if match {
+				logrus.Debugf("file path %q matching rule %q", fp, rule.Path)
+			}
+		}
+
+		if len(rule.Packages) > 0 {
+			match := false
+
+			// Composer package names are case insensitive
+			for rulePackageName, rulePackageVersion := range rule.Packages {
+				if strings.EqualFold(rulePackageName, packageName) {
+					match = isVersionMatching(packageVersion, rulePackageVersion)
+					break
+				}
+			}
+
+			ruleResults = append(ruleResults, match)
+		}
+
+		isAllMatching := true
+		for _, r := range ruleResults {
+			if !r {
+				isAllMatching = false
+				break
+			}
+		}
+		if isAllMatching && len(ruleResults) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isVersionMatching checks a package version against a matching rule constraint.
+func isVersionMatching(packageVersion, ruleConstraint string) bool {
+	if ruleConstraint == "" {
+		return true
+	}
+
+	v, err := semver.NewVersion(packageVersion)
+	if err != nil {
+		logrus.Debugf("%q - %s", packageVersion, err)
+		return packageVersion == ruleConstraint
+	}
+
+	c, err := semver.NewConstraint(ruleConstraint)
+	if err != nil {
+		logrus.Debugf("%q %s", err, ruleConstraint)
+		return packageVersion == ruleConstraint
+	}
+
+	return c.Check(v)
+}
@@ -0,0 +1,84 @@
+package composer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchingRules(t *testing.T) {
+	tests := []struct {
+		name           string
+		rules          MatchingRules
+		filePath       string
+		packageName    string
+		packageVersion string
+		expected       bool
+	}{
+		{
+			name:        "matching path",
+			rules:       MatchingRules{{Path: "app/*"}},
+			filePath:    "app/composer.json",
+			packageName: "monolog/monolog",
+			expected:    true,
+		},
+		{
+			name:        "not matching path",
+			rules:       MatchingRules{{Path: "api/*"}},
+			filePath:    "app/composer.json",
+			packageName: "monolog/monolog",
+		},
+		{
+			name:           "matching package version constraint",
+			rules:          MatchingRules{{Packages: map[string]string{"monolog/monolog": ">=3.0"}}},
+			filePath:       "composer.json",
+			packageName:    "monolog/monolog",
+			packageVersion: "3.5.0",
+			expected:       true,
+		},
+		{
+			name:           "package names are case insensitive",
+			rules:          MatchingRules{{Packages: map[string]string{"Monolog/Monolog": ""}}},
+			filePath:       "composer.json",
+			packageName:    "monolog/monolog",
+			packageVersion: "3.5.0",
+			expected:       true,
+		},
+		{
+			name:           "not matching package version constraint",
+			rules:          MatchingRules{{Packages: map[string]string{"monolog/monolog": "<3.0"}}},
+			filePath:       "composer.json",
+			packageName:    "monolog/monolog",
+			packageVersion: "3.5.0",
+		},
+		{
+			name:           "path and package must both match",
+			rules:          MatchingRules{{Path: "api/*", Packages: map[string]string{"monolog/monolog": ""}}},
+			filePath:       "app/composer.json",
+			packageName:    "monolog/monolog",
+			packageVersion: "3.5.0",
+		},
+		{
+			name: "any rule can match",
+			rules: MatchingRules{
+				{Path: "api/*"},
+				{Packages: map[string]string{"monolog/monolog": ""}},
+			},
+			filePath:    "app/composer.json",
+			packageName: "monolog/monolog",
+			expected:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rules.isMatchingRules("", tt.filePath, tt.packageName, tt.packageVersion)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestMatchingRulesValidate(t *testing.T) {
+	assert.NoError(t, MatchingRules{{Path: "composer.json"}}.Validate())
+	assert.Error(t, MatchingRules{{}}.Validate())
+}
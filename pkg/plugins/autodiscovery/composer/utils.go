@@ -0,0 +1,228 @@
+package composer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// composerJSON is the file name used by Composer to declare dependencies
+	composerJSON string = "composer.json"
+	// composerLock is the file name used by Composer to lock dependencies
+	composerLock string = "composer.lock"
+)
+
+// skipDirs lists directories that should never be walked for composer.json files.
+var skipDirs = map[string]bool{
+	".git":         true,
+	"node_modules": true,
+	"vendor":       true,
+}
+
+var (
+	// simpleConstraintRegex matches a constraint that can be bumped while keeping its operator,
+	// such as "^6.4", "~6.4.1", ">=6.4", or "6.4.1".
+	// Group 1: operator
+	// Group 2: version
+	simpleConstraintRegex = regexp.MustCompile(`^(\^|>=|~)?v?(\d+(?:\.\d+){0,2})$`)
+
+	// versionRegex extracts the first version from a constraint
+	versionRegex = regexp.MustCompile(`\d+(\.\d+)*`)
+)
+
+// composerManifest holds the subset of a composer.json used by the crawler
+type composerManifest struct {
+	Require    map[string]string `json:"require"`
+	RequireDev map[string]string `json:"require-dev"`
+}
+
+// composerLockfile holds the subset of a composer.lock used by the crawler
+type composerLockfile struct {
+	Packages    []lockedPackage `json:"packages"`
+	PackagesDev []lockedPackage `json:"packages-dev"`
+}
+
+// lockedPackage is a package version resolved in a composer.lock
+type lockedPackage struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// packageDependency holds a package requirement parsed from a composer.json
+type packageDependency struct {
+	// Name is the package name, using the "vendor/package" format
+	Name string
+	// Constraint is the version constraint, such as "^6.4"
+	Constraint string
+	// Section is either "require" or "require-dev"
+	Section string
+	// LockedVersion is the version resolved in the composer.lock, if any
+	LockedVersion string
+}
+
+// operator returns the operator of a simple constraint, and whether the constraint is simple.
+func (p packageDependency) operator() (string, bool) {
+	matches := simpleConstraintRegex.FindStringSubmatch(p.Constraint)
+	if matches == nil {
+		return "", false
+	}
+
+	// "~6.4" allows any 6.x version while "~6.4.3" only allows 6.4.x ones,
+	// so bumping it would prevent minor updates.
+	if matches[1] == "~" && strings.Count(matches[2], ".") < 2 {
+		return "", false
+	}
+
+	return matches[1], true
+}
+
+// isDevConstraint reports whether the constraint requires a development branch, such as "dev-main" or "2.x-dev"
+func (p packageDependency) isDevConstraint() bool {
+	for _, c := range strings.FieldsFunc(p.Constraint, func(r rune) bool { return r == '|' || r == ',' || r == ' ' }) {
+		c, _, _ = strings.Cut(c, "#")
+		if strings.HasPrefix(c, "dev-") || strings.HasSuffix(c, "-dev") {
+			return true
+		}
+	}
+	return false
+}
+
+// isPinned reports whether the constraint requires an exact version such as "6.4.1"
+func (p packageDependency) isPinned() bool {
+	operator, ok := p.operator()
+	return ok && operator == ""
+}
+
+// currentVersion returns the most accurate version known for the package.
+func (p packageDependency) currentVersion() string {
+	if p.LockedVersion != "" {
+		return p.LockedVersion
+	}
+
+	return versionRegex.FindString(p.Constraint)
+}
+
+// isPlatformPackage reports whether a requirement targets the platform, such as "php",
+// "ext-json", or "composer-plugin-api", instead of a package.
+func isPlatformPackage(name string) bool {
+	return !strings.Contains(name, "/")
+}
+
+// searchComposerFiles walks rootDir recursively and returns every composer.json found.
+func searchComposerFiles(rootDir string) ([]string, error) {
+	var found []string
+
+	err := filepath.WalkDir(rootDir, func(path string, di fs.DirEntry, err error) error {
+		if err != nil {
+			logrus.Errorf("accessing path %q: %v", path, err)
+			return err
+		}
+
+		if di.IsDir() {
+			if skipDirs[di.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if di.Name() == composerJSON {
+			found = append(found, path)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Debugf("%d composer.json file(s) found", len(found))
+	for _, f := range found {
+		logrus.Debugf("    * %q", f)
+	}
+
+	return found, nil
+}
+
+// parseComposerJSON returns the packages required by a composer.json, sorted by section and name.
+// Platform requirements are skipped.
+func parseComposerJSON(filename string) ([]packageDependency, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest composerManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("parsing %q: %w", filename, err)
+	}
+
+	var dependencies []packageDependency
+
+	for _, section := range []struct {
+		name         string
+		requirements map[string]string
+	}{
+		{name: "require", requirements: manifest.Require},
+		{name: "require-dev", requirements: manifest.RequireDev},
+	} {
+		names := make([]string, 0, len(section.requirements))
+		for name := range section.requirements {
+			if isPlatformPackage(name) {
+				continue
+			}
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			dependencies = append(dependencies, packageDependency{
+				Name:       name,
+				Constraint: strings.TrimSpace(section.requirements[name]),
+				Section:    section.name,
+			})
+		}
+	}
+
+	return dependencies, nil
+}
+
+// parseComposerLock returns the package versions locked in a composer.lock, keyed by lowercase package name.
+func parseComposerLock(filename string) (map[string]string, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var lockfile composerLockfile
+	if err := json.Unmarshal(data, &lockfile); err != nil {
+		return nil, fmt.Errorf("parsing %q: %w", filename, err)
+	}
+
+	versions := map[string]string{}
+	for _, p := range append(lockfile.Packages, lockfile.PackagesDev...) {
+		versions[strings.ToLower(p.Name)] = strings.TrimPrefix(p.Version, "v")
+	}
+
+	return versions, nil
+}
+
+// isLockFileDetected reports whether the given lock file exists on disk.
+func isLockFileDetected(lockfile string) bool {
+	_, err := os.Stat(lockfile)
+	return err == nil
+}
+
+// isComposerAvailable reports whether the composer command is present on PATH.
+func isComposerAvailable() bool {
+	return exec.Command("composer", "--version").Run() == nil
+}
@@ -0,0 +1,70 @@
+package composer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageDependency(t *testing.T) {
+	tests := []struct {
+		constraint       string
+		lockedVersion    string
+		expectedOperator string
+		expectedSimple   bool
+		expectedPinned   bool
+		expectedDev      bool
+		expectedVersion  string
+	}{
+		{constraint: "^3.5", expectedOperator: "^", expectedSimple: true, expectedVersion: "3.5"},
+		{constraint: "^3.5", lockedVersion: "3.5.0", expectedOperator: "^", expectedSimple: true, expectedVersion: "3.5.0"},
+		{constraint: "~7.8.1", expectedOperator: "~", expectedSimple: true, expectedVersion: "7.8.1"},
+		{constraint: "~2.17", expectedVersion: "2.17"},
+		{constraint: ">=3.3", expectedOperator: ">=", expectedSimple: true, expectedVersion: "3.3"},
+		{constraint: "10.5.2", expectedSimple: true, expectedPinned: true, expectedVersion: "10.5.2"},
+		{constraint: "v1.2.0", expectedSimple: true, expectedPinned: true, expectedVersion: "1.2.0"},
+		{constraint: "^5.4 || ^6.0", expectedVersion: "5.4"},
+		{constraint: ">=1.0 <2.0", expectedVersion: "1.0"},
+		{constraint: "^2.0@beta", expectedVersion: "2.0"},
+		{constraint: "dev-main", expectedDev: true},
+		{constraint: "2.x-dev", expectedDev: true, expectedVersion: "2"},
+		{constraint: "^1.0 || 1.1.x-dev", expectedDev: true, expectedVersion: "1.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			p := packageDependency{Name: "acme/demo", Constraint: tt.constraint, LockedVersion: tt.lockedVersion}
+
+			operator, simple := p.operator()
+			assert.Equal(t, tt.expectedOperator, operator)
+			assert.Equal(t, tt.expectedSimple, simple)
+			assert.Equal(t, tt.expectedPinned, p.isPinned())
+			assert.Equal(t, tt.expectedDev, p.isDevConstraint())
+			assert.Equal(t, tt.expectedVersion, p.currentVersion())
+		})
+	}
+}
+
+func TestParseComposerJSON(t *testing.T) {
+	got, err := parseComposerJSON("testdata/simple/composer.json")
+	require.NoError(t, err)
+
+	assert.Equal(t, []packageDependency{
+		{Name: "acme/internal", Constraint: "dev-main", Section: "require"},
+		{Name: "monolog/monolog", Constraint: "^3.5", Section: "require"},
+		{Name: "symfony/console", Constraint: "^5.4 || ^6.0", Section: "require"},
+		{Name: "phpunit/phpunit", Constraint: "10.5.2", Section: "require-dev"},
+	}, got)
+}
+
+func TestParseComposerLock(t *testing.T) {
+	got, err := parseComposerLock("testdata/simple/composer.lock")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"monolog/monolog": "3.5.0",
+		"symfony/console": "6.4.1",
+		"phpunit/phpunit": "10.5.2",
+	}, got)
+}
@@ -0,0 +1,66 @@
+package gradle
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var (
+	// pluginRegex matches a plugin declared with a version in a build file plugins block, such as
+	//   id("org.springframework.boot") version "3.2.1"
+	//   id 'com.diffplug.spotless' version '6.25.0'
+	//   kotlin("jvm") version "1.9.22"
+	// Group 1: plugin id
+	// Group 2: Kotlin plugin name
+	// Group 3: version
+	pluginRegex = regexp.MustCompile(`^\s*(?:id\s*\(?\s*["']([A-Za-z0-9._-]+)["']\s*\)?|kotlin\s*\(\s*["']([A-Za-z0-9._-]+)["']\s*\))\s+version\s*\(?\s*["']([^"'$]+)["']`)
+)
+
+// kotlinPluginPrefix is the plugin id prefix implied by the kotlin("...") shortcut
+const kotlinPluginPrefix = "org.jetbrains.kotlin."
+
+// parseBuildFile returns the plugins declared with a version in a build file.
+// Plugins using a variable as version are skipped.
+func parseBuildFile(filename string) ([]dependency, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var dependencies []dependency
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		matches := pluginRegex.FindStringSubmatch(scanner.Text())
+		if matches == nil {
+			continue
+		}
+
+		var dep dependency
+		switch matches[1] {
+		case "":
+			dep = newPluginDependency(kotlinPluginPrefix+matches[2], matches[3])
+			dep.TargetMatchPattern = fmt.Sprintf(`(kotlin\s*\(\s*["']%s["']\s*\)\s+version\s*\(?\s*["'])[^"']+(["'])`, regexp.QuoteMeta(matches[2]))
+		default:
+			dep = newPluginDependency(matches[1], matches[3])
+			dep.TargetMatchPattern = fmt.Sprintf(`(id\s*\(?\s*["']%s["']\s*\)?\s+version\s*\(?\s*["'])[^"']+(["'])`, regexp.QuoteMeta(matches[1]))
+		}
+
+		dependencies = append(dependencies, dep)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %q: %w", filename, err)
+	}
+
+	return dependencies, nil
+}
+
+// yamlSingleQuoted escapes a string rendered in a single-quoted yaml string
+func yamlSingleQuoted(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
@@ -0,0 +1,212 @@
+package gradle
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// versionCatalog holds the content of a Gradle version catalog
+type versionCatalog struct {
+	Versions  map[string]any `toml:"versions"`
+	Libraries map[string]any `toml:"libraries"`
+	Plugins   map[string]any `toml:"plugins"`
+}
+
+// dependency holds a versioned Gradle dependency, either a library or a plugin
+type dependency struct {
+	// Description identifies the dependency in the generated manifest, such as `library "com.google.guava:guava"`
+	Description string
+	// GroupID is the Maven groupId used to retrieve versions
+	GroupID string
+	// ArtifactID is the Maven artifactId used to retrieve versions
+	ArtifactID string
+	// PluginID is the Gradle plugin id, if the dependency is a plugin
+	PluginID string
+	// Version is the version currently declared
+	Version string
+	// Plugin reports whether the versions are retrieved from the plugin repositories
+	Plugin bool
+	// TargetKey is the toml key holding the version in a version catalog
+	TargetKey string
+	// TargetPrefix is prepended to the version when the version catalog uses the string notation, such as "group:artifact:"
+	TargetPrefix string
+	// TargetMatchPattern is the regular expression matching the version in a build file
+	TargetMatchPattern string
+}
+
+// newPluginDependency returns a dependency retrieving versions using the plugin marker artifact
+func newPluginDependency(pluginID, version string) dependency {
+	return dependency{
+		Description: fmt.Sprintf("plugin %q", pluginID),
+		GroupID:     pluginID,
+		ArtifactID:  pluginID + ".gradle.plugin",
+		PluginID:    pluginID,
+		Version:     version,
+		Plugin:      true,
+	}
+}
+
+// newLibraryDependency returns a dependency from a "group:artifact" module notation
+func newLibraryDependency(module, version string) (dependency, bool) {
+	groupID, artifactID, found := strings.Cut(module, ":")
+	if !found || groupID == "" || artifactID == "" || strings.Contains(artifactID, ":") {
+		return dependency{}, false
+	}
+
+	return dependency{
+		Description: fmt.Sprintf("library %q", module),
+		GroupID:     groupID,
+		ArtifactID:  artifactID,
+		Version:     version,
+	}, true
+}
+
+// catalogKey returns the dasel query selecting a catalog entry
+func catalogKey(keys ...string) string {
+	query := make([]string, 0, len(keys))
+	for _, k := range keys {
+		query = append(query, fmt.Sprintf("get(%q)", k))
+	}
+	return strings.Join(query, ".")
+}
+
+// sortedKeys returns the keys of a catalog table in a deterministic order
+func sortedKeys(table map[string]any) []string {
+	keys := make([]string, 0, len(table))
+	for k := range table {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// parseCatalogVersion returns either the version, or the referenced version name,
+// declared by a catalog entry. Rich versions such as { strictly = "1.0" } are ignored.
+func parseCatalogVersion(entry map[string]any) (version, ref string) {
+	switch v := entry["version"].(type) {
+	case string:
+		return v, ""
+	case map[string]any:
+		if r, ok := v["ref"].(string); ok {
+			return "", r
+		}
+	}
+	return "", ""
+}
+
+// parseVersionCatalog returns the versioned dependencies declared in a version catalog.
+// Versions declared in the "versions" table come first, followed by libraries and plugins.
+func parseVersionCatalog(filename string) ([]dependency, error) {
+	var catalog versionCatalog
+	if _, err := toml.DecodeFile(filename, &catalog); err != nil {
+		return nil, fmt.Errorf("parsing %q: %w", filename, err)
+	}
+
+	var libraries, plugins []dependency
+	// references maps a version name to the first dependency referencing it
+	references := map[string]dependency{}
+
+	for _, alias := range sortedKeys(catalog.Libraries) {
+		switch entry := catalog.Libraries[alias].(type) {
+		case string:
+			// "group:artifact:version"
+			i := strings.LastIndex(entry, ":")
+			if i == -1 {
+				continue
+			}
+			dep, ok := newLibraryDependency(entry[:i], entry[i+1:])
+			if !ok || dep.Version == "" {
+				continue
+			}
+			dep.TargetKey = catalogKey("libraries", alias)
+			dep.TargetPrefix = entry[:i+1]
+			libraries = append(libraries, dep)
+
+		case map[string]any:
+			module, _ := entry["module"].(string)
+			if module == "" {
+				group, _ := entry["group"].(string)
+				name, _ := entry["name"].(string)
+				module = group + ":" + name
+			}
+
+			version, ref := parseCatalogVersion(entry)
+			dep, ok := newLibraryDependency(module, version)
+			if !ok {
+				continue
+			}
+
+			switch {
+			case ref != "":
+				if _, found := references[ref]; !found {
+					references[ref] = dep
+				}
+			case version != "":
+				dep.TargetKey = catalogKey("libraries", alias, "version")
+				libraries = append(libraries, dep)
+			}
+		}
+	}
+
+	for _, alias := range sortedKeys(catalog.Plugins) {
+		switch entry := catalog.Plugins[alias].(type) {
+		case string:
+			// "plugin.id:version"
+			id, version, found := strings.Cut(entry, ":")
+			if !found || id == "" || version == "" {
+				continue
+			}
+			dep := newPluginDependency(id, version)
+			dep.TargetKey = catalogKey("plugins", alias)
+			dep.TargetPrefix = id + ":"
+			plugins = append(plugins, dep)
+
+		case map[string]any:
+			id, _ := entry["id"].(string)
+			if id == "" {
+				continue
+			}
+
+			version, ref := parseCatalogVersion(entry)
+			dep := newPluginDependency(id, version)
+
+			switch {
+			case ref != "":
+				if _, found := references[ref]; !found {
+					references[ref] = dep
+				}
+			case version != "":
+				dep.TargetKey = catalogKey("plugins", alias, "version")
+				plugins = append(plugins, dep)
+			}
+		}
+	}
+
+	var dependencies []dependency
+
+	for _, name := range sortedKeys(catalog.Versions) {
+		version, ok := catalog.Versions[name].(string)
+		if !ok {
+			// Rich versions can't be bumped to a single version
+			continue
+		}
+
+		dep, found := references[name]
+		if !found {
+			continue
+		}
+
+		dep.Description = fmt.Sprintf("version %q", name)
+		dep.Version = version
+		dep.TargetKey = catalogKey("versions", name)
+		dependencies = append(dependencies, dep)
+	}
+
+	dependencies = append(dependencies, libraries...)
+	dependencies = append(dependencies, plugins...)
+
+	return dependencies, nil
+}
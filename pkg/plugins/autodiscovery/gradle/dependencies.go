@@ -0,0 +1,141 @@
+package gradle
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+)
+
+// searchFromDir returns the directory from where Gradle files are searched
+func (g Gradle) searchFromDir() string {
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if g.spec.RootDir != "" && !path.IsAbs(g.spec.RootDir) {
+		return filepath.Join(g.rootDir, g.spec.RootDir)
+	}
+	return g.rootDir
+}
+
+// discoverCatalogManifests generates manifests for dependencies declared in version catalogs
+func (g Gradle) discoverCatalogManifests(catalogs []string) [][]byte {
+	var manifests [][]byte
+
+	for _, catalog := range catalogs {
+		logrus.Debugf("parsing file %q", catalog)
+
+		dependencies, err := parseVersionCatalog(catalog)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		manifests = append(manifests, g.generateManifests(catalog, catalogProjectDir(catalog), dependencies)...)
+	}
+
+	return manifests
+}
+
+// discoverBuildFileManifests generates manifests for plugins declared in build files
+func (g Gradle) discoverBuildFileManifests(buildFiles []string) [][]byte {
+	var manifests [][]byte
+
+	for _, buildFile := range buildFiles {
+		logrus.Debugf("parsing file %q", buildFile)
+
+		dependencies, err := parseBuildFile(buildFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		manifests = append(manifests, g.generateManifests(buildFile, filepath.Dir(buildFile), dependencies)...)
+	}
+
+	return manifests
+}
+
+// generateManifests renders one manifest per dependency found in a file
+func (g Gradle) generateManifests(foundFile, projectDir string, dependencies []dependency) [][]byte {
+	var manifests [][]byte
+
+	relativeFoundFile, err := filepath.Rel(g.rootDir, foundFile)
+	if err != nil {
+		logrus.Debugln(err)
+		return nil
+	}
+
+	if len(dependencies) == 0 {
+		logrus.Debugf("no Gradle dependency found in %q", relativeFoundFile)
+		return nil
+	}
+
+	tmpl, err := template.New("manifest").Parse(manifestTemplate)
+	if err != nil {
+		logrus.Debugln(err)
+		return nil
+	}
+
+	repositories := getRepositories(projectDir, g.rootDir)
+
+	for _, dep := range dependencies {
+		if len(g.spec.Ignore) > 0 && g.spec.Ignore.isMatchingRules(g.rootDir, relativeFoundFile, dep) {
+			logrus.Debugf("ignoring Gradle %s from %q, as matching ignore rule(s)", dep.Description, relativeFoundFile)
+			continue
+		}
+
+		if len(g.spec.Only) > 0 && !g.spec.Only.isMatchingRules(g.rootDir, relativeFoundFile, dep) {
+			logrus.Debugf("ignoring Gradle %s from %q, as not matching only rule(s)", dep.Description, relativeFoundFile)
+			continue
+		}
+
+		sourceVersionFilterKind := g.versionFilter.Kind
+		sourceVersionFilterPattern := g.versionFilter.Pattern
+		sourceVersionFilterRegex := g.versionFilter.Regex
+		if !g.spec.VersionFilter.IsZero() {
+			sourceVersionFilterPattern, err = g.versionFilter.GreaterThanPattern(dep.Version)
+			if err != nil {
+				logrus.Debugf("building version filter pattern: %s", err)
+				sourceVersionFilterPattern = "*"
+			}
+		}
+
+		sourceRepositories := repositories.libraries
+		if dep.Plugin {
+			sourceRepositories = repositories.plugins
+		}
+
+		params := manifestTemplateParams{
+			ManifestName:               fmt.Sprintf("deps(gradle): bump %s", dep.Description),
+			ActionID:                   g.actionID,
+			SourceID:                   "maven",
+			SourceName:                 fmt.Sprintf("Get latest Maven artifact version \"%s:%s\"", dep.GroupID, dep.ArtifactID),
+			SourceGroupID:              dep.GroupID,
+			SourceArtifactID:           dep.ArtifactID,
+			SourceRepositories:         sourceRepositories,
+			SourceVersionFilterKind:    sourceVersionFilterKind,
+			SourceVersionFilterPattern: sourceVersionFilterPattern,
+			SourceVersionFilterRegex:   sourceVersionFilterRegex,
+			TargetID:                   "gradle",
+			TargetName:                 fmt.Sprintf("deps(gradle): update %s to {{ source \"maven\" }}", dep.Description),
+			TargetKey:                  dep.TargetKey,
+			TargetPrefix:               dep.TargetPrefix,
+			TargetMatchPattern:         yamlSingleQuoted(dep.TargetMatchPattern),
+			File:                       relativeFoundFile,
+			ScmID:                      g.scmID,
+		}
+
+		manifest := bytes.Buffer{}
+		if err := tmpl.Execute(&manifest, params); err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		manifests = append(manifests, manifest.Bytes())
+	}
+
+	return manifests
+}
@@ -0,0 +1,140 @@
+// Package gradle implements the autodiscovery crawler for Gradle projects.
+//
+// It walks a root directory looking for version catalogs, such as gradle/libs.versions.toml,
+// and for build.gradle or build.gradle.kts files.
+//
+//   - Catalog versions, libraries, and plugins declaring a version are bumped using the toml resource.
+//     A version declared in the "versions" table is resolved using the first library, or plugin,
+//     referencing it.
+//   - Plugins declared with a version in a build file "plugins" block, such as
+//     `id("org.springframework.boot") version "3.2.1"`, are bumped using the file resource.
+//
+// Versions are retrieved using the maven resource. Libraries are looked up in the repositories
+// declared in the settings.gradle "dependencyResolutionManagement" block, and plugins in the
+// repositories declared in its "pluginManagement" block, defaulting to the Gradle plugin portal.
+package gradle
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines the parameters which can be provided to the Gradle crawler.
+type Spec struct {
+	// RootDir defines the root directory used to recursively search for Gradle version catalogs and build files
+	RootDir string `yaml:",omitempty"`
+	// Ignore allows to specify rule to ignore autodiscovery a specific Gradle dependency based on a rule
+	Ignore MatchingRules `yaml:",omitempty"`
+	// Only allows to specify rule to only autodiscover manifest for a specific Gradle dependency based on a rule
+	Only MatchingRules `yaml:",omitempty"`
+	//  `versionfilter` provides parameters to specify the version pattern used when generating manifest.
+	//
+	//  kind - semver
+	//    versionfilter of kind `semver` uses semantic versioning as version filtering
+	//    pattern accepts one of:
+	//      `prerelease` - Updatecli tries to identify the latest prerelease whatever it means
+	//      `patch` - Updatecli only handles patch version update
+	//      `minor` - Updatecli handles patch AND minor version update
+	//      `minoronly` - Updatecli handles minor version only
+	//      `major` - Updatecli handles patch, minor, AND major version update
+	//      `majoronly` - Updatecli only handles major version update
+	//      `a version constraint` such as `>= 1.0.0`
+	//
+	//  kind - regex
+	//    versionfilter of kind `regex` uses regular expression as version filtering
+	//    pattern accepts a valid regular expression
+	//
+	//  example:
+	//  ```
+	//    versionfilter:
+	//      kind: semver
+	//      pattern: minor
+	//  ```
+	//
+	//  More examples can be found at https://www.updatecli.io/docs/core/versionfilter/
+	VersionFilter version.Filter `yaml:",omitempty"`
+}
+
+// Gradle holds all information needed to generate Gradle manifests.
+type Gradle struct {
+	// actionID holds the actionID used by the newly generated manifest
+	actionID string
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// rootDir defines the root directory from where looking for Gradle files
+	rootDir string
+	// scmID holds the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+}
+
+// New return a new valid object.
+func New(spec interface{}, rootDir, scmID, actionID string) (Gradle, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Gradle{}, err
+	}
+
+	if err := s.Ignore.Validate(); err != nil {
+		return Gradle{}, fmt.Errorf("invalid ignore spec: %w", err)
+	}
+
+	if err := s.Only.Validate(); err != nil {
+		return Gradle{}, fmt.Errorf("invalid only spec: %w", err)
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return Gradle{}, fmt.Errorf("no working directory defined")
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		// Like for Maven, many artifacts don't follow semantic versioning,
+		// such as "33.0.0-jre", so we rely on the repository metadata.
+		newFilter.Kind = "latest"
+		newFilter.Pattern = "latest"
+	}
+
+	return Gradle{
+		actionID:      actionID,
+		spec:          s,
+		rootDir:       dir,
+		scmID:         scmID,
+		versionFilter: newFilter,
+	}, nil
+}
+
+// DiscoverManifests returns updatecli manifests for all Gradle dependencies found under rootDir.
+func (g Gradle) DiscoverManifests() ([][]byte, error) {
+	logrus.Infof("\n\n%s\n", strings.ToTitle("Gradle"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("Gradle")+1))
+
+	catalogs, buildFiles, err := searchGradleFiles(g.searchFromDir())
+	if err != nil {
+		return nil, err
+	}
+
+	manifests := g.discoverCatalogManifests(catalogs)
+	manifests = append(manifests, g.discoverBuildFileManifests(buildFiles)...)
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
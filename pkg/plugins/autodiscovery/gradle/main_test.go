@@ -0,0 +1,248 @@
+package gradle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	testdata := []struct {
+		name              string
+		rootDir           string
+		scmID             string
+		actionID          string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "version catalog with version reference",
+			rootDir: "testdata/catalog",
+			scmID:   "default",
+			spec: Spec{
+				Only: MatchingRules{
+					{
+						Path:        "gradle/libs.versions.toml",
+						ArtifactIDs: map[string]string{"kotlin-stdlib": "", "commons-lang3": ""},
+					},
+				},
+			},
+			expectedPipelines: []string{
+				`name: 'deps(gradle): bump version "kotlin"'
+sources:
+  maven:
+    name: 'Get latest Maven artifact version "org.jetbrains.kotlin:kotlin-stdlib"'
+    kind: 'maven'
+    spec:
+      groupid: 'org.jetbrains.kotlin'
+      artifactid: 'kotlin-stdlib'
+      repositories:
+        - 'https://repo1.maven.org/maven2/'
+        - 'https://repo.example.com/releases'
+      versionfilter:
+        kind: 'latest'
+        pattern: 'latest'
+targets:
+  gradle:
+    name: 'deps(gradle): update version "kotlin" to {{ source "maven" }}'
+    scmid: 'default'
+    kind: 'toml'
+    spec:
+      file: 'gradle/libs.versions.toml'
+      key: 'get("versions").get("kotlin")'
+      engine: 'dasel'
+    sourceid: 'maven'
+`,
+				`name: 'deps(gradle): bump library "org.apache.commons:commons-lang3"'
+sources:
+  maven:
+    name: 'Get latest Maven artifact version "org.apache.commons:commons-lang3"'
+    kind: 'maven'
+    spec:
+      groupid: 'org.apache.commons'
+      artifactid: 'commons-lang3'
+      repositories:
+        - 'https://repo1.maven.org/maven2/'
+        - 'https://repo.example.com/releases'
+      versionfilter:
+        kind: 'latest'
+        pattern: 'latest'
+targets:
+  gradle:
+    name: 'deps(gradle): update library "org.apache.commons:commons-lang3" to {{ source "maven" }}'
+    scmid: 'default'
+    kind: 'toml'
+    spec:
+      file: 'gradle/libs.versions.toml'
+      key: 'get("libraries").get("commons-lang3")'
+      engine: 'dasel'
+    sourceid: 'maven'
+    transformers:
+      - addprefix: 'org.apache.commons:commons-lang3:'
+`,
+			},
+		},
+		{
+			name:     "version catalog plugin with version filter",
+			rootDir:  "testdata/catalog",
+			actionID: "default",
+			spec: Spec{
+				Only: MatchingRules{
+					{Plugins: map[string]string{"com.diffplug.spotless": ">=6"}},
+				},
+				Ignore: MatchingRules{
+					{Path: "app/*"},
+				},
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "minor",
+				},
+			},
+			expectedPipelines: []string{
+				`name: 'deps(gradle): bump plugin "com.diffplug.spotless"'
+actions:
+  default:
+    title: 'deps(gradle): update plugin "com.diffplug.spotless" to {{ source "maven" }}'
+
+sources:
+  maven:
+    name: 'Get latest Maven artifact version "com.diffplug.spotless:com.diffplug.spotless.gradle.plugin"'
+    kind: 'maven'
+    spec:
+      groupid: 'com.diffplug.spotless'
+      artifactid: 'com.diffplug.spotless.gradle.plugin'
+      repositories:
+        - 'https://plugins.gradle.org/m2/'
+        - 'https://plugins.example.com/m2'
+      versionfilter:
+        kind: 'semver'
+        pattern: '6.x'
+targets:
+  gradle:
+    name: 'deps(gradle): update plugin "com.diffplug.spotless" to {{ source "maven" }}'
+    kind: 'toml'
+    spec:
+      file: 'gradle/libs.versions.toml'
+      key: 'get("plugins").get("spotless").get("version")'
+      engine: 'dasel'
+    sourceid: 'maven'
+`,
+			},
+		},
+		{
+			name:    "build file plugins",
+			rootDir: "testdata/catalog",
+			spec: Spec{
+				Only: MatchingRules{
+					{Path: "app/build.gradle.kts"},
+				},
+			},
+			expectedPipelines: []string{
+				`name: 'deps(gradle): bump plugin "org.springframework.boot"'
+sources:
+  maven:
+    name: 'Get latest Maven artifact version "org.springframework.boot:org.springframework.boot.gradle.plugin"'
+    kind: 'maven'
+    spec:
+      groupid: 'org.springframework.boot'
+      artifactid: 'org.springframework.boot.gradle.plugin'
+      repositories:
+        - 'https://plugins.gradle.org/m2/'
+        - 'https://plugins.example.com/m2'
+      versionfilter:
+        kind: 'latest'
+        pattern: 'latest'
+targets:
+  gradle:
+    name: 'deps(gradle): update plugin "org.springframework.boot" to {{ source "maven" }}'
+    kind: 'file'
+    spec:
+      file: 'app/build.gradle.kts'
+      matchpattern: '(id\s*\(?\s*["'']org\.springframework\.boot["'']\s*\)?\s+version\s*\(?\s*["''])[^"'']+(["''])'
+      replacepattern: '${1}{{ source "maven" }}${2}'
+    sourceid: 'maven'
+`,
+				`name: 'deps(gradle): bump plugin "org.jetbrains.kotlin.plugin.spring"'
+sources:
+  maven:
+    name: 'Get latest Maven artifact version "org.jetbrains.kotlin.plugin.spring:org.jetbrains.kotlin.plugin.spring.gradle.plugin"'
+    kind: 'maven'
+    spec:
+      groupid: 'org.jetbrains.kotlin.plugin.spring'
+      artifactid: 'org.jetbrains.kotlin.plugin.spring.gradle.plugin'
+      repositories:
+        - 'https://plugins.gradle.org/m2/'
+        - 'https://plugins.example.com/m2'
+      versionfilter:
+        kind: 'latest'
+        pattern: 'latest'
+targets:
+  gradle:
+    name: 'deps(gradle): update plugin "org.jetbrains.kotlin.plugin.spring" to {{ source "maven" }}'
+    kind: 'file'
+    spec:
+      file: 'app/build.gradle.kts'
+      matchpattern: '(kotlin\s*\(\s*["'']plugin\.spring["'']\s*\)\s+version\s*\(?\s*["''])[^"'']+(["''])'
+      replacepattern: '${1}{{ source "maven" }}${2}'
+    sourceid: 'maven'
+`,
+			},
+		},
+		{
+			name:    "groovy build file without settings",
+			rootDir: "testdata/groovy",
+			scmID:   "default",
+			expectedPipelines: []string{
+				`name: 'deps(gradle): bump plugin "com.diffplug.spotless"'
+sources:
+  maven:
+    name: 'Get latest Maven artifact version "com.diffplug.spotless:com.diffplug.spotless.gradle.plugin"'
+    kind: 'maven'
+    spec:
+      groupid: 'com.diffplug.spotless'
+      artifactid: 'com.diffplug.spotless.gradle.plugin'
+      repositories:
+        - 'https://plugins.gradle.org/m2/'
+      versionfilter:
+        kind: 'latest'
+        pattern: 'latest'
+targets:
+  gradle:
+    name: 'deps(gradle): update plugin "com.diffplug.spotless" to {{ source "maven" }}'
+    scmid: 'default'
+    kind: 'file'
+    spec:
+      file: 'build.gradle'
+      matchpattern: '(id\s*\(?\s*["'']com\.diffplug\.spotless["'']\s*\)?\s+version\s*\(?\s*["''])[^"'']+(["''])'
+      replacepattern: '${1}{{ source "maven" }}${2}'
+    sourceid: 'maven'
+`,
+			},
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := New(tt.spec, tt.rootDir, tt.scmID, tt.actionID)
+			require.NoError(t, err)
+
+			manifests, err := g.DiscoverManifests()
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.expectedPipelines), len(manifests))
+			for i := range manifests {
+				assert.Equal(t, tt.expectedPipelines[i], string(manifests[i]))
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Spec{Only: MatchingRules{{}}}, "testdata/catalog", "", "")
+	assert.ErrorContains(t, err, "invalid only spec")
+
+	_, err = New(Spec{}, "", "", "")
+	assert.ErrorContains(t, err, "no working directory defined")
+}
@@ -0,0 +1,75 @@
+package gradle
+
+// manifestTemplate is the Go template used to generate updatecli manifests
+// for Gradle dependencies discovered in version catalogs and build files.
+var manifestTemplate = `name: '{{ .ManifestName }}'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+    title: '{{ .TargetName }}'
+{{ end }}
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'maven'
+    spec:
+      groupid: '{{ .SourceGroupID }}'
+      artifactid: '{{ .SourceArtifactID }}'
+{{- if .SourceRepositories }}
+      repositories:
+{{- range $repo := .SourceRepositories }}
+        - '{{ $repo }}'
+{{- end }}
+{{- end }}
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+{{- if or (eq .SourceVersionFilterKind "regex/semver") (eq .SourceVersionFilterKind "regex/time") }}
+        regex: '{{ .SourceVersionFilterRegex }}'
+{{- end }}
+targets:
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+{{- if .TargetKey }}
+    kind: 'toml'
+    spec:
+      file: '{{ .File }}'
+      key: '{{ .TargetKey }}'
+      engine: 'dasel'
+{{- else }}
+    kind: 'file'
+    spec:
+      file: '{{ .File }}'
+      matchpattern: '{{ .TargetMatchPattern }}'
+      replacepattern: '${1}{{ "{{" }} source "{{ .SourceID }}" {{ "}}" }}${2}'
+{{- end }}
+    sourceid: '{{ .SourceID }}'
+{{- if .TargetPrefix }}
+    transformers:
+      - addprefix: '{{ .TargetPrefix }}'
+{{- end }}
+`
+
+// manifestTemplateParams holds the values injected into manifestTemplate.
+type manifestTemplateParams struct {
+	ManifestName               string
+	ActionID                   string
+	SourceID                   string
+	SourceName                 string
+	SourceGroupID              string
+	SourceArtifactID           string
+	SourceRepositories         []string
+	SourceVersionFilterKind    string
+	SourceVersionFilterPattern string
+	SourceVersionFilterRegex   string
+	TargetID                   string
+	TargetName                 string
+	TargetKey                  string
+	TargetPrefix               string
+	TargetMatchPattern         string
+	File                       string
+	ScmID                      string
+}
@@ -0,0 +1,116 @@
+package gradle
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// MatchingRule allows to specifies rules to identify manifest
+type MatchingRule struct {
+	// Path specifies a version catalog or build file path pattern, the pattern requires to match all of name, not just a substring.
+	Path string `yaml:",omitempty"`
+	// GroupIDs specifies the list of Maven GroupIDs to check
+	GroupIDs []string `yaml:",omitempty"`
+	// ArtifactIDs specifies the list of Maven ArtifactIDs to check, keyed by artifactId.
+	// The value is a semantic versioning constraint such as ">=1.0" or empty to match any version.
+	ArtifactIDs map[string]string `yaml:",omitempty"`
+	// Plugins specifies the list of Gradle plugins to check, keyed by plugin id.
+	// The value is a semantic versioning constraint such as ">=1.0" or empty to match any version.
+	Plugins map[string]string `yaml:",omitempty"`
+}
+
+// MatchingRules is a slice of MatchingRule.
+type MatchingRules []MatchingRule
+
+// Validate checks that each matching rule has at least one non-empty field.
+func (m MatchingRules) Validate() error {
+	for i, rule := range m {
+		if rule.Path == "" && len(rule.GroupIDs) == 0 && len(rule.ArtifactIDs) == 0 && len(rule.Plugins) == 0 {
+			return fmt.Errorf("rule %d has no valid fields (path, groupids, artifactids, or plugins must be specified)", i+1)
+		}
+	}
+	return nil
+}
+
+// isMatchingRules reports whether the given file/dependency pair matches any rule in the list.
+// Multiple conditions within one rule are AND-ed; multiple rules are OR-ed.
+func (m MatchingRules) isMatchingRules(rootDir, filePath string, dep dependency) bool {
+	for _, rule := range m {
+		var ruleResults []bool
+
+		if rule.Path != "" {
+			fp := filePath
+			if filepath.IsAbs(rule.Path) {
+				fp = filepath.Join(rootDir, filePath)
+			}
+
+			match, err := filepath.Match(rule.Path, fp)
+			if err != nil {
+				logrus.Errorf("%s - %q", err, rule.Path)
+				continue
+			}
+			ruleResults = append(ruleResults, match)
+			if match {
+				logrus.Debugf("file path %q matching rule %q", fp, rule.Path)
+			}
+		}
+
+		if len(rule.GroupIDs) > 0 {
+			ruleResults = append(ruleResults, slices.Contains(rule.GroupIDs, dep.GroupID))
+		}
+
+		if len(rule.ArtifactIDs) > 0 {
+			match := false
+			if ruleVersion, found := rule.ArtifactIDs[dep.ArtifactID]; found {
+				match = isVersionMatching(dep.Version, ruleVersion)
+			}
+			ruleResults = append(ruleResults, match)
+		}
+
+		if len(rule.Plugins) > 0 {
+			match := false
+			if ruleVersion, found := rule.Plugins[dep.PluginID]; found && dep.PluginID != "" {
+				match = isVersionMatching(dep.Version, ruleVersion)
+			}
+			ruleResults = append(ruleResults, match)
+		}
+
+		isAllMatching := true
+		for _, r := range ruleResults {
+			if !r {
+				isAllMatching = false
+				break
+			}
+		}
+		if isAllMatching && len(ruleResults) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isVersionMatching checks a dependency version against a matching rule constraint.
+func isVersionMatching(dependencyVersion, ruleConstraint string) bool {
+	if ruleConstraint == "" {
+		return true
+	}
+
+	v, err := semver.NewVersion(dependencyVersion)
+	if err != nil {
+		logrus.Debugf("%q - %s", dependencyVersion, err)
+		return dependencyVersion == ruleConstraint
+	}
+
+	c, err := semver.NewConstraint(ruleConstraint)
+	if err != nil {
+		logrus.Debugf("%q %s", err, ruleConstraint)
+		return dependencyVersion == ruleConstraint
+	}
+
+	return c.Check(v)
+}
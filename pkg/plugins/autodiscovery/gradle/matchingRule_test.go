@@ -0,0 +1,89 @@
+package gradle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchingRules(t *testing.T) {
+	commons := dependency{GroupID: "org.apache.commons", ArtifactID: "commons-lang3", Version: "3.14.0"}
+	spotless := newPluginDependency("com.diffplug.spotless", "6.25.0")
+
+	tests := []struct {
+		name       string
+		rules      MatchingRules
+		filePath   string
+		dependency dependency
+		expected   bool
+	}{
+		{
+			name:       "matching path",
+			rules:      MatchingRules{{Path: "gradle/*.toml"}},
+			filePath:   "gradle/libs.versions.toml",
+			dependency: commons,
+			expected:   true,
+		},
+		{
+			name:       "not matching path",
+			rules:      MatchingRules{{Path: "app/*"}},
+			filePath:   "gradle/libs.versions.toml",
+			dependency: commons,
+		},
+		{
+			name:       "matching groupid",
+			rules:      MatchingRules{{GroupIDs: []string{"org.apache.commons"}}},
+			filePath:   "gradle/libs.versions.toml",
+			dependency: commons,
+			expected:   true,
+		},
+		{
+			name:       "matching artifactid version constraint",
+			rules:      MatchingRules{{ArtifactIDs: map[string]string{"commons-lang3": ">=3"}}},
+			filePath:   "gradle/libs.versions.toml",
+			dependency: commons,
+			expected:   true,
+		},
+		{
+			name:       "not matching artifactid version constraint",
+			rules:      MatchingRules{{ArtifactIDs: map[string]string{"commons-lang3": "<3"}}},
+			filePath:   "gradle/libs.versions.toml",
+			dependency: commons,
+		},
+		{
+			name:       "matching plugin",
+			rules:      MatchingRules{{Plugins: map[string]string{"com.diffplug.spotless": ""}}},
+			filePath:   "build.gradle",
+			dependency: spotless,
+			expected:   true,
+		},
+		{
+			name:       "plugin rule doesn't match libraries",
+			rules:      MatchingRules{{Plugins: map[string]string{"commons-lang3": ""}}},
+			filePath:   "gradle/libs.versions.toml",
+			dependency: commons,
+		},
+		{
+			name: "any rule can match",
+			rules: MatchingRules{
+				{Path: "app/*"},
+				{GroupIDs: []string{"com.diffplug.spotless"}},
+			},
+			filePath:   "build.gradle",
+			dependency: spotless,
+			expected:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rules.isMatchingRules("", tt.filePath, tt.dependency)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestMatchingRulesValidate(t *testing.T) {
+	assert.NoError(t, MatchingRules{{Plugins: map[string]string{"com.diffplug.spotless": ""}}}.Validate())
+	assert.Error(t, MatchingRules{{}}.Validate())
+}
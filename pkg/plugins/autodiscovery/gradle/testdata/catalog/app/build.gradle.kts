@@ -0,0 +1,11 @@
+plugins {
+    alias(libs.plugins.kotlin.jvm)
+    id("org.springframework.boot") version "3.2.1"
+    kotlin("plugin.spring") version "1.9.22"
+    id("io.spring.dependency-management") version "$dependencyManagementVersion"
+    application
+}
+
+dependencies {
+    implementation(libs.guava)
+}
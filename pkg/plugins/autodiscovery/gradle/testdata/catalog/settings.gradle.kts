@@ -0,0 +1,19 @@
+pluginManagement {
+    repositories {
+        gradlePluginPortal()
+        maven("https://plugins.example.com/m2")
+    }
+}
+
+dependencyResolutionManagement {
+    repositories {
+        // maven("https://commented.example.com/m2")
+        mavenCentral()
+        maven {
+            url = uri("https://repo.example.com/releases")
+        }
+    }
+}
+
+rootProject.name = "catalog"
+include("app")
@@ -0,0 +1,203 @@
+package gradle
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// mavenCentralRepository is the repository declared with mavenCentral()
+	mavenCentralRepository string = "https://repo1.maven.org/maven2/"
+	// googleRepository is the repository declared with google()
+	googleRepository string = "https://maven.google.com/"
+	// gradlePluginPortalRepository is the repository declared with gradlePluginPortal()
+	gradlePluginPortalRepository string = "https://plugins.gradle.org/m2/"
+)
+
+var (
+	// catalogSuffix is the suffix used by Gradle version catalogs, such as "libs.versions.toml"
+	catalogSuffix = ".versions.toml"
+
+	// buildFiles lists the file names of Gradle build scripts
+	buildFiles = []string{"build.gradle", "build.gradle.kts"}
+
+	// settingsFiles lists the file names of Gradle settings scripts, by order of precedence
+	settingsFiles = []string{"settings.gradle.kts", "settings.gradle"}
+
+	// skipDirs lists directories that should never be walked for Gradle files.
+	skipDirs = map[string]bool{
+		".git":         true,
+		".gradle":      true,
+		"build":        true,
+		"node_modules": true,
+	}
+
+	// lineCommentRegex matches single line comments
+	lineCommentRegex = regexp.MustCompile(`(?m)^\s*//.*$`)
+
+	// repositoryRegex matches a repository declaration such as
+	//   mavenCentral()
+	//   maven("https://repo.example.com/releases")
+	//   maven { url = uri("https://repo.example.com/releases") }
+	//   maven { url 'https://repo.example.com/releases' }
+	// Group 1: well known repository function
+	// Group 2: url from the maven function
+	// Group 3: url from the maven block
+	repositoryRegex = regexp.MustCompile(`\b(mavenCentral|google|gradlePluginPortal)\s*\(\s*\)|\bmaven\s*\(\s*(?:url\s*=\s*)?(?:uri\s*\(\s*)?["']([^"']+)["']|\bmaven\s*\{[^}]*?\burl\s*(?:=\s*)?(?:uri\s*\(\s*)?["']([^"']+)["']`)
+)
+
+// searchGradleFiles walks rootDir recursively and returns every version catalog and build file found.
+func searchGradleFiles(rootDir string) (catalogs, builds []string, err error) {
+	err = filepath.WalkDir(rootDir, func(path string, di fs.DirEntry, err error) error {
+		if err != nil {
+			logrus.Errorf("accessing path %q: %v", path, err)
+			return err
+		}
+
+		if di.IsDir() {
+			if skipDirs[di.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		switch {
+		case strings.HasSuffix(di.Name(), catalogSuffix):
+			catalogs = append(catalogs, path)
+		case slices.Contains(buildFiles, di.Name()):
+			builds = append(builds, path)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logrus.Debugf("%d Gradle version catalog(s) and %d build file(s) found", len(catalogs), len(builds))
+	for _, f := range append(catalogs, builds...) {
+		logrus.Debugf("    * %q", f)
+	}
+
+	return catalogs, builds, nil
+}
+
+// catalogProjectDir returns the Gradle project directory owning a version catalog.
+// Catalogs are conventionally stored in the "gradle" directory of the project.
+func catalogProjectDir(catalog string) string {
+	dir := filepath.Dir(catalog)
+	if filepath.Base(dir) == "gradle" {
+		return filepath.Dir(dir)
+	}
+	return dir
+}
+
+// findSettingsFile looks for a settings.gradle(.kts) file from dir up to rootDir.
+func findSettingsFile(dir, rootDir string) string {
+	for {
+		for _, name := range settingsFiles {
+			settingsFile := filepath.Join(dir, name)
+			if _, err := os.Stat(settingsFile); err == nil {
+				return settingsFile
+			}
+		}
+
+		rel, err := filepath.Rel(rootDir, dir)
+		if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+			return ""
+		}
+
+		dir = filepath.Dir(dir)
+	}
+}
+
+// repositories holds the repositories declared in a settings.gradle(.kts) file
+type repositories struct {
+	// libraries lists the repositories declared in the "dependencyResolutionManagement" block
+	libraries []string
+	// plugins lists the repositories declared in the "pluginManagement" block
+	plugins []string
+}
+
+// getRepositories returns the repositories used to resolve libraries and plugins
+// for a project directory. Plugins default to the Gradle plugin portal.
+func getRepositories(dir, rootDir string) repositories {
+	result := repositories{}
+
+	settingsFile := findSettingsFile(dir, rootDir)
+	if settingsFile != "" {
+		data, err := os.ReadFile(settingsFile)
+		if err != nil {
+			logrus.Debugln(err)
+		} else {
+			content := lineCommentRegex.ReplaceAllString(string(data), "")
+
+			result.libraries = parseRepositories(extractBlock(extractBlock(content, "dependencyResolutionManagement"), "repositories"))
+			result.plugins = parseRepositories(extractBlock(extractBlock(content, "pluginManagement"), "repositories"))
+		}
+	}
+
+	if len(result.plugins) == 0 {
+		result.plugins = []string{gradlePluginPortalRepository}
+	}
+
+	return result
+}
+
+// parseRepositories returns the repository urls declared in a "repositories" block
+func parseRepositories(content string) []string {
+	var urls []string
+
+	for _, matches := range repositoryRegex.FindAllStringSubmatch(content, -1) {
+		var url string
+		switch {
+		case matches[1] == "mavenCentral":
+			url = mavenCentralRepository
+		case matches[1] == "google":
+			url = googleRepository
+		case matches[1] == "gradlePluginPortal":
+			url = gradlePluginPortalRepository
+		case matches[2] != "":
+			url = matches[2]
+		default:
+			url = matches[3]
+		}
+
+		if !slices.Contains(urls, url) {
+			urls = append(urls, url)
+		}
+	}
+
+	return urls
+}
+
+// extractBlock returns the content of the first block named "name", such as
+// "pluginManagement { ... }", or an empty string if the block doesn't exist.
+func extractBlock(content, name string) string {
+	loc := regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\s*\{`).FindStringIndex(content)
+	if loc == nil {
+		return ""
+	}
+
+	depth := 1
+	for i := loc[1]; i < len(content); i++ {
+		switch content[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return content[loc[1]:i]
+			}
+		}
+	}
+
+	return content[loc[1]:]
+}
@@ -0,0 +1,100 @@
+package gradle
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRepositories(t *testing.T) {
+	content := `
+pluginManagement {
+    repositories {
+        gradlePluginPortal()
+        google()
+    }
+}
+dependencyResolutionManagement {
+    repositoriesMode.set(RepositoriesMode.FAIL_ON_PROJECT_REPOS)
+    repositories {
+        mavenCentral()
+        maven("https://kotlin.example.com/m2")
+        maven(url = "https://named.example.com/m2")
+        maven { url 'https://groovy.example.com/m2' }
+        maven {
+            name = "internal"
+            url = uri("https://internal.example.com/m2")
+            credentials(PasswordCredentials::class)
+        }
+        mavenCentral()
+    }
+}`
+
+	assert.Equal(t, []string{
+		gradlePluginPortalRepository,
+		googleRepository,
+	}, parseRepositories(extractBlock(extractBlock(content, "pluginManagement"), "repositories")))
+
+	assert.Equal(t, []string{
+		mavenCentralRepository,
+		"https://kotlin.example.com/m2",
+		"https://named.example.com/m2",
+		"https://groovy.example.com/m2",
+		"https://internal.example.com/m2",
+	}, parseRepositories(extractBlock(extractBlock(content, "dependencyResolutionManagement"), "repositories")))
+
+	assert.Empty(t, extractBlock(content, "buildCache"))
+}
+
+func TestGetRepositories(t *testing.T) {
+	got := getRepositories(filepath.Join("testdata", "catalog", "app"), filepath.Join("testdata", "catalog"))
+	assert.Equal(t, []string{mavenCentralRepository, "https://repo.example.com/releases"}, got.libraries)
+	assert.Equal(t, []string{gradlePluginPortalRepository, "https://plugins.example.com/m2"}, got.plugins)
+
+	got = getRepositories(filepath.Join("testdata", "groovy"), filepath.Join("testdata", "groovy"))
+	assert.Empty(t, got.libraries)
+	assert.Equal(t, []string{gradlePluginPortalRepository}, got.plugins)
+}
+
+func TestCatalogProjectDir(t *testing.T) {
+	assert.Equal(t, "project", catalogProjectDir("project/gradle/libs.versions.toml"))
+	assert.Equal(t, "project", catalogProjectDir("project/deps.versions.toml"))
+}
+
+func TestParseVersionCatalog(t *testing.T) {
+	got, err := parseVersionCatalog("testdata/catalog/gradle/libs.versions.toml")
+	require.NoError(t, err)
+
+	var keys []string
+	for _, dep := range got {
+		keys = append(keys, dep.TargetKey)
+	}
+
+	// Rich versions, unused versions, and libraries without version are ignored
+	assert.Equal(t, []string{
+		`get("versions").get("kotlin")`,
+		`get("libraries").get("commons-lang3")`,
+		`get("libraries").get("guava").get("version")`,
+		`get("plugins").get("spotless").get("version")`,
+		`get("plugins").get("versions")`,
+	}, keys)
+
+	// The kotlin version is resolved using the first library referencing it
+	assert.Equal(t, "org.jetbrains.kotlin", got[0].GroupID)
+	assert.Equal(t, "kotlin-stdlib", got[0].ArtifactID)
+	assert.Equal(t, "1.9.22", got[0].Version)
+	assert.False(t, got[0].Plugin)
+}
+
+func TestParseBuildFile(t *testing.T) {
+	got, err := parseBuildFile("testdata/catalog/app/build.gradle.kts")
+	require.NoError(t, err)
+
+	require.Len(t, got, 2)
+	assert.Equal(t, "org.springframework.boot", got[0].PluginID)
+	assert.Equal(t, "3.2.1", got[0].Version)
+	assert.Equal(t, "org.jetbrains.kotlin.plugin.spring", got[1].PluginID)
+	assert.Equal(t, "1.9.22", got[1].Version)
+}
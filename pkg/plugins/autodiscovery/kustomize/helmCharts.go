@@ -0,0 +1,105 @@
+package kustomize
+
+import (
+	"bytes"
+	"net/url"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// generateHelmChartManifest generates an Updatecli manifest for a kustomization "helmCharts" entry
+func (k Kustomize) generateHelmChartManifest(relativeFile string, index int, chart kustomizeHelmChart) ([]byte, error) {
+	// Without a version, kustomize uses the latest chart and there is nothing to pin
+	if chart.Name == "" || chart.Repo == "" || chart.Version == "" {
+		logrus.Debugf("Ignoring Helm chart %q from %q, as name, repo, or version is missing", chart.Name, relativeFile)
+		return nil, nil
+	}
+
+	if _, err := semver.NewVersion(chart.Version); err != nil {
+		logrus.Debugf("Ignoring Helm chart %q from %q, as %q is not a valid semver version", chart.Name, relativeFile, chart.Version)
+		return nil, nil
+	}
+
+	dep := dependency{
+		kind:       dependencyKindHelmChart,
+		name:       chart.Name,
+		version:    chart.Version,
+		repository: chart.Repo,
+	}
+
+	if len(k.spec.Ignore) > 0 {
+		if k.spec.Ignore.isMatchingRules(k.rootDir, relativeFile, dep) {
+			logrus.Debugf("Ignoring Helm chart %q from %q, as matching ignore rule(s)\n", chart.Name, relativeFile)
+			return nil, nil
+		}
+	}
+
+	if len(k.spec.Only) > 0 {
+		if !k.spec.Only.isMatchingRules(k.rootDir, relativeFile, dep) {
+			logrus.Debugf("Ignoring Helm chart %q from %q, as not matching only rule(s)\n", chart.Name, relativeFile)
+			return nil, nil
+		}
+	}
+
+	token := ""
+	repoURL, err := url.Parse(chart.Repo)
+	switch err {
+	case nil:
+		if auth, ok := k.spec.Auths[repoURL.Host]; ok {
+			token = auth.Token
+			logrus.Debugf("found token for repository %q", repoURL.Host)
+		}
+	default:
+		logrus.Debugf("Ignoring auth configuration due to invalid Helm repository URL: %s", err)
+	}
+
+	versionFilterKind := k.versionFilter.Kind
+	versionFilterPattern := k.versionFilter.Pattern
+	versionFilterRegex := k.versionFilter.Regex
+
+	if !k.spec.VersionFilter.IsZero() {
+		versionFilterPattern, err = k.versionFilter.GreaterThanPattern(chart.Version)
+		if err != nil {
+			logrus.Debugf("building version filter pattern: %s", err)
+			versionFilterPattern = chart.Version
+		}
+	}
+
+	tmpl, err := template.New("manifest").Parse(helmChartManifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	params := struct {
+		ActionID             string
+		ChartName            string
+		ChartRepository      string
+		ScmID                string
+		TargetFile           string
+		TargetIndex          int
+		Token                string
+		VersionFilterKind    string
+		VersionFilterPattern string
+		VersionFilterRegex   string
+	}{
+		ActionID:             k.actionID,
+		ChartName:            chart.Name,
+		ChartRepository:      chart.Repo,
+		ScmID:                k.scmID,
+		TargetFile:           relativeFile,
+		TargetIndex:          index,
+		Token:                token,
+		VersionFilterKind:    versionFilterKind,
+		VersionFilterPattern: versionFilterPattern,
+		VersionFilterRegex:   versionFilterRegex,
+	}
+
+	manifest := bytes.Buffer{}
+	if err := tmpl.Execute(&manifest, params); err != nil {
+		return nil, err
+	}
+
+	return manifest.Bytes(), nil
+}
@@ -0,0 +1,137 @@
+package kustomize
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/dockerimage"
+)
+
+// generateImageManifest generates an Updatecli manifest for a kustomization "images" entry
+func (k Kustomize) generateImageManifest(relativeFile string, index int, image kustomizeImage) ([]byte, error) {
+	imageName := image.NewName
+	if imageName == "" {
+		imageName = image.Name
+	}
+
+	if imageName == "" {
+		return nil, fmt.Errorf("image entry %d has no name", index)
+	}
+
+	// An entry without newTag only renames the image or pins a digest on an unknown tag,
+	// in both cases we have no reference to compare against.
+	if image.NewTag == "" {
+		logrus.Debugf("Ignoring image %q from %q, as it does not define a newTag", imageName, relativeFile)
+		return nil, nil
+	}
+
+	dep := dependency{
+		kind:    dependencyKindImage,
+		name:    imageName,
+		version: image.NewTag,
+	}
+
+	if len(k.spec.Ignore) > 0 {
+		if k.spec.Ignore.isMatchingRules(k.rootDir, relativeFile, dep) {
+			logrus.Debugf("Ignoring image %q from %q, as matching ignore rule(s)\n", imageName, relativeFile)
+			return nil, nil
+		}
+	}
+
+	if len(k.spec.Only) > 0 {
+		if !k.spec.Only.isMatchingRules(k.rootDir, relativeFile, dep) {
+			logrus.Debugf("Ignoring image %q from %q, as not matching only rule(s)\n", imageName, relativeFile)
+			return nil, nil
+		}
+	}
+
+	// Kustomize gives precedence to the digest over the tag, so an existing digest
+	// must be updated alongside the tag to keep both consistent.
+	digest := image.Digest != ""
+
+	sourceSpec := dockerimage.NewDockerImageSpecFromImage(imageName, image.NewTag, k.spec.Auths)
+	if sourceSpec == nil && !digest {
+		logrus.Debugf("Ignoring image %q from %q, as no version could be identified from tag %q", imageName, relativeFile, image.NewTag)
+		return nil, nil
+	}
+
+	versionFilterKind := k.versionFilter.Kind
+	versionFilterPattern := k.versionFilter.Pattern
+	versionFilterRegex := k.versionFilter.Regex
+	tagFilter := "*"
+
+	registryUsername := ""
+	registryPassword := ""
+	registryToken := ""
+
+	if sourceSpec != nil {
+		versionFilterKind = sourceSpec.VersionFilter.Kind
+		versionFilterPattern = sourceSpec.VersionFilter.Pattern
+		versionFilterRegex = sourceSpec.VersionFilter.Regex
+		tagFilter = sourceSpec.TagFilter
+
+		registryUsername = sourceSpec.Username
+		registryPassword = sourceSpec.Password
+		registryToken = sourceSpec.Token
+	}
+
+	var err error
+	// If a versionfilter is specified in the manifest then we want to be sure that it takes precedence
+	if !k.spec.VersionFilter.IsZero() {
+		versionFilterKind = k.versionFilter.Kind
+		versionFilterRegex = k.versionFilter.Regex
+		versionFilterPattern, err = k.versionFilter.GreaterThanPattern(image.NewTag)
+		if err != nil {
+			logrus.Debugf("building version filter pattern: %s", err)
+			versionFilterPattern = "*"
+		}
+	}
+
+	tmpl, err := template.New("manifest").Parse(imageManifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	params := struct {
+		ActionID             string
+		Digest               bool
+		ImageName            string
+		ImageTag             string
+		RegistryUsername     string
+		RegistryPassword     string
+		RegistryToken        string
+		ScmID                string
+		SourceTagFilter      string
+		TargetFile           string
+		TargetIndex          int
+		UpdateTag            bool
+		VersionFilterKind    string
+		VersionFilterPattern string
+		VersionFilterRegex   string
+	}{
+		ActionID:             k.actionID,
+		Digest:               digest,
+		ImageName:            imageName,
+		ImageTag:             image.NewTag,
+		RegistryUsername:     registryUsername,
+		RegistryPassword:     registryPassword,
+		RegistryToken:        registryToken,
+		ScmID:                k.scmID,
+		SourceTagFilter:      tagFilter,
+		TargetFile:           relativeFile,
+		TargetIndex:          index,
+		UpdateTag:            sourceSpec != nil,
+		VersionFilterKind:    versionFilterKind,
+		VersionFilterPattern: versionFilterPattern,
+		VersionFilterRegex:   versionFilterRegex,
+	}
+
+	manifest := bytes.Buffer{}
+	if err := tmpl.Execute(&manifest, params); err != nil {
+		return nil, err
+	}
+
+	return manifest.Bytes(), nil
+}
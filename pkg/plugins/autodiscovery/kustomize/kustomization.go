@@ -0,0 +1,152 @@
+package kustomize
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+	goyaml "go.yaml.in/yaml/v4"
+)
+
+var (
+	// kustomizationFiles specifies the file names recognized by kustomize
+	kustomizationFiles []string = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+)
+
+// kustomization holds the subset of a kustomization file that can be automatically updated
+type kustomization struct {
+	Resources  []string             `yaml:"resources"`
+	Components []string             `yaml:"components"`
+	Bases      []string             `yaml:"bases"`
+	Images     []kustomizeImage     `yaml:"images"`
+	HelmCharts []kustomizeHelmChart `yaml:"helmCharts"`
+}
+
+// kustomizeImage is an entry of the kustomization "images" field
+type kustomizeImage struct {
+	Name    string `yaml:"name"`
+	NewName string `yaml:"newName"`
+	NewTag  string `yaml:"newTag"`
+	Digest  string `yaml:"digest"`
+}
+
+// kustomizeHelmChart is an entry of the kustomization "helmCharts" field
+type kustomizeHelmChart struct {
+	Name    string `yaml:"name"`
+	Repo    string `yaml:"repo"`
+	Version string `yaml:"version"`
+}
+
+// searchKustomizationFiles looks, recursively, for every kustomization file from a root directory.
+func searchKustomizationFiles(rootDir string) ([]string, error) {
+	foundFiles := []string{}
+
+	logrus.Debugf("Looking for kustomization file(s) in %q", rootDir)
+
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("accessing path %q: %w", path, err)
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		for _, name := range kustomizationFiles {
+			if d.Name() == name {
+				foundFiles = append(foundFiles, path)
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Debugf("%d kustomization file(s) found", len(foundFiles))
+	for _, foundFile := range foundFiles {
+		logrus.Debugf("    * %q", foundFile)
+	}
+
+	return foundFiles, nil
+}
+
+// loadKustomization reads and parses a kustomization file
+func loadKustomization(filename string) (*kustomization, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var k kustomization
+	if err := goyaml.Unmarshal(data, &k); err != nil {
+		return nil, fmt.Errorf("parsing %q: %w", filename, err)
+	}
+
+	return &k, nil
+}
+
+// discoverKustomizationManifests generates the Updatecli manifests for a single kustomization file
+func (k Kustomize) discoverKustomizationManifests(filename string) ([][]byte, error) {
+	relativeFile, err := filepath.Rel(k.rootDir, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := loadKustomization(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests [][]byte
+
+	for i, image := range data.Images {
+		manifest, err := k.generateImageManifest(relativeFile, i, image)
+		if err != nil {
+			logrus.Debugf("image %q from %q: %s", image.Name, relativeFile, err)
+			continue
+		}
+		if manifest != nil {
+			manifests = append(manifests, manifest)
+		}
+	}
+
+	for i, chart := range data.HelmCharts {
+		manifest, err := k.generateHelmChartManifest(relativeFile, i, chart)
+		if err != nil {
+			logrus.Debugf("helm chart %q from %q: %s", chart.Name, relativeFile, err)
+			continue
+		}
+		if manifest != nil {
+			manifests = append(manifests, manifest)
+		}
+	}
+
+	remoteResources := []struct {
+		field   string
+		entries []string
+	}{
+		{field: "resources", entries: data.Resources},
+		{field: "components", entries: data.Components},
+		{field: "bases", entries: data.Bases},
+	}
+
+	for _, r := range remoteResources {
+		for i, entry := range r.entries {
+			manifest, err := k.generateRemoteResourceManifest(relativeFile, r.field, i, entry)
+			if err != nil {
+				logrus.Debugf("remote resource %q from %q: %s", entry, relativeFile, err)
+				continue
+			}
+			if manifest != nil {
+				manifests = append(manifests, manifest)
+			}
+		}
+	}
+
+	return manifests, nil
+}
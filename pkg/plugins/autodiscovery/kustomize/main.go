@@ -0,0 +1,166 @@
+package kustomize
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/docker"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines the parameters which can be provided to the Kustomize crawler.
+type Spec struct {
+	// auths provides a map of registry credentials where the key is the registry URL without scheme.
+	// The same credentials are used for container registries and Helm chart repositories.
+	// if empty, updatecli relies on OCI credentials such as the one used by Docker.
+	//
+	// example:
+	//
+	// ```
+	// auths:
+	//   "ghcr.io":
+	//     token: "xxx"
+	//   "index.docker.io":
+	//     username: "admin"
+	//     password: "password"
+	// ```
+	//
+	Auths map[string]docker.InlineKeyChain `yaml:",omitempty"`
+	// rootDir defines the root directory used to recursively search for kustomization files
+	//
+	// default: . (current working directory) or scm root directory
+	RootDir string `yaml:",omitempty"`
+	// ignore allows to specify rule to ignore autodiscovery a specific kustomization entry based on a rule
+	//
+	// default: empty
+	Ignore MatchingRules `yaml:",omitempty"`
+	// only allows to specify rule to only autodiscover manifest for a specific kustomization entry based on a rule
+	//
+	// default: empty
+	Only MatchingRules `yaml:",omitempty"`
+	//  `versionfilter` provides parameters to specify the version pattern used when generating manifest.
+	//
+	//  kind - semver
+	//    versionfilter of kind `semver` uses semantic versioning as version filtering
+	//    pattern accepts one of:
+	//      `prerelease` - Updatecli tries to identify the latest prerelease whatever it means
+	//      `patch` - Updatecli only handles patch version update
+	//      `minor` - Updatecli handles patch AND minor version update
+	//      `minoronly` - Updatecli handles minor version only
+	//      `major` - Updatecli handles patch, minor, AND major version update
+	//      `majoronly` - Updatecli only handles major version update
+	//      `a version constraint` such as `>= 1.0.0`
+	//
+	//  kind - regex
+	//    versionfilter of kind `regex` uses regular expression as version filtering
+	//    pattern accepts a valid regular expression
+	//
+	//  example:
+	//  ```
+	//    versionfilter:
+	//      kind: semver
+	//      pattern: minor
+	//  ```
+	//
+	//  and its type like regex, semver, or just latest.
+	//
+	//  More examples can be found at https://www.updatecli.io/docs/core/versionfilter/
+	VersionFilter version.Filter `yaml:",omitempty"`
+}
+
+// Kustomize holds all information needed to generate Kustomize manifests.
+type Kustomize struct {
+	// actionID holds the value of the actionID parameter
+	actionID string
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// rootDir defines the root directory from where looking for kustomization files
+	rootDir string
+	// scmID hold the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+}
+
+// New return a new valid Kustomize object.
+func New(spec interface{}, rootDir, scmID, actionID string) (Kustomize, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Kustomize{}, err
+	}
+
+	// Validate ignore rules
+	if err := s.Ignore.Validate(); err != nil {
+		return Kustomize{}, fmt.Errorf("invalid ignore spec: %w", err)
+	}
+
+	// Validate only rules
+	if err := s.Only.Validate(); err != nil {
+		return Kustomize{}, fmt.Errorf("invalid only spec: %w", err)
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	// If no RootDir have been provided via settings,
+	// then fallback to the current process path.
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return Kustomize{}, fmt.Errorf("no working directory defined")
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		newFilter.Kind = "semver"
+		newFilter.Pattern = "*"
+	}
+
+	return Kustomize{
+		actionID:      actionID,
+		spec:          s,
+		rootDir:       dir,
+		scmID:         scmID,
+		versionFilter: newFilter,
+	}, nil
+}
+
+// DiscoverManifests returns the Updatecli manifests generated from every kustomization file found.
+func (k Kustomize) DiscoverManifests() ([][]byte, error) {
+	logrus.Infof("\n\n%s\n", strings.ToTitle("Kustomize"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("Kustomize")+1))
+
+	searchFromDir := k.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if k.spec.RootDir != "" && !path.IsAbs(k.spec.RootDir) {
+		searchFromDir = filepath.Join(k.rootDir, k.spec.RootDir)
+	}
+
+	foundFiles, err := searchKustomizationFiles(searchFromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests [][]byte
+	for _, foundFile := range foundFiles {
+		m, err := k.discoverKustomizationManifests(foundFile)
+		if err != nil {
+			logrus.Debugf("skipping kustomization file %q: %s", foundFile, err)
+			continue
+		}
+		manifests = append(manifests, m...)
+	}
+
+	return manifests, nil
+}
@@ -0,0 +1,206 @@
+package kustomize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	testdata := []struct {
+		name              string
+		rootDir           string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "no kustomization file",
+			rootDir: "testdata/empty",
+		},
+		{
+			name:    "images, helm charts, and remote resources",
+			rootDir: "testdata/simple",
+			expectedPipelines: []string{
+				`name: 'deps(kustomize): bump container image "nginx"'
+sources:
+  image:
+    name: 'get latest container image tag for "nginx"'
+    kind: 'dockerimage'
+    spec:
+      image: 'nginx'
+      tagfilter: '^\d*(\.\d*){2}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=1.25.3'
+targets:
+  image:
+    name: 'deps(kustomize): bump container image "nginx" to {{ source "image" }}'
+    kind: 'yaml'
+    spec:
+      file: 'overlays/prod/kustomization.yaml'
+      key: '$.images[0].newTag'
+    sourceid: 'image'
+`,
+				`name: 'deps(kustomize): bump container image "ghcr.io/updatecli/updatecli"'
+sources:
+  image:
+    name: 'get latest container image tag for "ghcr.io/updatecli/updatecli"'
+    kind: 'dockerimage'
+    spec:
+      image: 'ghcr.io/updatecli/updatecli'
+      tagfilter: '^v\d*(\.\d*){2}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=v0.67.0'
+  image-digest:
+    name: 'get latest container image digest for "ghcr.io/updatecli/updatecli"'
+    kind: 'dockerdigest'
+    spec:
+      image: 'ghcr.io/updatecli/updatecli'
+      tag: '{{ source "image" }}'
+      hidetag: true
+    transformers:
+      - trimprefix: '@'
+    dependson:
+      - 'image'
+targets:
+  image:
+    name: 'deps(kustomize): bump container image "ghcr.io/updatecli/updatecli" to {{ source "image" }}'
+    kind: 'yaml'
+    spec:
+      file: 'overlays/prod/kustomization.yaml'
+      key: '$.images[1].newTag'
+    sourceid: 'image'
+  image-digest:
+    name: 'deps(kustomize): bump container image digest for "ghcr.io/updatecli/updatecli"'
+    kind: 'yaml'
+    spec:
+      file: 'overlays/prod/kustomization.yaml'
+      key: '$.images[1].digest'
+    sourceid: 'image-digest'
+`,
+				`name: 'deps(kustomize): bump Helm chart "cert-manager"'
+sources:
+  helmchart:
+    name: 'get latest "cert-manager" Helm chart version'
+    kind: 'helmchart'
+    spec:
+      name: 'cert-manager'
+      url: 'https://charts.jetstack.io'
+      versionfilter:
+        kind: 'semver'
+        pattern: '*'
+targets:
+  helmchart:
+    name: 'deps(kustomize): bump Helm chart "cert-manager" to {{ source "helmchart" }}'
+    kind: 'yaml'
+    spec:
+      file: 'overlays/prod/kustomization.yaml'
+      key: '$.helmCharts[0].version'
+    sourceid: 'helmchart'
+`,
+				`name: 'deps(kustomize): bump remote resource "https://github.com/argoproj/argo-cd"'
+sources:
+  gittag:
+    name: 'get latest git tag for "https://github.com/argoproj/argo-cd"'
+    kind: 'gittag'
+    spec:
+      url: 'https://github.com/argoproj/argo-cd'
+      lsremote: true
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=2.9.3'
+targets:
+  gittag:
+    name: 'deps(kustomize): bump remote resource "https://github.com/argoproj/argo-cd" to {{ source "gittag" }}'
+    kind: 'yaml'
+    spec:
+      file: 'overlays/prod/kustomization.yaml'
+      key: '$.resources[1]'
+    sourceid: 'gittag'
+    transformers:
+      - addprefix: 'https://github.com/argoproj/argo-cd//manifests/cluster-install?ref='
+`,
+				`name: 'deps(kustomize): bump remote resource "git@github.com:example/components.git"'
+sources:
+  gittag:
+    name: 'get latest git tag for "git@github.com:example/components.git"'
+    kind: 'gittag'
+    spec:
+      url: 'git@github.com:example/components.git'
+      lsremote: true
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=1.2.0'
+targets:
+  gittag:
+    name: 'deps(kustomize): bump remote resource "git@github.com:example/components.git" to {{ source "gittag" }}'
+    kind: 'yaml'
+    spec:
+      file: 'overlays/prod/kustomization.yaml'
+      key: '$.components[0]'
+    sourceid: 'gittag'
+    transformers:
+      - addprefix: 'git@github.com:example/components.git//monitoring?ref='
+      - addsuffix: '&timeout=90s'
+`,
+			},
+		},
+		{
+			name:    "only helm charts",
+			rootDir: "testdata/simple",
+			spec: Spec{
+				Only: MatchingRules{
+					MatchingRule{
+						HelmCharts: map[string]string{
+							"cert-manager": "",
+						},
+					},
+				},
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "minor",
+				},
+			},
+			expectedPipelines: []string{
+				`name: 'deps(kustomize): bump Helm chart "cert-manager"'
+sources:
+  helmchart:
+    name: 'get latest "cert-manager" Helm chart version'
+    kind: 'helmchart'
+    spec:
+      name: 'cert-manager'
+      url: 'https://charts.jetstack.io'
+      versionfilter:
+        kind: 'semver'
+        pattern: '1.x'
+targets:
+  helmchart:
+    name: 'deps(kustomize): bump Helm chart "cert-manager" to {{ source "helmchart" }}'
+    kind: 'yaml'
+    spec:
+      file: 'overlays/prod/kustomization.yaml'
+      key: '$.helmCharts[0].version'
+    sourceid: 'helmchart'
+`,
+			},
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := New(tt.spec, tt.rootDir, "", "")
+			require.NoError(t, err)
+
+			pipelines, err := k.DiscoverManifests()
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.expectedPipelines), len(pipelines))
+			for i := range pipelines {
+				assert.Equal(t, tt.expectedPipelines[i], string(pipelines[i]))
+			}
+		})
+	}
+}
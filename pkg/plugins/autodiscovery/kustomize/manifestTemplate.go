@@ -0,0 +1,167 @@
+package kustomize
+
+const (
+	// imageManifestTemplate is the Go template used to update a kustomization image entry
+	imageManifestTemplate string = `name: 'deps(kustomize): bump container image "{{ .ImageName }}"'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+{{- if .UpdateTag }}
+    title: 'deps: bump container image "{{ .ImageName }}" to {{ "{{" }} source "image" {{ "}}" }}'
+{{- else }}
+    title: 'deps: bump container image digest for "{{ .ImageName }}:{{ .ImageTag }}"'
+{{- end }}
+{{ end }}
+sources:
+{{- if .UpdateTag }}
+  image:
+    name: 'get latest container image tag for "{{ .ImageName }}"'
+    kind: 'dockerimage'
+    spec:
+      image: '{{ .ImageName }}'
+      tagfilter: '{{ .SourceTagFilter }}'
+      {{- if .RegistryUsername }}
+      username: '{{ .RegistryUsername }}'
+      {{- end }}
+      {{- if .RegistryPassword }}
+      password: '{{ .RegistryPassword }}'
+      {{- end }}
+      {{- if .RegistryToken }}
+      token: '{{ .RegistryToken }}'
+      {{- end }}
+      versionfilter:
+        kind: '{{ .VersionFilterKind }}'
+        pattern: '{{ .VersionFilterPattern }}'
+{{- if or (eq .VersionFilterKind "regex/semver") (eq .VersionFilterKind "regex/time") }}
+        regex: '{{ .VersionFilterRegex }}'
+{{- end }}
+{{- end }}
+{{- if .Digest }}
+  image-digest:
+    name: 'get latest container image digest for "{{ .ImageName }}"'
+    kind: 'dockerdigest'
+    spec:
+      image: '{{ .ImageName }}'
+{{- if .UpdateTag }}
+      tag: '{{ "{{" }} source "image" {{ "}}" }}'
+{{- else }}
+      tag: '{{ .ImageTag }}'
+{{- end }}
+      hidetag: true
+      {{- if .RegistryUsername }}
+      username: '{{ .RegistryUsername }}'
+      {{- end }}
+      {{- if .RegistryPassword }}
+      password: '{{ .RegistryPassword }}'
+      {{- end }}
+      {{- if .RegistryToken }}
+      token: '{{ .RegistryToken }}'
+      {{- end }}
+    transformers:
+      - trimprefix: '@'
+{{- if .UpdateTag }}
+    dependson:
+      - 'image'
+{{- end }}
+{{- end }}
+targets:
+{{- if .UpdateTag }}
+  image:
+    name: 'deps(kustomize): bump container image "{{ .ImageName }}" to {{ "{{" }} source "image" {{ "}}" }}'
+    kind: 'yaml'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      file: '{{ .TargetFile }}'
+      key: '$.images[{{ .TargetIndex }}].newTag'
+    sourceid: 'image'
+{{- end }}
+{{- if .Digest }}
+  image-digest:
+    name: 'deps(kustomize): bump container image digest for "{{ .ImageName }}"'
+    kind: 'yaml'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      file: '{{ .TargetFile }}'
+      key: '$.images[{{ .TargetIndex }}].digest'
+    sourceid: 'image-digest'
+{{- end }}
+`
+
+	// helmChartManifestTemplate is the Go template used to update a kustomization helmCharts entry
+	helmChartManifestTemplate string = `name: 'deps(kustomize): bump Helm chart "{{ .ChartName }}"'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+    title: 'deps: bump Helm chart "{{ .ChartName }}" to {{ "{{" }} source "helmchart" {{ "}}" }}'
+{{ end }}
+sources:
+  helmchart:
+    name: 'get latest "{{ .ChartName }}" Helm chart version'
+    kind: 'helmchart'
+    spec:
+      name: '{{ .ChartName }}'
+      url: '{{ .ChartRepository }}'
+      {{- if .Token }}
+      token: '{{ .Token }}'
+      {{- end }}
+      versionfilter:
+        kind: '{{ .VersionFilterKind }}'
+        pattern: '{{ .VersionFilterPattern }}'
+{{- if or (eq .VersionFilterKind "regex/semver") (eq .VersionFilterKind "regex/time") }}
+        regex: '{{ .VersionFilterRegex }}'
+{{- end }}
+targets:
+  helmchart:
+    name: 'deps(kustomize): bump Helm chart "{{ .ChartName }}" to {{ "{{" }} source "helmchart" {{ "}}" }}'
+    kind: 'yaml'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      file: '{{ .TargetFile }}'
+      key: '$.helmCharts[{{ .TargetIndex }}].version'
+    sourceid: 'helmchart'
+`
+
+	// remoteResourceManifestTemplate is the Go template used to update the "ref" of a remote kustomization resource
+	remoteResourceManifestTemplate string = `name: 'deps(kustomize): bump remote resource "{{ .Repository }}"'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+    title: 'deps: bump remote resource "{{ .Repository }}" to {{ "{{" }} source "gittag" {{ "}}" }}'
+{{ end }}
+sources:
+  gittag:
+    name: 'get latest git tag for "{{ .Repository }}"'
+    kind: 'gittag'
+    spec:
+      url: '{{ .Repository }}'
+      lsremote: true
+      versionfilter:
+        kind: '{{ .VersionFilterKind }}'
+        pattern: '{{ .VersionFilterPattern }}'
+{{- if or (eq .VersionFilterKind "regex/semver") (eq .VersionFilterKind "regex/time") }}
+        regex: '{{ .VersionFilterRegex }}'
+{{- end }}
+targets:
+  gittag:
+    name: 'deps(kustomize): bump remote resource "{{ .Repository }}" to {{ "{{" }} source "gittag" {{ "}}" }}'
+    kind: 'yaml'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      file: '{{ .TargetFile }}'
+      key: '$.{{ .TargetField }}[{{ .TargetIndex }}]'
+    sourceid: 'gittag'
+    transformers:
+      - addprefix: '{{ .TargetPrefix }}'
+{{- if .TargetSuffix }}
+      - addsuffix: '{{ .TargetSuffix }}'
+{{- end }}
+`
+)
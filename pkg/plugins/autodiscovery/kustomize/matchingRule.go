@@ -0,0 +1,143 @@
+package kustomize
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	dependencyKindImage     string = "image"
+	dependencyKindHelmChart string = "helmchart"
+	dependencyKindRemote    string = "remote"
+)
+
+// dependency describes a kustomization entry evaluated against matching rules
+type dependency struct {
+	// kind is one of image, helmchart, or remote
+	kind string
+	// name is the container image name or the Helm chart name
+	name string
+	// version is the image tag, the Helm chart version, or the git reference
+	version string
+	// repository is the Helm chart repository or the remote resource git repository
+	repository string
+}
+
+// MatchingRule allows to specifies rules to identify manifest
+type MatchingRule struct {
+	// Path specifies a kustomization filepath pattern, the pattern requires to match all of name, not just a subpart of the path.
+	Path string
+	// Images specifies the list of container images to check, as defined by the kustomization "newName" or "name" field
+	Images []string
+	// HelmCharts specifies the list of Helm charts to check
+	//
+	// The key is the chart name and the value is a semver constraint on the chart version.
+	// If the value is empty, then the chart name is enough to match.
+	HelmCharts map[string]string
+	// Repositories specifies the list of Helm chart repositories or remote resource git repositories to check
+	Repositories []string
+}
+
+type MatchingRules []MatchingRule
+
+// Validate checks that each matching rule has at least one non-empty field.
+// Returns an error if any rule has no valid fields specified.
+func (m MatchingRules) Validate() error {
+	for i, rule := range m {
+		if rule.Path == "" && len(rule.Images) == 0 && len(rule.HelmCharts) == 0 && len(rule.Repositories) == 0 {
+			return fmt.Errorf("rule %d has no valid fields (path, images, helmcharts, or repositories must be specified)", i+1)
+		}
+	}
+	return nil
+}
+
+// isMatchingRules checks if a specific kustomization entry matches at least one rule
+func (m MatchingRules) isMatchingRules(rootDir, filePath string, dep dependency) bool {
+	for _, rule := range m {
+		var ruleResults []bool
+
+		if rule.Path != "" {
+			p := filePath
+			if filepath.IsAbs(rule.Path) {
+				p = filepath.Join(rootDir, filePath)
+			}
+
+			match, err := filepath.Match(rule.Path, p)
+			if err != nil {
+				logrus.Errorf("%s - %q", err, rule.Path)
+				continue
+			}
+			ruleResults = append(ruleResults, match)
+		}
+
+		if len(rule.Images) > 0 {
+			match := false
+			if dep.kind == dependencyKindImage {
+				for _, image := range rule.Images {
+					if image == dep.name {
+						match = true
+						break
+					}
+				}
+			}
+			ruleResults = append(ruleResults, match)
+		}
+
+		if len(rule.HelmCharts) > 0 {
+			match := false
+			if dep.kind == dependencyKindHelmChart {
+				if constraint, found := rule.HelmCharts[dep.name]; found {
+					match = isVersionMatching(constraint, dep.version)
+				}
+			}
+			ruleResults = append(ruleResults, match)
+		}
+
+		if len(rule.Repositories) > 0 {
+			match := false
+			for _, repository := range rule.Repositories {
+				if dep.repository != "" && repository == dep.repository {
+					match = true
+					break
+				}
+			}
+			ruleResults = append(ruleResults, match)
+		}
+
+		isAllMatching := true
+		for _, result := range ruleResults {
+			if !result {
+				isAllMatching = false
+				break
+			}
+		}
+
+		if isAllMatching {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isVersionMatching checks if a version satisfies a semver constraint, falling back to a strict comparison
+func isVersionMatching(constraint, version string) bool {
+	if constraint == "" {
+		return true
+	}
+
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return constraint == version
+	}
+
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return constraint == version
+	}
+
+	return c.Check(v)
+}
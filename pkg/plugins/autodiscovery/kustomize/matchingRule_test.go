@@ -0,0 +1,89 @@
+package kustomize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchingRules(t *testing.T) {
+	testdata := []struct {
+		name     string
+		rules    MatchingRules
+		filePath string
+		dep      dependency
+		expected bool
+	}{
+		{
+			name:     "matching path",
+			rules:    MatchingRules{{Path: "overlays/*/kustomization.yaml"}},
+			filePath: "overlays/prod/kustomization.yaml",
+			dep:      dependency{kind: dependencyKindImage, name: "nginx", version: "1.25.3"},
+			expected: true,
+		},
+		{
+			name:     "matching image",
+			rules:    MatchingRules{{Images: []string{"nginx"}}},
+			filePath: "kustomization.yaml",
+			dep:      dependency{kind: dependencyKindImage, name: "nginx", version: "1.25.3"},
+			expected: true,
+		},
+		{
+			name:     "image rule does not match helm chart",
+			rules:    MatchingRules{{Images: []string{"cert-manager"}}},
+			filePath: "kustomization.yaml",
+			dep:      dependency{kind: dependencyKindHelmChart, name: "cert-manager", version: "v1.13.2"},
+			expected: false,
+		},
+		{
+			name:     "matching helm chart version constraint",
+			rules:    MatchingRules{{HelmCharts: map[string]string{"cert-manager": "~1.13"}}},
+			filePath: "kustomization.yaml",
+			dep:      dependency{kind: dependencyKindHelmChart, name: "cert-manager", version: "v1.13.2"},
+			expected: true,
+		},
+		{
+			name:     "not matching helm chart version constraint",
+			rules:    MatchingRules{{HelmCharts: map[string]string{"cert-manager": ">=2"}}},
+			filePath: "kustomization.yaml",
+			dep:      dependency{kind: dependencyKindHelmChart, name: "cert-manager", version: "v1.13.2"},
+			expected: false,
+		},
+		{
+			name: "matching repository and path in the same rule",
+			rules: MatchingRules{{
+				Path:         "overlays/prod/kustomization.yaml",
+				Repositories: []string{"https://github.com/argoproj/argo-cd"},
+			}},
+			filePath: "overlays/prod/kustomization.yaml",
+			dep: dependency{
+				kind:       dependencyKindRemote,
+				name:       "https://github.com/argoproj/argo-cd",
+				version:    "v2.9.3",
+				repository: "https://github.com/argoproj/argo-cd",
+			},
+			expected: true,
+		},
+		{
+			name: "second rule matching",
+			rules: MatchingRules{
+				{Path: "base/kustomization.yaml"},
+				{Images: []string{"nginx"}},
+			},
+			filePath: "overlays/prod/kustomization.yaml",
+			dep:      dependency{kind: dependencyKindImage, name: "nginx", version: "1.25.3"},
+			expected: true,
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.rules.isMatchingRules("/tmp", tt.filePath, tt.dep))
+		})
+	}
+}
+
+func TestMatchingRulesValidate(t *testing.T) {
+	assert.NoError(t, MatchingRules{{Images: []string{"nginx"}}}.Validate())
+	assert.Error(t, MatchingRules{{}}.Validate())
+}
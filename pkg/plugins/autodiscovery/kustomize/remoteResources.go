@@ -0,0 +1,208 @@
+package kustomize
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// knownGitHosts lists the hosts for which kustomize accepts remote resources without
+// a "//" or ".git" separator between the repository and the directory
+var knownGitHosts = []string{"github.com", "gitlab.com", "bitbucket.org"}
+
+// remoteResource describes a kustomization remote resource pinned with a "ref" query parameter
+type remoteResource struct {
+	// repository is the git repository URL
+	repository string
+	// ref is the git reference used to pin the remote resource
+	ref string
+	// prefix is the part of the resource preceding the ref value
+	prefix string
+	// suffix is the part of the resource following the ref value
+	suffix string
+}
+
+// parseRemoteResource extracts the git repository and reference of a kustomization remote resource.
+// It returns nil if the resource is a local path or isn't pinned by a "ref" (or legacy "version") parameter.
+func parseRemoteResource(raw string) *remoteResource {
+	queryIndex := strings.Index(raw, "?")
+	if queryIndex < 0 {
+		return nil
+	}
+
+	base := raw[:queryIndex]
+
+	// Locate the ref value in the raw string so the rest of the resource can be restored as is
+	result := remoteResource{}
+	offset := queryIndex + 1
+	for _, param := range strings.Split(raw[queryIndex+1:], "&") {
+		key, value, _ := strings.Cut(param, "=")
+		if (key == "ref" || key == "version") && value != "" {
+			start := offset + len(key) + 1
+			result.ref = value
+			result.prefix = raw[:start]
+			result.suffix = raw[start+len(value):]
+			break
+		}
+		offset += len(param) + 1
+	}
+
+	if result.ref == "" {
+		return nil
+	}
+
+	repository, err := getRepositoryURL(base)
+	if err != nil {
+		logrus.Debugf("remote resource %q: %s", raw, err)
+		return nil
+	}
+	result.repository = repository
+
+	return &result
+}
+
+// getRepositoryURL returns the git repository URL of a kustomization remote resource, without
+// the directory path nor query parameters.
+func getRepositoryURL(base string) (string, error) {
+	base = strings.TrimPrefix(base, "git::")
+
+	scheme := ""
+	remaining := base
+
+	switch {
+	case strings.HasPrefix(base, "file://"):
+		return "", fmt.Errorf("local git repositories are not supported")
+	case strings.Contains(base, "://"):
+		scheme, remaining, _ = strings.Cut(base, "://")
+		scheme += "://"
+	case strings.HasPrefix(base, "git@"):
+		// scp-like syntax such as git@github.com:owner/repo.git
+		scheme = "git@"
+		remaining = strings.TrimPrefix(base, "git@")
+	case strings.HasPrefix(base, ".") || strings.HasPrefix(base, "/"):
+		return "", fmt.Errorf("local path")
+	default:
+		// kustomize considers "github.com/owner/repo" as an https URL
+		scheme = "https://"
+	}
+
+	// An explicit "//" separates the repository from the directory path
+	if repo, _, found := strings.Cut(remaining, "//"); found {
+		return scheme + repo, nil
+	}
+
+	if i := strings.Index(remaining, ".git"); i >= 0 {
+		end := i + len(".git")
+		if end == len(remaining) || remaining[end] == '/' {
+			return scheme + remaining[:end], nil
+		}
+	}
+
+	// Without explicit separator, only well-known hosts use an "owner/repo" layout
+	host, path, _ := strings.Cut(strings.Replace(remaining, ":", "/", 1), "/")
+	for _, knownHost := range knownGitHosts {
+		if host != knownHost {
+			continue
+		}
+
+		segments := strings.SplitN(path, "/", 3)
+		if len(segments) < 2 || segments[0] == "" || segments[1] == "" {
+			return "", fmt.Errorf("unable to identify repository from %q", base)
+		}
+
+		separator := "/"
+		if scheme == "git@" {
+			separator = ":"
+		}
+
+		return scheme + host + separator + segments[0] + "/" + segments[1], nil
+	}
+
+	return "", fmt.Errorf("unable to identify repository from %q", base)
+}
+
+// generateRemoteResourceManifest generates an Updatecli manifest for a remote resource
+// listed in the kustomization "resources", "components", or "bases" field
+func (k Kustomize) generateRemoteResourceManifest(relativeFile, field string, index int, entry string) ([]byte, error) {
+	resource := parseRemoteResource(entry)
+	if resource == nil {
+		return nil, nil
+	}
+
+	// Only tags can be bumped, branch names and commit hashes are ignored
+	if _, err := semver.NewVersion(resource.ref); err != nil {
+		logrus.Debugf("Ignoring remote resource %q from %q, as %q is not a valid semver version", entry, relativeFile, resource.ref)
+		return nil, nil
+	}
+
+	dep := dependency{
+		kind:       dependencyKindRemote,
+		name:       resource.repository,
+		version:    resource.ref,
+		repository: resource.repository,
+	}
+
+	if len(k.spec.Ignore) > 0 {
+		if k.spec.Ignore.isMatchingRules(k.rootDir, relativeFile, dep) {
+			logrus.Debugf("Ignoring remote resource %q from %q, as matching ignore rule(s)\n", resource.repository, relativeFile)
+			return nil, nil
+		}
+	}
+
+	if len(k.spec.Only) > 0 {
+		if !k.spec.Only.isMatchingRules(k.rootDir, relativeFile, dep) {
+			logrus.Debugf("Ignoring remote resource %q from %q, as not matching only rule(s)\n", resource.repository, relativeFile)
+			return nil, nil
+		}
+	}
+
+	versionFilterKind := k.versionFilter.Kind
+	versionFilterRegex := k.versionFilter.Regex
+	versionFilterPattern, err := k.versionFilter.GreaterThanPattern(resource.ref)
+	if err != nil {
+		logrus.Debugf("building version filter pattern: %s", err)
+		versionFilterPattern = "*"
+	}
+
+	tmpl, err := template.New("manifest").Parse(remoteResourceManifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	params := struct {
+		ActionID             string
+		Repository           string
+		ScmID                string
+		TargetField          string
+		TargetFile           string
+		TargetIndex          int
+		TargetPrefix         string
+		TargetSuffix         string
+		VersionFilterKind    string
+		VersionFilterPattern string
+		VersionFilterRegex   string
+	}{
+		ActionID:             k.actionID,
+		Repository:           resource.repository,
+		ScmID:                k.scmID,
+		TargetField:          field,
+		TargetFile:           relativeFile,
+		TargetIndex:          index,
+		TargetPrefix:         resource.prefix,
+		TargetSuffix:         resource.suffix,
+		VersionFilterKind:    versionFilterKind,
+		VersionFilterPattern: versionFilterPattern,
+		VersionFilterRegex:   versionFilterRegex,
+	}
+
+	manifest := bytes.Buffer{}
+	if err := tmpl.Execute(&manifest, params); err != nil {
+		return nil, err
+	}
+
+	return manifest.Bytes(), nil
+}
@@ -0,0 +1,71 @@
+package kustomize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRemoteResource(t *testing.T) {
+	testdata := []struct {
+		name     string
+		resource string
+		expected *remoteResource
+	}{
+		{
+			name:     "local path",
+			resource: "../base",
+		},
+		{
+			name:     "remote resource without ref",
+			resource: "https://github.com/argoproj/argo-cd//manifests/cluster-install",
+		},
+		{
+			name:     "https with double slash separator",
+			resource: "https://github.com/argoproj/argo-cd//manifests/cluster-install?ref=v2.9.3",
+			expected: &remoteResource{
+				repository: "https://github.com/argoproj/argo-cd",
+				ref:        "v2.9.3",
+				prefix:     "https://github.com/argoproj/argo-cd//manifests/cluster-install?ref=",
+			},
+		},
+		{
+			name:     "legacy github notation without scheme",
+			resource: "github.com/kubernetes-sigs/kustomize/examples/multibases?ref=v1.0.6",
+			expected: &remoteResource{
+				repository: "https://github.com/kubernetes-sigs/kustomize",
+				ref:        "v1.0.6",
+				prefix:     "github.com/kubernetes-sigs/kustomize/examples/multibases?ref=",
+			},
+		},
+		{
+			name:     "scp-like ssh with extra parameters",
+			resource: "git@github.com:example/components.git/monitoring?timeout=90s&ref=v1.2.0&submodules=false",
+			expected: &remoteResource{
+				repository: "git@github.com:example/components.git",
+				ref:        "v1.2.0",
+				prefix:     "git@github.com:example/components.git/monitoring?timeout=90s&ref=",
+				suffix:     "&submodules=false",
+			},
+		},
+		{
+			name:     "ssh scheme on a self-hosted git server",
+			resource: "ssh://git@git.example.com/platform/deploy.git//base?version=1.0.0",
+			expected: &remoteResource{
+				repository: "ssh://git@git.example.com/platform/deploy.git",
+				ref:        "1.0.0",
+				prefix:     "ssh://git@git.example.com/platform/deploy.git//base?version=",
+			},
+		},
+		{
+			name:     "self-hosted git server without separator",
+			resource: "https://git.example.com/platform/deploy/base?ref=v1.0.0",
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseRemoteResource(tt.resource))
+		})
+	}
+}
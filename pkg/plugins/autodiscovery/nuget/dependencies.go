@@ -0,0 +1,124 @@
+package nuget
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func (n Nuget) discoverDependencyManifests() ([][]byte, error) {
+	var manifests [][]byte
+
+	searchFromDir := n.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if n.spec.RootDir != "" && !path.IsAbs(n.spec.RootDir) {
+		searchFromDir = filepath.Join(n.rootDir, n.spec.RootDir)
+	}
+
+	foundFiles, err := searchProjectFiles(searchFromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New("manifest").Parse(manifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+		logrus.Debugf("parsing file %q", foundFile)
+
+		relativeFoundFile, err := filepath.Rel(n.rootDir, foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		references, err := parsePackageReferences(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		if len(references) == 0 {
+			logrus.Debugf("no NuGet package found in %q", relativeFoundFile)
+			continue
+		}
+
+		for _, reference := range references {
+			if len(n.spec.Ignore) > 0 && n.spec.Ignore.isMatchingRules(n.rootDir, relativeFoundFile, reference.ID, reference.Version) {
+				logrus.Debugf("ignoring NuGet package %q from %q, as matching ignore rule(s)", reference.ID, relativeFoundFile)
+				continue
+			}
+
+			if len(n.spec.Only) > 0 && !n.spec.Only.isMatchingRules(n.rootDir, relativeFoundFile, reference.ID, reference.Version) {
+				logrus.Debugf("ignoring NuGet package %q from %q, as not matching only rule(s)", reference.ID, relativeFoundFile)
+				continue
+			}
+
+			sourceVersionFilterKind, sourceVersionFilterPattern := n.getVersionFilter(reference.Version)
+
+			params := manifestTemplateParams{
+				ManifestName:               fmt.Sprintf("deps(nuget): bump %q in %q", reference.ID, relativeFoundFile),
+				ActionID:                   n.actionID,
+				SourceID:                   "nuget",
+				SourceName:                 fmt.Sprintf("Get latest %q NuGet package version", reference.ID),
+				SourceURL:                  n.spec.URL,
+				SourceUsername:             n.spec.Username,
+				SourcePassword:             n.spec.Password,
+				SourceVersionFilterKind:    sourceVersionFilterKind,
+				SourceVersionFilterPattern: sourceVersionFilterPattern,
+				SourceVersionFilterRegex:   n.versionFilter.Regex,
+				PackageID:                  reference.ID,
+				TargetID:                   "nuget",
+				TargetName:                 fmt.Sprintf("deps(nuget): bump %q to {{ source \"nuget\" }}", reference.ID),
+				TargetXMLPath:              reference.XMLPath,
+				File:                       relativeFoundFile,
+				ScmID:                      n.scmID,
+			}
+
+			manifest := bytes.Buffer{}
+			if err := tmpl.Execute(&manifest, params); err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			manifests = append(manifests, manifest.Bytes())
+		}
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
+
+// getVersionFilter returns the version filter kind and pattern used by the generated source.
+//
+// Pattern order
+//  1. If a versionfilter is defined in the manifest, then its kind and pattern are used
+//  2. Otherwise, the version is converted to ">=x.y.z"
+//  3. Versions which aren't semantic versions, such as "4.5.0.1", fall back to the latest version
+func (n Nuget) getVersionFilter(currentVersion string) (kind, pattern string) {
+	if !n.spec.VersionFilter.IsZero() {
+		pattern, err := n.versionFilter.GreaterThanPattern(currentVersion)
+		if err != nil {
+			logrus.Debugf("building version filter pattern: %s", err)
+			pattern = "*"
+		}
+		return n.versionFilter.Kind, pattern
+	}
+
+	if _, err := semver.NewVersion(currentVersion); err != nil {
+		logrus.Debugf("version %q isn't a semantic version, falling back to the latest version", currentVersion)
+		return version.LATESTVERSIONKIND, version.LATESTVERSIONKIND
+	}
+
+	return n.versionFilter.Kind, ">=" + currentVersion
+}
@@ -0,0 +1,135 @@
+// Package nuget implements the autodiscovery crawler for .NET projects.
+//
+// It walks a root directory looking for *.csproj, *.fsproj, and *.vbproj project files,
+// and for Directory.Packages.props files used by central package management.
+// One manifest is generated per "PackageReference", or "PackageVersion", declaring a version.
+// The version is bumped using the xml resource, whether it is defined as an attribute or as
+// a child element.
+package nuget
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines the parameters which can be provided to the NuGet crawler.
+type Spec struct {
+	// RootDir defines the root directory used to recursively search for .NET project files
+	RootDir string `yaml:",omitempty"`
+	// Ignore allows to specify rule to ignore autodiscovery a specific NuGet package based on a rule
+	Ignore MatchingRules `yaml:",omitempty"`
+	// Only allows to specify rule to only autodiscover manifest for a specific NuGet package based on a rule
+	Only MatchingRules `yaml:",omitempty"`
+	//  `versionfilter` provides parameters to specify the version pattern used when generating manifest.
+	//
+	//  kind - semver
+	//    versionfilter of kind `semver` uses semantic versioning as version filtering
+	//    pattern accepts one of:
+	//      `prerelease` - Updatecli tries to identify the latest prerelease whatever it means
+	//      `patch` - Updatecli only handles patch version update
+	//      `minor` - Updatecli handles patch AND minor version update
+	//      `minoronly` - Updatecli handles minor version only
+	//      `major` - Updatecli handles patch, minor, AND major version update
+	//      `majoronly` - Updatecli only handles major version update
+	//      `a version constraint` such as `>= 1.0.0`
+	//
+	//  kind - regex
+	//    versionfilter of kind `regex` uses regular expression as version filtering
+	//    pattern accepts a valid regular expression
+	//
+	//  example:
+	//  ```
+	//    versionfilter:
+	//      kind: semver
+	//      pattern: minor
+	//  ```
+	//
+	//  More examples can be found at https://www.updatecli.io/docs/core/versionfilter/
+	VersionFilter version.Filter `yaml:",omitempty"`
+	// URL defines the NuGet v3 service index (defaults to `https://api.nuget.org/v3/index.json`).
+	// This will be propagated to all generated nuget resource specs.
+	URL string `yaml:",omitempty"`
+	// Username defines the username used to authenticate against a private feed.
+	// This will be propagated to all generated nuget resource specs.
+	Username string `yaml:",omitempty"`
+	// Password defines the password, or personal access token, used to authenticate against a private feed.
+	// This will be propagated to all generated nuget resource specs.
+	Password string `yaml:",omitempty"`
+}
+
+// Nuget holds all information needed to generate NuGet manifests.
+type Nuget struct {
+	// actionID holds the actionID used by the newly generated manifest
+	actionID string
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// rootDir defines the root directory from where looking for project files
+	rootDir string
+	// scmID holds the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+}
+
+// New return a new valid object.
+func New(spec interface{}, rootDir, scmID, actionID string) (Nuget, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Nuget{}, err
+	}
+
+	if err := s.Ignore.Validate(); err != nil {
+		return Nuget{}, fmt.Errorf("invalid ignore spec: %w", err)
+	}
+
+	if err := s.Only.Validate(); err != nil {
+		return Nuget{}, fmt.Errorf("invalid only spec: %w", err)
+	}
+
+	if s.Password != "" && s.Username == "" {
+		return Nuget{}, fmt.Errorf("username required when a password is defined")
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return Nuget{}, fmt.Errorf("no working directory defined")
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		// By default, NuGet packages use semantic versioning
+		newFilter.Kind = "semver"
+		newFilter.Pattern = "*"
+	}
+
+	return Nuget{
+		actionID:      actionID,
+		spec:          s,
+		rootDir:       dir,
+		scmID:         scmID,
+		versionFilter: newFilter,
+	}, nil
+}
+
+// DiscoverManifests returns updatecli manifests for all NuGet packages found under rootDir.
+func (n Nuget) DiscoverManifests() ([][]byte, error) {
+	logrus.Infof("\n\n%s\n", strings.ToTitle("NuGet"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("NuGet")+1))
+
+	return n.discoverDependencyManifests()
+}
@@ -0,0 +1,174 @@
+package nuget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	testdata := []struct {
+		name              string
+		rootDir           string
+		scmID             string
+		actionID          string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:     "project files",
+			rootDir:  "testdata/project",
+			scmID:    "default",
+			actionID: "default",
+			spec: Spec{
+				RootDir: "src/Api",
+			},
+			expectedPipelines: []string{
+				`name: 'deps(nuget): bump "Newtonsoft.Json" in "src/Api/Api.csproj"'
+actions:
+  default:
+    title: 'deps(nuget): bump "Newtonsoft.Json" to {{ source "nuget" }}'
+
+sources:
+  nuget:
+    name: 'Get latest "Newtonsoft.Json" NuGet package version'
+    kind: 'nuget'
+    spec:
+      name: 'Newtonsoft.Json'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=13.0.1'
+targets:
+  nuget:
+    name: 'deps(nuget): bump "Newtonsoft.Json" to {{ source "nuget" }}'
+    scmid: 'default'
+    kind: 'xml'
+    spec:
+      file: 'src/Api/Api.csproj'
+      path: "//PackageReference[@Include='Newtonsoft.Json']/@Version"
+    sourceid: 'nuget'
+`,
+				`name: 'deps(nuget): bump "Polly" in "src/Api/Api.csproj"'
+actions:
+  default:
+    title: 'deps(nuget): bump "Polly" to {{ source "nuget" }}'
+
+sources:
+  nuget:
+    name: 'Get latest "Polly" NuGet package version'
+    kind: 'nuget'
+    spec:
+      name: 'Polly'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=8.2.0'
+targets:
+  nuget:
+    name: 'deps(nuget): bump "Polly" to {{ source "nuget" }}'
+    scmid: 'default'
+    kind: 'xml'
+    spec:
+      file: 'src/Api/Api.csproj'
+      path: "//PackageReference[@Include='Polly']/Version"
+    sourceid: 'nuget'
+`,
+			},
+		},
+		{
+			name:    "central package management with private feed",
+			rootDir: "testdata/project",
+			spec: Spec{
+				Only: MatchingRules{
+					{Path: "Directory.Packages.props"},
+				},
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "minor",
+				},
+				URL:      "https://pkgs.dev.azure.com/updatecli/_packaging/feed/nuget/v3/index.json",
+				Username: "updatecli",
+				Password: "secret",
+			},
+			expectedPipelines: []string{
+				`name: 'deps(nuget): bump "Serilog" in "Directory.Packages.props"'
+sources:
+  nuget:
+    name: 'Get latest "Serilog" NuGet package version'
+    kind: 'nuget'
+    spec:
+      name: 'Serilog'
+      url: 'https://pkgs.dev.azure.com/updatecli/_packaging/feed/nuget/v3/index.json'
+      username: 'updatecli'
+      password: 'secret'
+      versionfilter:
+        kind: 'semver'
+        pattern: '3.x'
+targets:
+  nuget:
+    name: 'deps(nuget): bump "Serilog" to {{ source "nuget" }}'
+    kind: 'xml'
+    spec:
+      file: 'Directory.Packages.props'
+      path: "//PackageVersion[@Include='Serilog']/@Version"
+    sourceid: 'nuget'
+`,
+			},
+		},
+		{
+			name:    "non semantic version",
+			rootDir: "testdata/project",
+			spec: Spec{
+				Only: MatchingRules{
+					{Packages: map[string]string{"system.data.sqlclient": ""}},
+				},
+			},
+			expectedPipelines: []string{
+				`name: 'deps(nuget): bump "System.Data.SqlClient" in "src/Lib/Lib.fsproj"'
+sources:
+  nuget:
+    name: 'Get latest "System.Data.SqlClient" NuGet package version'
+    kind: 'nuget'
+    spec:
+      name: 'System.Data.SqlClient'
+      versionfilter:
+        kind: 'latest'
+        pattern: 'latest'
+targets:
+  nuget:
+    name: 'deps(nuget): bump "System.Data.SqlClient" to {{ source "nuget" }}'
+    kind: 'xml'
+    spec:
+      file: 'src/Lib/Lib.fsproj'
+      path: "//PackageReference[@Include='System.Data.SqlClient']/@Version"
+    sourceid: 'nuget'
+`,
+			},
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := New(tt.spec, tt.rootDir, tt.scmID, tt.actionID)
+			require.NoError(t, err)
+
+			manifests, err := n.DiscoverManifests()
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.expectedPipelines), len(manifests))
+
+			for i := range manifests {
+				assert.Equal(t, tt.expectedPipelines[i], string(manifests[i]))
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Spec{Password: "secret"}, "testdata/project", "", "")
+	assert.ErrorContains(t, err, "username required")
+
+	_, err = New(Spec{Ignore: MatchingRules{{}}}, "testdata/project", "", "")
+	assert.ErrorContains(t, err, "invalid ignore spec")
+}
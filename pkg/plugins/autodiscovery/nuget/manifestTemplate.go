@@ -0,0 +1,63 @@
+package nuget
+
+// manifestTemplate is the Go template used to generate updatecli manifests
+// for NuGet package updates discovered in .NET project files.
+var manifestTemplate = `name: '{{ .ManifestName }}'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+    title: '{{ .TargetName }}'
+{{ end }}
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'nuget'
+    spec:
+      name: '{{ .PackageID }}'
+{{- if .SourceURL }}
+      url: '{{ .SourceURL }}'
+{{- end }}
+{{- if .SourceUsername }}
+      username: '{{ .SourceUsername }}'
+{{- end }}
+{{- if .SourcePassword }}
+      password: '{{ .SourcePassword }}'
+{{- end }}
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+{{- if or (eq .SourceVersionFilterKind "regex/semver") (eq .SourceVersionFilterKind "regex/time") }}
+        regex: '{{ .SourceVersionFilterRegex }}'
+{{- end }}
+targets:
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    kind: 'xml'
+    spec:
+      file: '{{ .File }}'
+      path: "{{ .TargetXMLPath }}"
+    sourceid: '{{ .SourceID }}'
+`
+
+// manifestTemplateParams holds the values injected into manifestTemplate.
+type manifestTemplateParams struct {
+	ManifestName               string
+	ActionID                   string
+	SourceID                   string
+	SourceName                 string
+	SourceURL                  string
+	SourceUsername             string
+	SourcePassword             string
+	SourceVersionFilterKind    string
+	SourceVersionFilterPattern string
+	SourceVersionFilterRegex   string
+	PackageID                  string
+	TargetID                   string
+	TargetName                 string
+	TargetXMLPath              string
+	File                       string
+	ScmID                      string
+}
@@ -0,0 +1,84 @@
+package nuget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchingRules(t *testing.T) {
+	tests := []struct {
+		name           string
+		rules          MatchingRules
+		filePath       string
+		packageName    string
+		packageVersion string
+		expected       bool
+	}{
+		{
+			name:        "matching path",
+			rules:       MatchingRules{{Path: "app/*"}},
+			filePath:    "app/App.csproj",
+			packageName: "Serilog",
+			expected:    true,
+		},
+		{
+			name:        "not matching path",
+			rules:       MatchingRules{{Path: "api/*"}},
+			filePath:    "app/App.csproj",
+			packageName: "Serilog",
+		},
+		{
+			name:           "matching package version constraint",
+			rules:          MatchingRules{{Packages: map[string]string{"Serilog": ">=3.0"}}},
+			filePath:       "App.csproj",
+			packageName:    "Serilog",
+			packageVersion: "3.1.1",
+			expected:       true,
+		},
+		{
+			name:           "not matching package version constraint",
+			rules:          MatchingRules{{Packages: map[string]string{"Serilog": "<3.0"}}},
+			filePath:       "App.csproj",
+			packageName:    "Serilog",
+			packageVersion: "3.1.1",
+		},
+		{
+			name:           "path and package must both match",
+			rules:          MatchingRules{{Path: "api/*", Packages: map[string]string{"Serilog": ""}}},
+			filePath:       "app/App.csproj",
+			packageName:    "Serilog",
+			packageVersion: "3.1.1",
+		},
+		{
+			name: "any rule can match",
+			rules: MatchingRules{
+				{Path: "api/*"},
+				{Packages: map[string]string{"Serilog": ""}},
+			},
+			filePath:    "app/App.csproj",
+			packageName: "Serilog",
+			expected:    true,
+		},
+		{
+			name:           "package id is case insensitive",
+			rules:          MatchingRules{{Packages: map[string]string{"serilog": ""}}},
+			filePath:       "App.csproj",
+			packageName:    "Serilog",
+			packageVersion: "3.1.1",
+			expected:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rules.isMatchingRules("", tt.filePath, tt.packageName, tt.packageVersion)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestMatchingRulesValidate(t *testing.T) {
+	assert.NoError(t, MatchingRules{{Path: "App.csproj"}}.Validate())
+	assert.Error(t, MatchingRules{{}}.Validate())
+}
@@ -0,0 +1,167 @@
+package nuget
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// centralPackageFile is the file used by central package management to declare package versions
+	centralPackageFile string = "Directory.Packages.props"
+)
+
+// projectFileExtensions lists the MSBuild project files which may contain PackageReference
+var projectFileExtensions = []string{".csproj", ".fsproj", ".vbproj"}
+
+// skipDirs lists directories that should never be walked for project files.
+var skipDirs = map[string]bool{
+	".git":         true,
+	"bin":          true,
+	"obj":          true,
+	"node_modules": true,
+	"packages":     true,
+}
+
+// packageReference holds a package version declared in a project file
+type packageReference struct {
+	// ID is the NuGet package id
+	ID string
+	// Version is the version currently declared
+	Version string
+	// XMLPath is the xpath query selecting the version in the project file
+	XMLPath string
+}
+
+// searchProjectFiles walks rootDir recursively and returns every project file
+// and Directory.Packages.props found.
+func searchProjectFiles(rootDir string) ([]string, error) {
+	var found []string
+
+	err := filepath.WalkDir(rootDir, func(path string, di fs.DirEntry, err error) error {
+		if err != nil {
+			logrus.Errorf("accessing path %q: %v", path, err)
+			return err
+		}
+
+		if di.IsDir() {
+			if skipDirs[di.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if isProjectFile(di.Name()) {
+			found = append(found, path)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Debugf("%d .NET project file(s) found", len(found))
+	for _, f := range found {
+		logrus.Debugf("    * %q", f)
+	}
+
+	return found, nil
+}
+
+// isProjectFile reports whether filename is a file handled by the crawler
+func isProjectFile(filename string) bool {
+	if filename == centralPackageFile {
+		return true
+	}
+
+	for _, ext := range projectFileExtensions {
+		if strings.EqualFold(filepath.Ext(filename), ext) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parsePackageReferences returns the package versions declared in a project file.
+//
+// Both "PackageReference" items from project files and "PackageVersion" items from
+// Directory.Packages.props are supported, with the version defined either as an
+// attribute or as a child element. References without version, managed centrally,
+// and versions which can't be bumped such as ranges or MSBuild properties are skipped.
+func parsePackageReferences(filename string) ([]packageReference, error) {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromFile(filename); err != nil {
+		return nil, fmt.Errorf("parsing %q: %w", filename, err)
+	}
+
+	var references []packageReference
+	found := map[string]bool{}
+
+	for _, item := range []string{"PackageReference", "PackageVersion"} {
+		for _, elem := range doc.FindElements("//" + item) {
+			idAttr := "Include"
+			id := elem.SelectAttrValue(idAttr, "")
+			if id == "" {
+				idAttr = "Update"
+				id = elem.SelectAttrValue(idAttr, "")
+			}
+
+			if id == "" {
+				continue
+			}
+
+			selector := fmt.Sprintf("//%s[@%s='%s']", item, idAttr, id)
+
+			var version, xmlPath string
+			switch {
+			case elem.SelectAttr("Version") != nil:
+				version = elem.SelectAttrValue("Version", "")
+				xmlPath = selector + "/@Version"
+			case elem.SelectElement("Version") != nil:
+				version = strings.TrimSpace(elem.SelectElement("Version").Text())
+				xmlPath = selector + "/Version"
+			default:
+				logrus.Debugf("skipping package %q from %q, no version defined", id, filename)
+				continue
+			}
+
+			if !isVersionSupported(version) {
+				logrus.Debugf("skipping package %q from %q, version %q not supported", id, filename, version)
+				continue
+			}
+
+			// The xml resource only updates the first element matching a path
+			if found[xmlPath] {
+				logrus.Debugf("skipping duplicated package %q from %q", id, filename)
+				continue
+			}
+			found[xmlPath] = true
+
+			references = append(references, packageReference{
+				ID:      id,
+				Version: version,
+				XMLPath: xmlPath,
+			})
+		}
+	}
+
+	return references, nil
+}
+
+// isVersionSupported reports whether a version can be bumped.
+// Version ranges such as "[1.0,2.0)", floating versions such as "1.*",
+// and MSBuild properties such as "$(SerilogVersion)" are not supported.
+func isVersionSupported(version string) bool {
+	if version == "" {
+		return false
+	}
+
+	return !strings.ContainsAny(version, "[]()*$,'")
+}
@@ -0,0 +1,69 @@
+package nuget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchProjectFiles(t *testing.T) {
+	got, err := searchProjectFiles("testdata/project")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"testdata/project/Directory.Packages.props",
+		"testdata/project/src/Api/Api.csproj",
+		"testdata/project/src/Lib/Lib.fsproj",
+	}, got)
+}
+
+func TestParsePackageReferences(t *testing.T) {
+	tests := []struct {
+		file     string
+		expected []packageReference
+	}{
+		{
+			file: "testdata/project/Directory.Packages.props",
+			expected: []packageReference{
+				{ID: "Serilog", Version: "3.1.1", XMLPath: "//PackageVersion[@Include='Serilog']/@Version"},
+			},
+		},
+		{
+			file: "testdata/project/src/Api/Api.csproj",
+			expected: []packageReference{
+				{ID: "Newtonsoft.Json", Version: "13.0.1", XMLPath: "//PackageReference[@Include='Newtonsoft.Json']/@Version"},
+				{ID: "Polly", Version: "8.2.0", XMLPath: "//PackageReference[@Include='Polly']/Version"},
+			},
+		},
+		{
+			file: "testdata/project/src/Lib/Lib.fsproj",
+			expected: []packageReference{
+				{ID: "FSharp.Core", Version: "8.0.100", XMLPath: "//PackageReference[@Update='FSharp.Core']/@Version"},
+				{ID: "System.Data.SqlClient", Version: "4.8.6.1", XMLPath: "//PackageReference[@Include='System.Data.SqlClient']/@Version"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			got, err := parsePackageReferences(tt.file)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestIsVersionSupported(t *testing.T) {
+	for version, expected := range map[string]bool{
+		"13.0.1":            true,
+		"1.0.0-beta.1":      true,
+		"4.8.6.1":           true,
+		"":                  false,
+		"2.6.*":             false,
+		"[1.0,2.0)":         false,
+		"$(SerilogVersion)": false,
+	} {
+		assert.Equal(t, expected, isVersionSupported(version), version)
+	}
+}
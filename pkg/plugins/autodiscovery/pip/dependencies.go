@@ -0,0 +1,109 @@
+package pip
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// processDependencies generates manifests for the dependencies pinned in relativeFile.
+// workdir and lockEnabled are only used by the pipfile flavor to update Pipfile.lock.
+func (p Pip) processDependencies(
+	deps []pythonDependency,
+	relativeFile string,
+	workdir string,
+	lockEnabled bool,
+) [][]byte {
+	var manifests [][]byte
+
+	tmpl, err := template.New("manifest").Parse(manifestTemplate)
+	if err != nil {
+		logrus.Errorln(err)
+		return manifests
+	}
+
+	for _, dep := range deps {
+		if len(p.spec.Ignore) > 0 && p.spec.Ignore.isMatchingRules(p.rootDir, relativeFile, dep.Name, dep.Version) {
+			logrus.Debugf("ignoring %q from %q as matching ignore rule(s)", dep.Name, relativeFile)
+			continue
+		}
+
+		if len(p.spec.Only) > 0 && !p.spec.Only.isMatchingRules(p.rootDir, relativeFile, dep.Name, dep.Version) {
+			logrus.Debugf("ignoring %q from %q as not matching only rule(s)", dep.Name, relativeFile)
+			continue
+		}
+
+		params := p.buildTemplateParams(dep, relativeFile, workdir, lockEnabled)
+
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, params); err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		manifests = append(manifests, buf.Bytes())
+	}
+
+	return manifests
+}
+
+// buildTemplateParams constructs the manifestTemplateParams for a single dependency.
+func (p Pip) buildTemplateParams(
+	dep pythonDependency,
+	relativeFile string,
+	workdir string,
+	lockEnabled bool,
+) manifestTemplateParams {
+	// Determine version filter.
+	//
+	// Priority:
+	//   1. User-specified VersionFilter from spec, resolved against the pinned version.
+	//   2. "~=" specifiers are reused as is, so "~=2.28.0" only allows 2.28.x releases.
+	//   3. "==" specifiers allow any release greater than or equal to the pinned version.
+	sourceVersionFilterKind := p.versionFilter.Kind
+	sourceVersionFilterPattern := p.versionFilter.Pattern
+	sourceVersionFilterRegex := p.versionFilter.Regex
+
+	if !p.spec.VersionFilter.IsZero() {
+		var err error
+		sourceVersionFilterPattern, err = p.versionFilter.GreaterThanPattern(dep.Version)
+		if err != nil {
+			logrus.Debugf("building version filter pattern for %q: %s", dep.Name, err)
+			sourceVersionFilterPattern = p.versionFilter.Pattern
+		}
+	} else {
+		sourceVersionFilterKind = version.PEP440VERSIONKIND
+		switch dep.Operator {
+		case "~=":
+			sourceVersionFilterPattern = "~=" + dep.Version
+		default:
+			sourceVersionFilterPattern = ">=" + dep.Version
+		}
+	}
+
+	return manifestTemplateParams{
+		ManifestName:               fmt.Sprintf("deps(pypi): bump %q in %q", dep.Name, relativeFile),
+		ActionID:                   p.actionID,
+		SourceID:                   dep.Name,
+		SourceName:                 fmt.Sprintf("Get latest %q package version", dep.Name),
+		SourceVersionFilterKind:    sourceVersionFilterKind,
+		SourceVersionFilterPattern: sourceVersionFilterPattern,
+		SourceVersionFilterRegex:   sourceVersionFilterRegex,
+		DependencyName:             dep.Name,
+		IndexURL:                   p.spec.IndexURL,
+		TargetID:                   dep.Name,
+		TargetLockID:               "pipfile.lock",
+		TargetName:                 fmt.Sprintf("deps(pypi): bump %q to {{ source %q }}", dep.Name, dep.Name),
+		// The pattern is rendered in a single-quoted yaml string
+		TargetMatchPattern: strings.ReplaceAll(dep.MatchPattern, "'", "''"),
+		TargetLockEnabled:  lockEnabled,
+		File:               relativeFile,
+		LockFile:           pipfileLock,
+		Workdir:            workdir,
+		ScmID:              p.scmID,
+	}
+}
@@ -0,0 +1,179 @@
+// Package pip implements the autodiscovery crawlers for Python projects pinning their
+// dependencies in pip requirements files or in a Pipfile.
+//
+// Two flavors are supported:
+//
+//   - requirements: walks a root directory looking for requirements*.txt files, and *.txt files
+//     inside a "requirements" directory, and follows the -r/--requirement and -c/--constraint
+//     includes they declare. Only dependencies pinned with `==` or `~=` are updated.
+//   - pipfile: walks a root directory looking for Pipfile files and reads the [packages] and
+//     [dev-packages] tables. Only dependencies pinned with `==` or `~=` are updated.
+//     When a Pipfile.lock sits next to the Pipfile, a shell target running `pipenv lock` is
+//     generated, or the whole Pipfile is skipped if the pipenv command is missing.
+//
+// Each generated manifest combines a pypi source with a file target anchored on the line
+// declaring the dependency. Only the version is rewritten, so comments, extras, and environment
+// markers are preserved. Requirements pinned with --hash, and every requirement of a file using
+// --require-hashes, are skipped as the hashes must be regenerated by a tool such as pip-compile.
+package pip
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+var (
+	// FlavorRequirements discovers dependencies declared in pip requirements files.
+	FlavorRequirements string = "requirements"
+	// FlavorPipfile discovers dependencies declared in Pipfile files.
+	FlavorPipfile string = "pipfile"
+)
+
+// Spec defines the requirements and pipfile autodiscovery parameters.
+type Spec struct {
+	// RootDir defines the root directory used to recursively search for requirements files or Pipfile.
+	RootDir string `yaml:",omitempty"`
+	// Ignore specifies rules to exclude dependencies from autodiscovery.
+	Ignore MatchingRules `yaml:",omitempty"`
+	// Only specifies rules to restrict autodiscovery to matching dependencies.
+	Only MatchingRules `yaml:",omitempty"`
+	//  `versionfilter` provides parameters to specify the version pattern used when generating manifest.
+	//
+	//  If unspecified, Updatecli falls back to kind `pep440` and derives the pattern from each
+	//  dependency's own specifier, such as `>=2.28.0` for `requests==2.28.0`, or `~=2.28.0`
+	//  for `requests~=2.28.0`.
+	//
+	//  kind - pep440 (default)
+	//    versionfilter of kind `pep440` uses PEP 440 version specifiers natively
+	//    pattern accepts a PEP 440 version specifier such as `>=2.28`, `>=1.0,<3.0`, or `*` (any)
+	//
+	//  kind - semver
+	//    versionfilter of kind `semver` uses semantic versioning as version filtering
+	//    pattern accepts one of:
+	//      `prerelease` - Updatecli tries to identify the latest prerelease whatever it means
+	//      `patch` - Updatecli only handles patch version update
+	//      `minor` - Updatecli handles patch AND minor version update
+	//      `minoronly` - Updatecli handles minor version only
+	//      `major` - Updatecli handles patch, minor, AND major version update
+	//      `majoronly` - Updatecli only handles major version update
+	//      `a version constraint` such as `>= 1.0.0`
+	//    relative patterns such as `minor` are resolved against the version currently pinned
+	//    by each dependency, so `minor` generates the pattern `2.x` for `requests==2.28.0`
+	//
+	//  kind - regex
+	//    versionfilter of kind `regex` uses regular expression as version filtering
+	//    pattern accepts a valid regular expression
+	//
+	//  example:
+	//  ```
+	//    versionfilter:
+	//      kind: pep440
+	//      pattern: ">=2.28"
+	//  ```
+	//
+	//  More examples can be found at https://www.updatecli.io/docs/core/versionfilter/
+	VersionFilter version.Filter `yaml:",omitempty"`
+	// IndexURL specifies a custom PyPI index URL propagated to all generated source specs.
+	// It carries no credentials: authenticating against a private registry requires setting the
+	// pypi resource `token` field on the generated manifests.
+	IndexURL string `yaml:",omitempty"`
+}
+
+// Pip holds all state needed to discover requirements files or Pipfile dependency manifests.
+type Pip struct {
+	// spec is the user-supplied configuration.
+	spec Spec
+	// rootDir is the resolved directory to search from.
+	rootDir string
+	// actionID is propagated to generated manifests.
+	actionID string
+	// scmID is propagated to generated manifests.
+	scmID string
+	// flavor defines which kind of files is discovered.
+	flavor string
+	// versionFilter is the resolved filter (may differ from spec.VersionFilter when defaults apply).
+	versionFilter version.Filter
+	// pipenvAvailable reports whether the pipenv CLI is present on PATH.
+	pipenvAvailable bool
+}
+
+// New constructs a valid Pip autodiscovery instance from the provided spec.
+func New(spec interface{}, rootDir, scmID, actionID, flavor string) (Pip, error) {
+	var s Spec
+
+	if err := mapstructure.Decode(spec, &s); err != nil {
+		return Pip{}, err
+	}
+
+	if err := s.Ignore.Validate(); err != nil {
+		return Pip{}, fmt.Errorf("invalid ignore spec: %w", err)
+	}
+
+	if err := s.Only.Validate(); err != nil {
+		return Pip{}, fmt.Errorf("invalid only spec: %w", err)
+	}
+
+	switch flavor {
+	case FlavorRequirements, FlavorPipfile:
+	default:
+		return Pip{}, fmt.Errorf("unsupported flavor %q", flavor)
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return Pip{}, fmt.Errorf("no working directory defined")
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		logrus.Debugln("no versioning filter specified, falling back to pep440 versioning")
+		newFilter.Kind = version.PEP440VERSIONKIND
+		newFilter.Pattern = "*"
+	}
+
+	p := Pip{
+		actionID:      actionID,
+		spec:          s,
+		rootDir:       dir,
+		scmID:         scmID,
+		flavor:        flavor,
+		versionFilter: newFilter,
+	}
+
+	if flavor == FlavorPipfile {
+		p.pipenvAvailable = isPipenvAvailable()
+	}
+
+	return p, nil
+}
+
+// DiscoverManifests returns updatecli manifests for all Python dependencies found under rootDir.
+func (p Pip) DiscoverManifests() ([][]byte, error) {
+	title := "Requirements"
+	if p.flavor == FlavorPipfile {
+		title = "Pipfile"
+	}
+
+	logrus.Infof("\n\n%s\n", strings.ToTitle(title))
+	logrus.Infof("%s\n", strings.Repeat("=", len(title)+1))
+
+	switch p.flavor {
+	case FlavorPipfile:
+		return p.discoverPipfileManifests()
+	default:
+		return p.discoverRequirementsManifests()
+	}
+}
@@ -0,0 +1,277 @@
+package pip
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	testdata := []struct {
+		name              string
+		rootDir           string
+		scmID             string
+		actionID          string
+		flavor            string
+		spec              Spec
+		pipenvAvailable   bool
+		expectedPipelines []string
+	}{
+		{
+			name:     "Scenario 1 -- requirements directory with scmID and actionID",
+			rootDir:  "testdata/requirements_dir",
+			scmID:    "git",
+			actionID: "github",
+			flavor:   FlavorRequirements,
+			expectedPipelines: []string{
+				`name: 'deps(pypi): bump "black" in "requirements/dev.txt"'
+actions:
+  github:
+    title: 'deps(pypi): bump "black" to {{ source "black" }}'
+
+sources:
+  black:
+    name: 'Get latest "black" package version'
+    kind: 'pypi'
+    spec:
+      name: 'black'
+      versionfilter:
+        kind: 'pep440'
+        pattern: '>=23.7.0'
+targets:
+  black:
+    name: 'deps(pypi): bump "black" to {{ source "black" }}'
+    scmid: 'git'
+    kind: 'file'
+    spec:
+      file: 'requirements/dev.txt'
+      matchpattern: '(?m)^([ \t]*black[ \t]*(?:\[[^\]\n]*\])?[ \t]*==[ \t]*)[^\s;#,\\]+((?:[ \t]+[^;\s].*)?[ \t\r]*)$'
+      replacepattern: '${1}{{ source "black" }}${2}'
+    sourceid: 'black'
+`,
+				`name: 'deps(pypi): bump "requests" in "requirements.txt"'
+actions:
+  github:
+    title: 'deps(pypi): bump "requests" to {{ source "requests" }}'
+
+sources:
+  requests:
+    name: 'Get latest "requests" package version'
+    kind: 'pypi'
+    spec:
+      name: 'requests'
+      versionfilter:
+        kind: 'pep440'
+        pattern: '>=2.28.0'
+targets:
+  requests:
+    name: 'deps(pypi): bump "requests" to {{ source "requests" }}'
+    scmid: 'git'
+    kind: 'file'
+    spec:
+      file: 'requirements.txt'
+      matchpattern: '(?m)^([ \t]*requests[ \t]*(?:\[[^\]\n]*\])?[ \t]*==[ \t]*)[^\s;#,\\]+((?:[ \t]+[^;\s].*)?[ \t\r]*)$'
+      replacepattern: '${1}{{ source "requests" }}${2}'
+    sourceid: 'requests'
+`,
+			},
+		},
+		{
+			name:    "Scenario 2 -- requirements with environment markers and a custom version filter",
+			rootDir: "testdata/requirements_project",
+			flavor:  FlavorRequirements,
+			spec: Spec{
+				Only: MatchingRules{
+					{Packages: map[string]string{"numpy": ">=1.25"}},
+				},
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "minor",
+				},
+				IndexURL: "https://pypi.example.com/",
+			},
+			expectedPipelines: []string{
+				`name: 'deps(pypi): bump "numpy" in "requirements.txt"'
+sources:
+  numpy:
+    name: 'Get latest "numpy" package version'
+    kind: 'pypi'
+    spec:
+      name: 'numpy'
+      url: 'https://pypi.example.com/'
+      versionfilter:
+        kind: 'semver'
+        pattern: '1.x'
+targets:
+  numpy:
+    name: 'deps(pypi): bump "numpy" to {{ source "numpy" }}'
+    kind: 'file'
+    spec:
+      file: 'requirements.txt'
+      matchpattern: '(?m)^([ \t]*numpy[ \t]*(?:\[[^\]\n]*\])?[ \t]*==[ \t]*)[^\s;#,\\]+([ \t]*;[ \t]*python_version >= "3\.9"(?:[ \t\r].*)?)$'
+      replacepattern: '${1}{{ source "numpy" }}${2}'
+    sourceid: 'numpy'
+`,
+			},
+		},
+		{
+			name:            "Scenario 3 -- Pipfile with Pipfile.lock, pipenv available",
+			rootDir:         "testdata/pipfile_lock",
+			flavor:          FlavorPipfile,
+			pipenvAvailable: true,
+			expectedPipelines: []string{
+				`name: 'deps(pypi): bump "requests" in "Pipfile"'
+sources:
+  requests:
+    name: 'Get latest "requests" package version'
+    kind: 'pypi'
+    spec:
+      name: 'requests'
+      versionfilter:
+        kind: 'pep440'
+        pattern: '>=2.28.0'
+targets:
+  requests:
+    name: 'deps(pypi): bump "requests" to {{ source "requests" }}'
+    kind: 'file'
+    spec:
+      file: 'Pipfile'
+      matchpattern: '(?m)^([ \t]*["'']?requests["'']?[ \t]*=[ \t]*(?:\{[^}\n]*\bversion[ \t]*=[ \t]*)?["'']==[ \t]*)[^"'']+(["''])'
+      replacepattern: '${1}{{ source "requests" }}${2}'
+    sourceid: 'requests'
+  pipfile.lock:
+    name: 'deps(pypi): bump "requests" to {{ source "requests" }}'
+    dependson:
+      - 'target#requests'
+    kind: 'shell'
+    spec:
+      command: 'pipenv lock'
+      changedif:
+        kind: file/checksum
+        spec:
+          files:
+            - "Pipfile.lock"
+      environments:
+        - name: PATH
+      workdir: '.'
+    disablesourceinput: true
+`,
+			},
+		},
+		{
+			name:              "Scenario 4 -- Pipfile with Pipfile.lock, pipenv NOT available",
+			rootDir:           "testdata/pipfile_lock",
+			flavor:            FlavorPipfile,
+			expectedPipelines: []string{},
+		},
+		{
+			name:    "Scenario 5 -- Pipfile without lock file, ignore rule excludes requests",
+			rootDir: "testdata/pipfile",
+			flavor:  FlavorPipfile,
+			spec: Spec{
+				Ignore: MatchingRules{
+					{Packages: map[string]string{"requests": ""}},
+				},
+			},
+			expectedPipelines: []string{
+				`name: 'deps(pypi): bump "flask" in "Pipfile"'
+sources:
+  flask:
+    name: 'Get latest "flask" package version'
+    kind: 'pypi'
+    spec:
+      name: 'flask'
+      versionfilter:
+        kind: 'pep440'
+        pattern: '~=2.3.2'
+targets:
+  flask:
+    name: 'deps(pypi): bump "flask" to {{ source "flask" }}'
+    kind: 'file'
+    spec:
+      file: 'Pipfile'
+      matchpattern: '(?m)^([ \t]*["'']?flask["'']?[ \t]*=[ \t]*(?:\{[^}\n]*\bversion[ \t]*=[ \t]*)?["'']~=[ \t]*)[^"'']+(["''])'
+      replacepattern: '${1}{{ source "flask" }}${2}'
+    sourceid: 'flask'
+`,
+				`name: 'deps(pypi): bump "pytest" in "Pipfile"'
+sources:
+  pytest:
+    name: 'Get latest "pytest" package version'
+    kind: 'pypi'
+    spec:
+      name: 'pytest'
+      versionfilter:
+        kind: 'pep440'
+        pattern: '>=7.4.0'
+targets:
+  pytest:
+    name: 'deps(pypi): bump "pytest" to {{ source "pytest" }}'
+    kind: 'file'
+    spec:
+      file: 'Pipfile'
+      matchpattern: '(?m)^([ \t]*["'']?pytest["'']?[ \t]*=[ \t]*(?:\{[^}\n]*\bversion[ \t]*=[ \t]*)?["'']==[ \t]*)[^"'']+(["''])'
+      replacepattern: '${1}{{ source "pytest" }}${2}'
+    sourceid: 'pytest'
+`,
+			},
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := New(tt.spec, tt.rootDir, tt.scmID, tt.actionID, tt.flavor)
+			require.NoError(t, err)
+
+			p.pipenvAvailable = tt.pipenvAvailable
+
+			rawPipelines, err := p.DiscoverManifests()
+			require.NoError(t, err)
+
+			if len(rawPipelines) == 0 && len(tt.expectedPipelines) > 0 {
+				t.Fatalf("expected %d pipelines, got 0", len(tt.expectedPipelines))
+			}
+
+			require.Equal(t, len(tt.expectedPipelines), len(rawPipelines), "number of pipelines mismatch")
+
+			for i := range rawPipelines {
+				assert.Equal(t, tt.expectedPipelines[i], string(rawPipelines[i]))
+			}
+		})
+	}
+}
+
+func TestDiscoverRequirementsIncludes(t *testing.T) {
+	p, err := New(Spec{}, "testdata/requirements_project", "", "", FlavorRequirements)
+	require.NoError(t, err)
+
+	rawPipelines, err := p.DiscoverManifests()
+	require.NoError(t, err)
+
+	var gotNames []string
+	for _, rawPipeline := range rawPipelines {
+		name, _, _ := strings.Cut(string(rawPipeline), "\n")
+		gotNames = append(gotNames, name)
+	}
+
+	// requirements.txt is included by requirements-dev.txt but only parsed once,
+	// while common.txt and constraints.txt are only discovered through includes.
+	// Requirements pinned with hashes, urllib3 and idna, are skipped.
+	assert.Equal(t, []string{
+		`name: 'deps(pypi): bump "pytest" in "requirements-dev.txt"'`,
+		`name: 'deps(pypi): bump "requests" in "requirements.txt"'`,
+		`name: 'deps(pypi): bump "Flask" in "requirements.txt"'`,
+		`name: 'deps(pypi): bump "numpy" in "requirements.txt"'`,
+		`name: 'deps(pypi): bump "numpy" in "requirements.txt"'`,
+		`name: 'deps(pypi): bump "pyyaml" in "common.txt"'`,
+		`name: 'deps(pypi): bump "certifi" in "constraints.txt"'`,
+	}, gotNames)
+}
+
+func TestNewUnsupportedFlavor(t *testing.T) {
+	_, err := New(Spec{}, "testdata/pipfile", "", "", "poetry")
+	require.Error(t, err)
+}
@@ -0,0 +1,81 @@
+package pip
+
+// manifestTemplate is the Go text/template used to generate updatecli manifests
+// for Python dependency updates discovered via requirements files or Pipfile.
+var manifestTemplate = `name: '{{ .ManifestName }}'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+    title: '{{ .TargetName }}'
+{{ end }}
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'pypi'
+    spec:
+      name: '{{ .DependencyName }}'
+{{- if .IndexURL }}
+      url: '{{ .IndexURL }}'
+{{- end }}
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+{{- if or (eq .SourceVersionFilterKind "regex/semver") (eq .SourceVersionFilterKind "regex/time") }}
+        regex: '{{ .SourceVersionFilterRegex }}'
+{{- end }}
+targets:
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    kind: 'file'
+    spec:
+      file: '{{ .File }}'
+      matchpattern: '{{ .TargetMatchPattern }}'
+      replacepattern: '${1}{{ "{{" }} source "{{ .SourceID }}" {{ "}}" }}${2}'
+    sourceid: '{{ .SourceID }}'
+{{- if .TargetLockEnabled }}
+  {{ .TargetLockID }}:
+    name: '{{ .TargetName }}'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    dependson:
+      - 'target#{{ .TargetID }}'
+    kind: 'shell'
+    spec:
+      command: 'pipenv lock'
+      changedif:
+        kind: file/checksum
+        spec:
+          files:
+            - "{{ .LockFile }}"
+      environments:
+        - name: PATH
+      workdir: '{{ .Workdir }}'
+    disablesourceinput: true
+{{- end }}
+`
+
+// manifestTemplateParams holds the values injected into manifestTemplate.
+type manifestTemplateParams struct {
+	ManifestName               string
+	ActionID                   string
+	SourceID                   string
+	SourceName                 string
+	SourceVersionFilterKind    string
+	SourceVersionFilterPattern string
+	SourceVersionFilterRegex   string
+	DependencyName             string
+	IndexURL                   string
+	TargetID                   string
+	TargetLockID               string
+	TargetName                 string
+	TargetMatchPattern         string
+	TargetLockEnabled          bool
+	File                       string
+	LockFile                   string
+	Workdir                    string
+	ScmID                      string
+}
@@ -0,0 +1,136 @@
+package pip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchingRulesValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		rules       MatchingRules
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:        "empty rules should pass",
+			rules:       MatchingRules{},
+			expectError: false,
+		},
+		{
+			name: "rule with path should pass",
+			rules: MatchingRules{
+				{Path: "requirements.txt"},
+			},
+			expectError: false,
+		},
+		{
+			name: "rule with packages should pass",
+			rules: MatchingRules{
+				{Packages: map[string]string{"requests": ""}},
+			},
+			expectError: false,
+		},
+		{
+			name: "empty rule should fail",
+			rules: MatchingRules{
+				{Path: "Pipfile"},
+				{},
+			},
+			expectError: true,
+			errorMsg:    "rule 2 has no valid fields",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rules.Validate()
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsMatchingRules(t *testing.T) {
+	dataset := []struct {
+		name           string
+		rules          MatchingRules
+		rootDir        string
+		filePath       string
+		packageName    string
+		packageVersion string
+		expectedResult bool
+	}{
+		{
+			name: "path matches with wildcard",
+			rules: MatchingRules{
+				{Path: "requirements/*.txt"},
+			},
+			filePath:       "requirements/dev.txt",
+			expectedResult: true,
+		},
+		{
+			name: "path does not match",
+			rules: MatchingRules{
+				{Path: "requirements.txt"},
+			},
+			filePath:       "subdir/requirements.txt",
+			expectedResult: false,
+		},
+		{
+			name: "package version constraint matches",
+			rules: MatchingRules{
+				{Packages: map[string]string{"requests": ">=2.0"}},
+			},
+			filePath:       "requirements.txt",
+			packageName:    "requests",
+			packageVersion: "2.28.0",
+			expectedResult: true,
+		},
+		{
+			name: "package version constraint does not match",
+			rules: MatchingRules{
+				{Packages: map[string]string{"requests": ">=3.0"}},
+			},
+			filePath:       "requirements.txt",
+			packageName:    "requests",
+			packageVersion: "2.28.0",
+			expectedResult: false,
+		},
+		{
+			name: "path and package AND logic — path matches but package does not",
+			rules: MatchingRules{
+				{
+					Path:     "Pipfile",
+					Packages: map[string]string{"requests": ""},
+				},
+			},
+			filePath:       "Pipfile",
+			packageName:    "flask",
+			expectedResult: false,
+		},
+		{
+			name: "multiple rules use OR logic — second rule matches",
+			rules: MatchingRules{
+				{Packages: map[string]string{"requests": ""}},
+				{Packages: map[string]string{"flask": ""}},
+			},
+			filePath:       "Pipfile",
+			packageName:    "flask",
+			expectedResult: true,
+		},
+	}
+
+	for _, d := range dataset {
+		t.Run(d.name, func(t *testing.T) {
+			got := d.rules.isMatchingRules(d.rootDir, d.filePath, d.packageName, d.packageVersion)
+			assert.Equal(t, d.expectedResult, got)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package pip
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	"github.com/BurntSushi/toml"
+	"github.com/sirupsen/logrus"
+)
+
+// pipfileTOML mirrors the subset of Pipfile we care about.
+// A package is declared either as a version specifier string, such as `requests = "==2.28.0"`,
+// or as a table, such as `requests = {version = "==2.28.0", extras = ["socks"]}`.
+type pipfileTOML struct {
+	Packages    map[string]interface{} `toml:"packages"`
+	DevPackages map[string]interface{} `toml:"dev-packages"`
+}
+
+// loadPipfileData reads and unmarshals a Pipfile.
+func loadPipfileData(filePath string) (pipfileTOML, error) {
+	var data pipfileTOML
+	if _, err := toml.DecodeFile(filePath, &data); err != nil {
+		return data, fmt.Errorf("parsing %q: %w", filePath, err)
+	}
+	return data, nil
+}
+
+// discoverPipfileManifests is the entry point of the pipfile flavor.
+func (p Pip) discoverPipfileManifests() ([][]byte, error) {
+	var manifests [][]byte
+
+	searchFromDir := p.rootDir
+	// spec.RootDir relative paths are joined onto rootDir; absolute ones were resolved in New().
+	if p.spec.RootDir != "" && !path.IsAbs(p.spec.RootDir) {
+		searchFromDir = filepath.Join(p.rootDir, p.spec.RootDir)
+	}
+
+	foundFiles, err := findFiles(searchFromDir, isPipfile)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+		logrus.Debugf("parsing file %q", foundFile)
+
+		dir := filepath.Dir(foundFile)
+
+		relativeFile, err := filepath.Rel(p.rootDir, foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		workdir, err := filepath.Rel(p.rootDir, dir)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		hasLockFile := isLockFileDetected(filepath.Join(dir, pipfileLock))
+		// It doesn't make sense to update the Pipfile if Updatecli can't update the Pipfile.lock
+		if hasLockFile && !p.pipenvAvailable {
+			logrus.Warningf("skipping %q, Pipfile.lock detected but Updatecli couldn't detect the pipenv command to update it", relativeFile)
+			continue
+		}
+
+		data, err := loadPipfileData(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		var deps []pythonDependency
+		for _, packages := range []map[string]interface{}{data.Packages, data.DevPackages} {
+			for _, name := range slices.Sorted(maps.Keys(packages)) {
+				dep, err := parsePipfilePackage(name, packages[name])
+				if err != nil {
+					logrus.Debugf("skipping package %q from %q: %s", name, relativeFile, err)
+					continue
+				}
+				deps = append(deps, dep)
+			}
+		}
+
+		manifests = append(manifests, p.processDependencies(deps, relativeFile, workdir, hasLockFile)...)
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
+
+// parsePipfilePackage parses a package declared in the [packages] or [dev-packages] table.
+func parsePipfilePackage(name string, value interface{}) (pythonDependency, error) {
+	var specifier string
+
+	switch v := value.(type) {
+	case string:
+		specifier = v
+	case map[string]interface{}:
+		s, ok := v["version"].(string)
+		if !ok {
+			return pythonDependency{}, fmt.Errorf("no version defined")
+		}
+		specifier = s
+	default:
+		return pythonDependency{}, fmt.Errorf("unsupported value type %T", value)
+	}
+
+	operator, version, err := parsePinnedVersion(specifier)
+	if err != nil {
+		return pythonDependency{}, err
+	}
+
+	return pythonDependency{
+		Name:         name,
+		Operator:     operator,
+		Version:      version,
+		MatchPattern: pipfilePattern(name, operator),
+	}, nil
+}
+
+// pipfilePattern returns the regular expression matching the version of a Pipfile package,
+// whether it's declared as a string or as a table with a version key.
+func pipfilePattern(name, operator string) string {
+	return fmt.Sprintf(`(?m)^([ \t]*["']?%s["']?[ \t]*=[ \t]*(?:\{[^}\n]*\bversion[ \t]*=[ \t]*)?["']%s[ \t]*)[^"']+(["'])`,
+		regexp.QuoteMeta(name), regexp.QuoteMeta(operator))
+}
@@ -0,0 +1,232 @@
+package pip
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// requirementRegex parses a requirement line, once comments, options, and markers are removed.
+	// Group 1: package name
+	// Group 2: version specifier
+	requirementRegex = regexp.MustCompile(`^([A-Za-z0-9](?:[A-Za-z0-9._-]*[A-Za-z0-9])?)\s*(?:\[[^\]]*\])?\s*(.*)$`)
+	// requirementIncludeRegex parses the -r/--requirement and -c/--constraint options.
+	// Group 1: included file path
+	requirementIncludeRegex = regexp.MustCompile(`^(?:-r|--requirement|-c|--constraint)(?:\s*=\s*|\s*)(\S+)$`)
+	// requirementCommentRegex matches comments, which start at the beginning of a line or after a whitespace.
+	requirementCommentRegex = regexp.MustCompile(`(^|\s+)#.*$`)
+)
+
+// requirementsFile holds the content of a requirements file relevant to Updatecli.
+type requirementsFile struct {
+	Dependencies []pythonDependency
+	// Includes lists the files referenced by -r and -c options, as written in the file
+	Includes []string
+}
+
+// discoverRequirementsManifests is the entry point of the requirements flavor.
+func (p Pip) discoverRequirementsManifests() ([][]byte, error) {
+	var manifests [][]byte
+
+	searchFromDir := p.rootDir
+	// spec.RootDir relative paths are joined onto rootDir; absolute ones were resolved in New().
+	if p.spec.RootDir != "" && !path.IsAbs(p.spec.RootDir) {
+		searchFromDir = filepath.Join(p.rootDir, p.spec.RootDir)
+	}
+
+	foundFiles, err := findFiles(searchFromDir, isRequirementsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// Files included by -r and -c options are appended to the list while iterating,
+	// so a file is parsed once even if it's both found and included.
+	visited := make(map[string]bool)
+	for i := 0; i < len(foundFiles); i++ {
+		foundFile := filepath.Clean(foundFiles[i])
+		if visited[foundFile] {
+			continue
+		}
+		visited[foundFile] = true
+
+		logrus.Debugf("parsing file %q", foundFile)
+
+		data, err := parseRequirementsFile(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		for _, include := range data.Includes {
+			if strings.Contains(include, "://") {
+				logrus.Debugf("skipping remote requirements file %q included from %q", include, foundFile)
+				continue
+			}
+
+			includedFile := include
+			if !filepath.IsAbs(includedFile) {
+				includedFile = filepath.Join(filepath.Dir(foundFile), include)
+			}
+
+			rel, err := filepath.Rel(p.rootDir, includedFile)
+			if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+				logrus.Debugf("skipping requirements file %q included from %q, as located outside of %q", include, foundFile, p.rootDir)
+				continue
+			}
+
+			foundFiles = append(foundFiles, includedFile)
+		}
+
+		relativeFile, err := filepath.Rel(p.rootDir, foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		manifests = append(manifests, p.processDependencies(data.Dependencies, relativeFile, "", false)...)
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
+
+// parseRequirementsFile reads the dependencies pinned with == or ~= in a requirements file,
+// and the files it includes.
+//
+// Lines ending with a backslash are joined with the following ones before being parsed.
+// The environment marker must be declared on the same line as the version to be preserved
+// by the generated target.
+//
+// Requirements pinned with --hash are skipped, as the hashes would still describe the previous
+// version and be rejected by pip. Every requirement is skipped if the file uses --require-hashes.
+func parseRequirementsFile(filePath string) (requirementsFile, error) {
+	var result requirementsFile
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return result, fmt.Errorf("opening %q: %w", filePath, err)
+	}
+	defer f.Close()
+
+	var lines []string
+	var logicalLine strings.Builder
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(requirementCommentRegex.ReplaceAllString(scanner.Text(), ""))
+		continuation := strings.HasSuffix(line, `\`)
+		line = strings.TrimSpace(strings.TrimSuffix(line, `\`))
+
+		if logicalLine.Len() > 0 && line != "" {
+			logicalLine.WriteString(" ")
+		}
+		logicalLine.WriteString(line)
+
+		if continuation {
+			continue
+		}
+
+		lines = append(lines, logicalLine.String())
+		logicalLine.Reset()
+	}
+
+	if err := scanner.Err(); err != nil {
+		return result, fmt.Errorf("reading %q: %w", filePath, err)
+	}
+
+	if logicalLine.Len() > 0 {
+		lines = append(lines, logicalLine.String())
+	}
+
+	requireHashes := false
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "-") {
+			if matches := requirementIncludeRegex.FindStringSubmatch(line); matches != nil {
+				result.Includes = append(result.Includes, matches[1])
+			}
+			if line == "--require-hashes" {
+				requireHashes = true
+			}
+			continue
+		}
+
+		dep, err := parseRequirement(line)
+		if err != nil {
+			logrus.Debugf("skipping requirement %q from %q: %s", line, filePath, err)
+			continue
+		}
+
+		result.Dependencies = append(result.Dependencies, dep)
+	}
+
+	if requireHashes && len(result.Dependencies) > 0 {
+		logrus.Debugf("skipping requirements from %q as it requires hashes", filePath)
+		result.Dependencies = nil
+	}
+
+	return result, nil
+}
+
+// parseRequirement parses a requirement line stripped from its comment.
+func parseRequirement(line string) (pythonDependency, error) {
+	// Per-requirement options, such as --hash, follow the requirement and its marker
+	if idx := strings.Index(line, " --"); idx != -1 {
+		if strings.Contains(line[idx:], "--hash") {
+			return pythonDependency{}, fmt.Errorf("requirement pinned with hashes")
+		}
+		line = line[:idx]
+	}
+
+	requirement, marker, _ := strings.Cut(line, ";")
+	requirement = strings.TrimSpace(requirement)
+	marker = strings.TrimSpace(marker)
+
+	matches := requirementRegex.FindStringSubmatch(requirement)
+	if matches == nil {
+		return pythonDependency{}, fmt.Errorf("could not parse requirement")
+	}
+
+	name := matches[1]
+
+	operator, version, err := parsePinnedVersion(strings.TrimSpace(matches[2]))
+	if err != nil {
+		return pythonDependency{}, err
+	}
+
+	return pythonDependency{
+		Name:         name,
+		Operator:     operator,
+		Version:      version,
+		Marker:       marker,
+		MatchPattern: requirementPattern(name, operator, marker),
+	}, nil
+}
+
+// requirementPattern returns the regular expression matching the version of a requirement line.
+// The first group captures everything preceding the version and the second one everything
+// following it, so comments, extras, markers, and options are preserved.
+//
+// When a marker is defined, only the line declaring the same marker is matched, as a requirement
+// may be pinned to different versions depending on the environment.
+func requirementPattern(name, operator, marker string) string {
+	pattern := fmt.Sprintf(`(?m)^([ \t]*%s[ \t]*(?:\[[^\]\n]*\])?[ \t]*%s[ \t]*)[^\s;#,\\]+`,
+		regexp.QuoteMeta(name), regexp.QuoteMeta(operator))
+
+	if marker != "" {
+		return pattern + fmt.Sprintf(`([ \t]*;[ \t]*%s(?:[ \t\r].*)?)$`, regexp.QuoteMeta(marker))
+	}
+
+	return pattern + `((?:[ \t]+[^;\s].*)?[ \t\r]*)$`
+}
@@ -0,0 +1,169 @@
+package pip
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRequirement(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected pythonDependency
+		wantErr  bool
+	}{
+		{
+			line:     "requests==2.28.0",
+			expected: pythonDependency{Name: "requests", Operator: "==", Version: "2.28.0"},
+		},
+		{
+			line:     "Flask[async] ~= 2.3.2",
+			expected: pythonDependency{Name: "Flask", Operator: "~=", Version: "2.3.2"},
+		},
+		{
+			line:     `numpy==1.24.4; python_version < "3.9" --config-settings=editable_mode=compat`,
+			expected: pythonDependency{Name: "numpy", Operator: "==", Version: "1.24.4", Marker: `python_version < "3.9"`},
+		},
+		{
+			line:    `numpy==1.24.4; python_version < "3.9" --hash=sha256:abc`,
+			wantErr: true,
+		},
+		{
+			line:    "django>=4.2",
+			wantErr: true,
+		},
+		{
+			line:    "django>=4.2,<5.0",
+			wantErr: true,
+		},
+		{
+			line:    "pandas==2.*",
+			wantErr: true,
+		},
+		{
+			line:    "pandas===2.0.0",
+			wantErr: true,
+		},
+		{
+			line:    "mypkg @ https://example.com/mypkg-1.0.0.tar.gz",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			got, err := parseRequirement(tt.line)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			got.MatchPattern = ""
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestRequirementPattern(t *testing.T) {
+	content, err := os.ReadFile("testdata/requirements_project/requirements.txt")
+	require.NoError(t, err)
+
+	data, err := parseRequirementsFile("testdata/requirements_project/requirements.txt")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"common.txt", "constraints.txt"}, data.Includes)
+
+	got := string(content)
+	for _, dep := range data.Dependencies {
+		re, err := regexp.Compile(dep.MatchPattern)
+		require.NoError(t, err)
+		require.Len(t, re.FindAllString(got, -1), 1, "pattern %q must match a single line", dep.MatchPattern)
+		got = re.ReplaceAllString(got, "${1}9.9.9${2}")
+	}
+
+	expected := `# Application dependencies
+-r common.txt
+-c constraints.txt
+--index-url https://pypi.org/simple
+
+requests==9.9.9  # pinned for compatibility
+Flask[async] ~= 9.9.9
+numpy==9.9.9; python_version < "3.9"
+numpy==9.9.9 ; python_version >= "3.9"
+urllib3==2.0.7 \
+    --hash=sha256:c97dfde1f7bd43a71c8d2a58e369e9b2bf692d1334ea9f9cae55add7d0dd0f84 \
+    --hash=sha256:fdb6d215c776278489906c2f8916e6e7d4f5a9b602ccbcfdf7f016fc8da0596e
+idna==3.4 --hash=sha256:814f528e8dead7d329833b91c5faa87d60bf71824cd12a7530b5526063d02cb4
+django>=4.2
+pandas==2.*
+-e ./local-package
+mypkg @ https://example.com/mypkg-1.0.0.tar.gz
+`
+	assert.Equal(t, expected, got)
+}
+
+func TestParseRequirementsFileHashes(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "inline hash",
+			content:  "requests==2.31.0\nidna==3.4 --hash=sha256:abc\n",
+			expected: []string{"requests"},
+		},
+		{
+			name:     "hashes on continuation lines",
+			content:  "urllib3==2.0.7 \\\n    --hash=sha256:abc \\\n    --hash=sha256:def\nrequests==2.31.0\n",
+			expected: []string{"requests"},
+		},
+		{
+			name:     "marker and hash on continuation lines",
+			content:  "numpy==1.24.4 \\\n    ; python_version < \"3.9\" \\\n    --hash=sha256:abc\n",
+			expected: nil,
+		},
+		{
+			name:     "require hashes",
+			content:  "--require-hashes\nrequests==2.31.0\n",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), "requirements.txt")
+			require.NoError(t, os.WriteFile(filePath, []byte(tt.content), 0o600))
+
+			data, err := parseRequirementsFile(filePath)
+			require.NoError(t, err)
+
+			var got []string
+			for _, dep := range data.Dependencies {
+				got = append(got, dep.Name)
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestPipfilePattern(t *testing.T) {
+	content, err := os.ReadFile("testdata/pipfile/Pipfile")
+	require.NoError(t, err)
+
+	got := string(content)
+	for _, p := range []string{pipfilePattern("requests", "=="), pipfilePattern("flask", "~="), pipfilePattern("pytest", "==")} {
+		re, err := regexp.Compile(p)
+		require.NoError(t, err)
+		require.Len(t, re.FindAllString(got, -1), 1, "pattern %q must match a single line", p)
+		got = re.ReplaceAllString(got, "${1}9.9.9${2}")
+	}
+
+	assert.Contains(t, got, `requests = "==9.9.9"`)
+	assert.Contains(t, got, `flask = {version = "~=9.9.9", extras = ["async"]}`)
+	assert.Contains(t, got, `"pytest" = "==9.9.9"`)
+	assert.Contains(t, got, `django = "*"`)
+}
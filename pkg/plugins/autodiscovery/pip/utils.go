@@ -0,0 +1,123 @@
+package pip
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	pipfile     = "Pipfile"
+	pipfileLock = "Pipfile.lock"
+)
+
+// skipDirs lists directories that should never be walked for requirements files or Pipfile.
+var skipDirs = map[string]bool{
+	".venv":        true,
+	"venv":         true,
+	"__pycache__":  true,
+	".git":         true,
+	"node_modules": true,
+	".tox":         true,
+	".nox":         true,
+	".eggs":        true,
+}
+
+// pinnedVersionRegex parses the `==` and `~=` version specifiers handled by the crawlers.
+// Group 1: operator
+// Group 2: version
+//
+// Arbitrary equality (`===`), wildcard versions such as `==2.*`, and multiple specifiers
+// such as `>=2.0,<3.0` are not matched.
+var pinnedVersionRegex = regexp.MustCompile(`^(==|~=)\s*(\d[^\s,;*]*)$`)
+
+// pythonDependency holds a dependency pinned to a specific version.
+type pythonDependency struct {
+	Name     string
+	Operator string // "==" or "~="
+	Version  string // e.g. "2.28.0"
+	// Marker is the environment marker declared on the same line, e.g. `python_version < "3.9"`
+	Marker string
+	// MatchPattern is the regular expression matching the dependency version in its file
+	MatchPattern string
+}
+
+// parsePinnedVersion parses a `==` or `~=` version specifier.
+func parsePinnedVersion(specifier string) (operator, version string, err error) {
+	matches := pinnedVersionRegex.FindStringSubmatch(specifier)
+	if matches == nil {
+		return "", "", fmt.Errorf("version specifier %q isn't pinned with == or ~=", specifier)
+	}
+
+	return matches[1], matches[2], nil
+}
+
+// findFiles walks rootDir recursively and returns absolute paths to every file accepted by
+// isMatchingFile, skipping common non-source directories.
+func findFiles(rootDir string, isMatchingFile func(path string) bool) ([]string, error) {
+	var found []string
+
+	err := filepath.WalkDir(rootDir, func(path string, di fs.DirEntry, err error) error {
+		if err != nil {
+			logrus.Errorf("accessing path %q: %v", path, err)
+			return err
+		}
+
+		if di.IsDir() {
+			if skipDirs[di.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if isMatchingFile(path) {
+			found = append(found, path)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Debugf("%d file(s) found", len(found))
+	for _, f := range found {
+		logrus.Debugf("    * %q", f)
+	}
+
+	return found, nil
+}
+
+// isRequirementsFile reports whether path is a requirements*.txt file, or a *.txt file
+// inside a "requirements" directory.
+func isRequirementsFile(path string) bool {
+	name := filepath.Base(path)
+
+	if match, _ := filepath.Match("requirements*.txt", name); match {
+		return true
+	}
+
+	return filepath.Ext(name) == ".txt" && filepath.Base(filepath.Dir(path)) == "requirements"
+}
+
+// isPipfile reports whether path is a Pipfile.
+func isPipfile(path string) bool {
+	return filepath.Base(path) == pipfile
+}
+
+// isPipenvAvailable reports whether the pipenv CLI is present on PATH.
+func isPipenvAvailable() bool {
+	return exec.Command("pipenv", "--version").Run() == nil
+}
+
+// isLockFileDetected reports whether the given lockfile path exists on disk.
+func isLockFileDetected(lockfile string) bool {
+	_, err := os.Stat(lockfile)
+	return err == nil
+}
@@ -0,0 +1,33 @@
+package branch
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks that a branch exists on the Azure DevOps repository.
+func (a *AzureDevOps) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		logrus.Warningf("scm not supported, ignoring")
+	}
+
+	branch := source
+	if a.spec.Branch != "" {
+		branch = a.spec.Branch
+	}
+
+	branches, err := a.SearchBranches(ctx)
+	if err != nil {
+		return false, "", fmt.Errorf("looking for Azure DevOps branches: %w", err)
+	}
+
+	if !slices.Contains(branches, branch) {
+		return false, fmt.Sprintf("no Azure DevOps branch %q found for repository %s/%s", branch, a.spec.Project, a.spec.Repository), nil
+	}
+
+	return true, fmt.Sprintf("Azure DevOps branch %q found for repository %s/%s", branch, a.spec.Project, a.spec.Repository), nil
+}
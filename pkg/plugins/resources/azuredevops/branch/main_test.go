@@ -0,0 +1,148 @@
+package branch
+
+import (
+	"context"
+	"testing"
+
+	azdogit "github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	azdoclient "github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+)
+
+const mainSHA = "1111111111111111111111111111111111111111"
+
+type mockGitClient struct {
+	azdoclient.GitClient
+	branches []string
+	updates  *[]azdogit.GitRefUpdate
+}
+
+func (m *mockGitClient) GetRepository(ctx context.Context, args azdogit.GetRepositoryArgs) (*azdogit.GitRepository, error) {
+	defaultBranch := "refs/heads/main"
+	return &azdogit.GitRepository{DefaultBranch: &defaultBranch}, nil
+}
+
+func (m *mockGitClient) GetRefs(ctx context.Context, args azdogit.GetRefsArgs) (*azdogit.GetRefsResponseValue, error) {
+	refs := []azdogit.GitRef{}
+	for _, branch := range m.branches {
+		name := "refs/heads/" + branch
+		objectID := mainSHA
+		refs = append(refs, azdogit.GitRef{Name: &name, ObjectId: &objectID})
+	}
+	return &azdogit.GetRefsResponseValue{Value: refs}, nil
+}
+
+func (m *mockGitClient) UpdateRefs(ctx context.Context, args azdogit.UpdateRefsArgs) (*[]azdogit.GitRefUpdateResult, error) {
+	m.updates = args.RefUpdates
+	success := true
+	return &[]azdogit.GitRefUpdateResult{{Success: &success}}, nil
+}
+
+func newTestAzureDevOps(t *testing.T, branches []string, spec map[string]any) (*AzureDevOps, *mockGitClient) {
+	t.Helper()
+
+	manifest := map[string]any{
+		"organization": "updatecli",
+		"project":      "project",
+		"repository":   "repository",
+	}
+	for k, v := range spec {
+		manifest[k] = v
+	}
+
+	a, err := New(manifest)
+	require.NoError(t, err)
+
+	gitClient := &mockGitClient{branches: branches}
+	a.gitClient = gitClient
+
+	return a, gitClient
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(map[string]any{"organization": "updatecli", "project": "project"})
+	require.ErrorContains(t, err, "wrong azure devops configuration")
+}
+
+func TestSource(t *testing.T) {
+	a, _ := newTestAzureDevOps(t, []string{"main", "release/1.0", "release/1.2", "release/1.10"}, map[string]any{
+		"versionfilter": map[string]any{
+			"kind":    "regex/semver",
+			"pattern": "~1",
+			"regex":   `^release/(\d*\.\d*)$`,
+		},
+	})
+
+	gotResult := result.Source{}
+	err := a.Source(context.Background(), "", &gotResult)
+	require.NoError(t, err)
+
+	assert.Equal(t, "release/1.10", gotResult.Information)
+	assert.Equal(t, result.SUCCESS, gotResult.Result)
+}
+
+func TestCondition(t *testing.T) {
+	a, _ := newTestAzureDevOps(t, []string{"main", "develop"}, nil)
+
+	pass, _, err := a.Condition(context.Background(), "develop", nil)
+	require.NoError(t, err)
+	assert.True(t, pass)
+
+	pass, _, err = a.Condition(context.Background(), "unknown", nil)
+	require.NoError(t, err)
+	assert.False(t, pass)
+}
+
+func TestTarget(t *testing.T) {
+	tests := []struct {
+		name        string
+		source      string
+		dryRun      bool
+		wantChanged bool
+		wantUpdate  bool
+	}{
+		{
+			name:   "branch already exists",
+			source: "main",
+		},
+		{
+			name:        "branch should be created",
+			source:      "release/2.0",
+			dryRun:      true,
+			wantChanged: true,
+		},
+		{
+			name:        "branch is created",
+			source:      "release/2.0",
+			wantChanged: true,
+			wantUpdate:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, gitClient := newTestAzureDevOps(t, []string{"main"}, nil)
+
+			gotResult := result.Target{}
+			err := a.Target(context.Background(), tt.source, nil, tt.dryRun, &gotResult)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantChanged, gotResult.Changed)
+			assert.Equal(t, tt.source, gotResult.NewInformation)
+
+			if !tt.wantUpdate {
+				assert.Nil(t, gitClient.updates)
+				return
+			}
+
+			require.NotNil(t, gitClient.updates)
+			require.Len(t, *gitClient.updates, 1)
+			update := (*gitClient.updates)[0]
+			assert.Equal(t, "refs/heads/"+tt.source, *update.Name)
+			assert.Equal(t, mainSHA, *update.NewObjectId)
+			assert.Equal(t, emptyObjectID, *update.OldObjectId)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package branch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Source retrieves the Azure DevOps branch matching the version filter.
+func (a *AzureDevOps) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	branches, err := a.SearchBranches(ctx)
+	if err != nil {
+		return fmt.Errorf("searching Azure DevOps branches: %w", err)
+	}
+
+	if len(branches) == 0 {
+		return errors.New("no Azure DevOps branch found")
+	}
+
+	a.foundVersion, err = a.spec.VersionFilter.Search(branches)
+	if err != nil {
+		switch err {
+		case version.ErrNoVersionFound:
+			return fmt.Errorf("no Azure DevOps branch found matching pattern %q", a.versionFilter.Pattern)
+		default:
+			return fmt.Errorf("filtering Azure DevOps branches: %w", err)
+		}
+	}
+
+	value := a.foundVersion.GetVersion()
+	if value == "" {
+		return fmt.Errorf("no Azure DevOps branch found matching pattern %q", a.versionFilter.Pattern)
+	}
+
+	resultSource.Information = value
+	resultSource.Result = result.SUCCESS
+	resultSource.Description = fmt.Sprintf("Azure DevOps branch %q found matching pattern %q", value, a.versionFilter.Pattern)
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package branch
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	azdogit "github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	azdoclient "github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+)
+
+// emptyObjectID is the object id used by Azure DevOps to create a new reference
+var emptyObjectID = strings.Repeat("0", 40)
+
+// Target ensures that a branch exists on the Azure DevOps repository, otherwise creates it.
+func (a *AzureDevOps) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	if scm != nil {
+		logrus.Warningf("scm not supported, ignoring")
+	}
+
+	branch := source
+	if a.spec.Branch != "" {
+		branch = a.spec.Branch
+	}
+
+	resultTarget.NewInformation = branch
+
+	branches, err := a.SearchBranches(ctx)
+	if err != nil {
+		return fmt.Errorf("looking for Azure DevOps branches: %w", err)
+	}
+
+	if slices.Contains(branches, branch) {
+		resultTarget.Information = branch
+		resultTarget.Result = result.SUCCESS
+		resultTarget.Description = fmt.Sprintf("Azure DevOps branch %q already exists", branch)
+		return nil
+	}
+
+	resultTarget.Result = result.ATTENTION
+	resultTarget.Changed = true
+
+	from := a.spec.From
+	if from == "" {
+		from = "the default branch"
+	}
+
+	if dryRun {
+		resultTarget.Description = fmt.Sprintf("Azure DevOps branch %q should be created from %s", branch, from)
+		return nil
+	}
+
+	gitClient, err := a.getGitClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	commit, err := azdoclient.ResolveCommit(ctx, gitClient, a.spec.Project, a.spec.Repository, a.spec.From)
+	if err != nil {
+		return err
+	}
+
+	refName := azdoclient.BranchRefPrefix + branch
+	updates, err := gitClient.UpdateRefs(ctx, azdogit.UpdateRefsArgs{
+		Project:      &a.spec.Project,
+		RepositoryId: &a.spec.Repository,
+		RefUpdates: &[]azdogit.GitRefUpdate{
+			{
+				Name:        &refName,
+				OldObjectId: &emptyObjectID,
+				NewObjectId: &commit,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("create Azure DevOps branch %q: %w", branch, err)
+	}
+
+	if updates != nil {
+		for _, update := range *updates {
+			if update.Success != nil && !*update.Success {
+				message := ""
+				if update.CustomMessage != nil {
+					message = *update.CustomMessage
+				}
+				return fmt.Errorf("create Azure DevOps branch %q: %s", branch, message)
+			}
+		}
+	}
+
+	resultTarget.Description = fmt.Sprintf("Azure DevOps branch %q created from %s (%s)", branch, from, commit)
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	azdogit "github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+)
+
+// GitClient is the subset of the Azure DevOps git api used to manage branches and tags.
+type GitClient interface {
+	GetRepository(context.Context, azdogit.GetRepositoryArgs) (*azdogit.GitRepository, error)
+	GetRefs(context.Context, azdogit.GetRefsArgs) (*azdogit.GetRefsResponseValue, error)
+	UpdateRefs(context.Context, azdogit.UpdateRefsArgs) (*[]azdogit.GitRefUpdateResult, error)
+	CreateAnnotatedTag(context.Context, azdogit.CreateAnnotatedTagArgs) (*azdogit.GitAnnotatedTag, error)
+}
+
+const (
+	// BranchRefPrefix is the prefix of every Azure DevOps branch reference
+	BranchRefPrefix = "refs/heads/"
+	// TagRefPrefix is the prefix of every Azure DevOps tag reference
+	TagRefPrefix = "refs/tags/"
+)
+
+var commitSHARegex = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+
+// ListRefs returns every reference of a repository starting with prefix, such as "refs/heads/",
+// with the prefix removed. References are returned in the order provided by the api.
+func ListRefs(ctx context.Context, gitClient GitClient, project, repository, prefix string) ([]string, error) {
+	refs, err := getRefs(ctx, gitClient, project, repository, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []string{}
+	for _, ref := range refs {
+		results = append(results, strings.TrimPrefix(stringValue(ref.Name), prefix))
+	}
+
+	return results, nil
+}
+
+// ResolveCommit returns the commit sha referenced by a branch, a tag or a commit sha.
+// An empty ref resolves to the repository default branch.
+func ResolveCommit(ctx context.Context, gitClient GitClient, project, repository, ref string) (string, error) {
+	if commitSHARegex.MatchString(ref) {
+		return ref, nil
+	}
+
+	if ref == "" {
+		repo, err := gitClient.GetRepository(ctx, azdogit.GetRepositoryArgs{
+			Project:      &project,
+			RepositoryId: &repository,
+		})
+		if err != nil {
+			return "", fmt.Errorf("get Azure DevOps repository %s/%s: %w", project, repository, err)
+		}
+
+		if repo == nil || stringValue(repo.DefaultBranch) == "" {
+			return "", fmt.Errorf("no default branch found for Azure DevOps repository %s/%s", project, repository)
+		}
+
+		ref = stringValue(repo.DefaultBranch)
+	}
+
+	candidates := []string{BranchRefPrefix + ref, TagRefPrefix + ref}
+	if strings.HasPrefix(ref, "refs/") {
+		candidates = []string{ref}
+	}
+
+	for _, candidate := range candidates {
+		refs, err := getRefs(ctx, gitClient, project, repository, candidate)
+		if err != nil {
+			return "", err
+		}
+
+		for _, r := range refs {
+			if stringValue(r.Name) != candidate {
+				continue
+			}
+
+			// Annotated tags reference a tag object, the peeled object is the tagged commit
+			if sha := stringValue(r.PeeledObjectId); sha != "" {
+				return sha, nil
+			}
+			return stringValue(r.ObjectId), nil
+		}
+	}
+
+	return "", fmt.Errorf("no branch, tag or commit found matching %q in Azure DevOps repository %s/%s", ref, project, repository)
+}
+
+// getRefs retrieves every reference starting with prefix, following the pagination.
+func getRefs(ctx context.Context, gitClient GitClient, project, repository, prefix string) ([]azdogit.GitRef, error) {
+	// The api filter expects the reference name without the "refs/" prefix
+	filter := strings.TrimPrefix(prefix, "refs/")
+	top := 1000
+	peelTags := true
+
+	results := []azdogit.GitRef{}
+	continuationToken := ""
+	for {
+		args := azdogit.GetRefsArgs{
+			Project:      &project,
+			RepositoryId: &repository,
+			Filter:       &filter,
+			PeelTags:     &peelTags,
+			Top:          &top,
+		}
+		if continuationToken != "" {
+			args.ContinuationToken = &continuationToken
+		}
+
+		refs, err := gitClient.GetRefs(ctx, args)
+		if err != nil {
+			return nil, fmt.Errorf("list Azure DevOps refs matching %q: %w", prefix, err)
+		}
+
+		if refs == nil {
+			break
+		}
+
+		for _, ref := range refs.Value {
+			if strings.HasPrefix(stringValue(ref.Name), prefix) {
+				results = append(results, ref)
+			}
+		}
+
+		if refs.ContinuationToken == "" {
+			break
+		}
+		continuationToken = refs.ContinuationToken
+	}
+
+	return results, nil
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	azdogit "github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockGitClient struct {
+	GitClient
+	defaultBranch string
+	// pages contains the refs returned by each GetRefs call, indexed by continuation token
+	pages map[string]azdogit.GetRefsResponseValue
+}
+
+func (m mockGitClient) GetRepository(ctx context.Context, args azdogit.GetRepositoryArgs) (*azdogit.GitRepository, error) {
+	if m.defaultBranch == "" {
+		return &azdogit.GitRepository{}, nil
+	}
+	return &azdogit.GitRepository{DefaultBranch: &m.defaultBranch}, nil
+}
+
+func (m mockGitClient) GetRefs(ctx context.Context, args azdogit.GetRefsArgs) (*azdogit.GetRefsResponseValue, error) {
+	token := ""
+	if args.ContinuationToken != nil {
+		token = *args.ContinuationToken
+	}
+
+	page, ok := m.pages[token]
+	if !ok {
+		return nil, errors.New("unexpected continuation token")
+	}
+
+	return &page, nil
+}
+
+func newRef(name, objectID, peeledObjectID string) azdogit.GitRef {
+	ref := azdogit.GitRef{Name: &name, ObjectId: &objectID}
+	if peeledObjectID != "" {
+		ref.PeeledObjectId = &peeledObjectID
+	}
+	return ref
+}
+
+const (
+	mainSHA   = "1111111111111111111111111111111111111111"
+	tagSHA    = "2222222222222222222222222222222222222222"
+	taggedSHA = "3333333333333333333333333333333333333333"
+)
+
+func TestListRefs(t *testing.T) {
+	gitClient := mockGitClient{
+		pages: map[string]azdogit.GetRefsResponseValue{
+			"": {
+				Value: []azdogit.GitRef{
+					newRef("refs/tags/v1.0.0", tagSHA, taggedSHA),
+					newRef("refs/heads/main", mainSHA, ""),
+				},
+				ContinuationToken: "next",
+			},
+			"next": {
+				Value: []azdogit.GitRef{
+					newRef("refs/tags/v1.1.0", tagSHA, ""),
+				},
+			},
+		},
+	}
+
+	got, err := ListRefs(context.Background(), gitClient, "project", "repository", TagRefPrefix)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v1.0.0", "v1.1.0"}, got)
+}
+
+func TestResolveCommit(t *testing.T) {
+	gitClient := mockGitClient{
+		defaultBranch: "refs/heads/main",
+		pages: map[string]azdogit.GetRefsResponseValue{
+			"": {
+				Value: []azdogit.GitRef{
+					newRef("refs/heads/main", mainSHA, ""),
+					newRef("refs/heads/main-old", tagSHA, ""),
+					newRef("refs/tags/v1.0.0", tagSHA, taggedSHA),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		ref     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "default branch",
+			want: mainSHA,
+		},
+		{
+			name: "branch",
+			ref:  "main",
+			want: mainSHA,
+		},
+		{
+			name: "annotated tag",
+			ref:  "v1.0.0",
+			want: taggedSHA,
+		},
+		{
+			name: "commit sha",
+			ref:  taggedSHA,
+			want: taggedSHA,
+		},
+		{
+			name:    "unknown reference",
+			ref:     "unknown",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveCommit(context.Background(), gitClient, "project", "repository", tt.ref)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
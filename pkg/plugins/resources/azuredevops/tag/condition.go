@@ -0,0 +1,33 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks that a tag exists on the Azure DevOps repository.
+func (a *AzureDevOps) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		logrus.Warningf("scm not supported, ignoring")
+	}
+
+	tag := source
+	if a.spec.Tag != "" {
+		tag = a.spec.Tag
+	}
+
+	tags, err := a.SearchTags(ctx)
+	if err != nil {
+		return false, "", fmt.Errorf("looking for Azure DevOps tags: %w", err)
+	}
+
+	if !slices.Contains(tags, tag) {
+		return false, fmt.Sprintf("no Azure DevOps tag %q found for repository %s/%s", tag, a.spec.Project, a.spec.Repository), nil
+	}
+
+	return true, fmt.Sprintf("Azure DevOps tag %q found for repository %s/%s", tag, a.spec.Project, a.spec.Repository), nil
+}
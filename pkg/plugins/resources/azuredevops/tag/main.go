@@ -0,0 +1,160 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/sirupsen/logrus"
+	azdoclient "github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/credential"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines settings used to interact with Azure DevOps tags.
+type Spec struct {
+	azdoclient.Spec `yaml:",inline,omitempty"`
+	// [S] "versionfilter" provides parameters to specify version pattern and its type like regex, semver, or just latest.
+	//
+	// remark:
+	//   Azure DevOps returns tags sorted by name, so "latest" returns the last tag alphabetically.
+	VersionFilter version.Filter `yaml:",omitempty"`
+	// [C][T] "tag" defines the tag name.
+	//
+	// default:
+	//   The source output
+	Tag string `yaml:",omitempty"`
+	// [T] "commitish" defines the branch, tag, or commit sha to tag.
+	//
+	// default:
+	//   The repository default branch
+	Commitish string `yaml:",omitempty"`
+	// [T] "message" defines the annotated tag message.
+	//
+	// default:
+	//   "Generated by updatecli"
+	Message string `yaml:",omitempty"`
+}
+
+// AzureDevOps contains information to interact with Azure DevOps tags.
+type AzureDevOps struct {
+	spec Spec
+	// client handles the API authentication and helpers.
+	client azdoclient.Client
+	// gitClient is lazily initialized from client, it can be overridden for testing purposes.
+	gitClient     azdoclient.GitClient
+	foundVersion  version.Version
+	versionFilter version.Filter
+}
+
+// New returns a new valid Azure DevOps tag object.
+func New(spec interface{}) (*AzureDevOps, error) {
+	var s Spec
+	var clientSpec azdoclient.Spec
+
+	// mapstructure.Decode cannot handle embedded fields
+	// hence we decode it in two steps
+	err := mapstructure.Decode(spec, &clientSpec)
+	if err != nil {
+		return &AzureDevOps{}, fmt.Errorf("error decoding client spec: %w", err)
+	}
+
+	err = mapstructure.Decode(spec, &s)
+	if err != nil {
+		return &AzureDevOps{}, fmt.Errorf("error decoding spec: %w", err)
+	}
+
+	usernameFromEnv, tokenFromEnv := credential.GetCredentialsFromEnv()
+	if clientSpec.Username == "" {
+		clientSpec.Username = usernameFromEnv
+	}
+	if clientSpec.Token == "" {
+		clientSpec.Token = tokenFromEnv
+	}
+
+	s.Spec = clientSpec
+	if err = s.Validate(); err != nil {
+		return &AzureDevOps{}, err
+	}
+
+	c, err := azdoclient.New(clientSpec)
+	if err != nil {
+		return &AzureDevOps{}, err
+	}
+
+	s.Spec = c.Spec
+
+	newFilter, err := s.VersionFilter.Init()
+	if err != nil {
+		return &AzureDevOps{}, err
+	}
+	s.VersionFilter = newFilter
+
+	return &AzureDevOps{
+		spec:          s,
+		client:        c,
+		versionFilter: newFilter,
+	}, nil
+}
+
+// Validate validates that a spec contains the required parameters.
+func (s Spec) Validate() error {
+	missingParameters := []string{}
+
+	if s.Project == "" {
+		missingParameters = append(missingParameters, "project")
+	}
+
+	if s.Repository == "" {
+		missingParameters = append(missingParameters, "repository")
+	}
+
+	if len(missingParameters) > 0 {
+		logrus.Errorf("missing parameter(s) [%s]", strings.Join(missingParameters, ","))
+		return fmt.Errorf("wrong azure devops configuration")
+	}
+
+	return s.Spec.Validate()
+}
+
+func (a *AzureDevOps) getGitClient(ctx context.Context) (azdoclient.GitClient, error) {
+	if a.gitClient != nil {
+		return a.gitClient, nil
+	}
+
+	gitClient, err := a.client.NewGitClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create Azure DevOps git client: %w", err)
+	}
+	a.gitClient = gitClient
+
+	return gitClient, nil
+}
+
+// SearchTags retrieves every tag from the Azure DevOps repository.
+func (a *AzureDevOps) SearchTags(ctx context.Context) ([]string, error) {
+	gitClient, err := a.getGitClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return azdoclient.ListRefs(ctx, gitClient, a.spec.Project, a.spec.Repository, azdoclient.TagRefPrefix)
+}
+
+// ReportConfig returns a new configuration object with only the necessary fields
+// to identify the resource without any sensitive information or context specific data.
+func (a *AzureDevOps) ReportConfig() interface{} {
+	return Spec{
+		Spec: azdoclient.Spec{
+			Organization: a.spec.Organization,
+			URL:          a.spec.URL,
+			Project:      a.spec.Project,
+			Repository:   a.spec.Repository,
+		},
+		VersionFilter: a.spec.VersionFilter,
+		Tag:           a.spec.Tag,
+		Commitish:     a.spec.Commitish,
+		Message:       a.spec.Message,
+	}
+}
@@ -0,0 +1,164 @@
+package tag
+
+import (
+	"context"
+	"testing"
+
+	azdogit "github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	azdoclient "github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+)
+
+const developSHA = "4444444444444444444444444444444444444444"
+
+type mockGitClient struct {
+	azdoclient.GitClient
+	tags    []string
+	created *azdogit.GitAnnotatedTag
+}
+
+func (m *mockGitClient) GetRefs(ctx context.Context, args azdogit.GetRefsArgs) (*azdogit.GetRefsResponseValue, error) {
+	refs := []azdogit.GitRef{}
+	for _, tag := range m.tags {
+		name := "refs/tags/" + tag
+		objectID := "5555555555555555555555555555555555555555"
+		refs = append(refs, azdogit.GitRef{Name: &name, ObjectId: &objectID})
+	}
+
+	name := "refs/heads/develop"
+	objectID := developSHA
+	refs = append(refs, azdogit.GitRef{Name: &name, ObjectId: &objectID})
+
+	return &azdogit.GetRefsResponseValue{Value: refs}, nil
+}
+
+func (m *mockGitClient) CreateAnnotatedTag(ctx context.Context, args azdogit.CreateAnnotatedTagArgs) (*azdogit.GitAnnotatedTag, error) {
+	m.created = args.TagObject
+	return args.TagObject, nil
+}
+
+func newTestAzureDevOps(t *testing.T, tags []string, spec map[string]any) (*AzureDevOps, *mockGitClient) {
+	t.Helper()
+
+	manifest := map[string]any{
+		"organization": "updatecli",
+		"project":      "project",
+		"repository":   "repository",
+	}
+	for k, v := range spec {
+		manifest[k] = v
+	}
+
+	a, err := New(manifest)
+	require.NoError(t, err)
+
+	gitClient := &mockGitClient{tags: tags}
+	a.gitClient = gitClient
+
+	return a, gitClient
+}
+
+func TestSource(t *testing.T) {
+	tests := []struct {
+		name    string
+		tags    []string
+		spec    map[string]any
+		want    string
+		wantErr string
+	}{
+		{
+			name: "semver tag",
+			tags: []string{"v1.0.0", "v1.10.0", "v1.2.0", "v2.0.0-rc.1"},
+			spec: map[string]any{
+				"versionfilter": map[string]any{
+					"kind": "semver",
+				},
+			},
+			want: "v1.10.0",
+		},
+		{
+			name:    "no tag",
+			wantErr: "no Azure DevOps tag found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, _ := newTestAzureDevOps(t, tt.tags, tt.spec)
+
+			gotResult := result.Source{}
+			err := a.Source(context.Background(), "", &gotResult)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, gotResult.Information)
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	a, _ := newTestAzureDevOps(t, []string{"v1.0.0"}, map[string]any{"tag": "v1.0.0"})
+
+	pass, _, err := a.Condition(context.Background(), "v9.9.9", nil)
+	require.NoError(t, err)
+	assert.True(t, pass)
+
+	pass, _, err = a.Condition(context.Background(), "", nil)
+	require.NoError(t, err)
+	assert.True(t, pass)
+
+	a, _ = newTestAzureDevOps(t, []string{"v1.0.0"}, nil)
+
+	pass, _, err = a.Condition(context.Background(), "v1.1.0", nil)
+	require.NoError(t, err)
+	assert.False(t, pass)
+}
+
+func TestTarget(t *testing.T) {
+	t.Run("tag already exists", func(t *testing.T) {
+		a, gitClient := newTestAzureDevOps(t, []string{"v1.0.0"}, nil)
+
+		gotResult := result.Target{}
+		err := a.Target(context.Background(), "v1.0.0", nil, false, &gotResult)
+		require.NoError(t, err)
+
+		assert.False(t, gotResult.Changed)
+		assert.Equal(t, result.SUCCESS, gotResult.Result)
+		assert.Nil(t, gitClient.created)
+	})
+
+	t.Run("tag should be created", func(t *testing.T) {
+		a, gitClient := newTestAzureDevOps(t, []string{"v1.0.0"}, nil)
+
+		gotResult := result.Target{}
+		err := a.Target(context.Background(), "v1.1.0", nil, true, &gotResult)
+		require.NoError(t, err)
+
+		assert.True(t, gotResult.Changed)
+		assert.Equal(t, result.ATTENTION, gotResult.Result)
+		assert.Nil(t, gitClient.created)
+	})
+
+	t.Run("tag is created", func(t *testing.T) {
+		a, gitClient := newTestAzureDevOps(t, []string{"v1.0.0"}, map[string]any{
+			"commitish": "develop",
+			"message":   "Release v1.1.0",
+		})
+
+		gotResult := result.Target{}
+		err := a.Target(context.Background(), "v1.1.0", nil, false, &gotResult)
+		require.NoError(t, err)
+
+		assert.True(t, gotResult.Changed)
+		require.NotNil(t, gitClient.created)
+		assert.Equal(t, "v1.1.0", *gitClient.created.Name)
+		assert.Equal(t, "Release v1.1.0", *gitClient.created.Message)
+		assert.Equal(t, developSHA, *gitClient.created.TaggedObject.ObjectId)
+	})
+}
@@ -0,0 +1,43 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Source retrieves the Azure DevOps tag matching the version filter.
+func (a *AzureDevOps) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	tags, err := a.SearchTags(ctx)
+	if err != nil {
+		return fmt.Errorf("searching Azure DevOps tags: %w", err)
+	}
+
+	if len(tags) == 0 {
+		return errors.New("no Azure DevOps tag found")
+	}
+
+	a.foundVersion, err = a.spec.VersionFilter.Search(tags)
+	if err != nil {
+		switch err {
+		case version.ErrNoVersionFound:
+			return fmt.Errorf("no Azure DevOps tag found matching pattern %q", a.versionFilter.Pattern)
+		default:
+			return fmt.Errorf("filtering Azure DevOps tags: %w", err)
+		}
+	}
+
+	value := a.foundVersion.GetVersion()
+	if value == "" {
+		return fmt.Errorf("no Azure DevOps tag found matching pattern %q", a.versionFilter.Pattern)
+	}
+
+	resultSource.Information = value
+	resultSource.Result = result.SUCCESS
+	resultSource.Description = fmt.Sprintf("Azure DevOps tag %q found matching pattern %q", value, a.versionFilter.Pattern)
+
+	return nil
+}
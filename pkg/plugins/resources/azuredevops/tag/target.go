@@ -0,0 +1,86 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	azdogit "github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	azdoclient "github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+)
+
+// Target ensures that a tag exists on the Azure DevOps repository, otherwise creates an annotated tag.
+func (a *AzureDevOps) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	if scm != nil {
+		logrus.Warningf("scm not supported, ignoring")
+	}
+
+	tag := source
+	if a.spec.Tag != "" {
+		tag = a.spec.Tag
+	}
+
+	resultTarget.NewInformation = tag
+
+	tags, err := a.SearchTags(ctx)
+	if err != nil {
+		return fmt.Errorf("looking for Azure DevOps tags: %w", err)
+	}
+
+	if slices.Contains(tags, tag) {
+		resultTarget.Information = tag
+		resultTarget.Result = result.SUCCESS
+		resultTarget.Description = fmt.Sprintf("Azure DevOps tag %q already exists", tag)
+		return nil
+	}
+
+	resultTarget.Result = result.ATTENTION
+	resultTarget.Changed = true
+
+	commitish := a.spec.Commitish
+	if commitish == "" {
+		commitish = "the default branch"
+	}
+
+	if dryRun {
+		resultTarget.Description = fmt.Sprintf("Azure DevOps tag %q should be created on %s", tag, commitish)
+		return nil
+	}
+
+	message := a.spec.Message
+	if message == "" {
+		message = "Generated by updatecli"
+	}
+
+	gitClient, err := a.getGitClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	commit, err := azdoclient.ResolveCommit(ctx, gitClient, a.spec.Project, a.spec.Repository, a.spec.Commitish)
+	if err != nil {
+		return err
+	}
+
+	_, err = gitClient.CreateAnnotatedTag(ctx, azdogit.CreateAnnotatedTagArgs{
+		Project:      &a.spec.Project,
+		RepositoryId: &a.spec.Repository,
+		TagObject: &azdogit.GitAnnotatedTag{
+			Name:    &tag,
+			Message: &message,
+			TaggedObject: &azdogit.GitObject{
+				ObjectId: &commit,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("create Azure DevOps tag %q: %w", tag, err)
+	}
+
+	resultTarget.Description = fmt.Sprintf("Azure DevOps tag %q created on %s (%s)", tag, commitish, commit)
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package branch
+
+import "github.com/updatecli/updatecli/pkg/core/result"
+
+// Changelog returns the changelog for this resource, or an empty string if not supported
+func (g *Bitbucket) Changelog(from, to string) *result.Changelogs {
+	return nil
+}
@@ -0,0 +1,43 @@
+package branch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks that a branch exists on a Bitbucket Cloud repository
+func (g *Bitbucket) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+
+	if scm != nil {
+		logrus.Warningf("scm not supported, ignoring")
+	}
+
+	branch := source
+	if g.spec.Branch != "" {
+		branch = g.spec.Branch
+	}
+
+	branches, err := g.SearchBranches(ctx)
+	if err != nil {
+		return false, "", fmt.Errorf("looking for branches: %w", err)
+	}
+
+	if len(branches) == 0 {
+		return false, fmt.Sprintf("no Bitbucket branch found for repository %s/%s", g.spec.Owner, g.spec.Repository), nil
+	}
+
+	for _, b := range branches {
+		if b == branch {
+			return true, fmt.Sprintf("Bitbucket branch %q found for repository %s/%s", branch, g.spec.Owner, g.spec.Repository), nil
+		}
+	}
+
+	return false, fmt.Sprintf("no Bitbucket branch found matching %q for repository %s/%s",
+		branch,
+		g.spec.Owner,
+		g.spec.Repository,
+	), nil
+}
@@ -0,0 +1,154 @@
+package branch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+func newTestBitbucket(t *testing.T, branches []string, spec map[string]any) *Bitbucket {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2.0/repositories/updatecli/demo/refs/branches" {
+			http.NotFound(w, r)
+			return
+		}
+
+		values := []map[string]string{}
+		for _, branch := range branches {
+			values = append(values, map[string]string{"name": branch, "type": "branch"})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"values": values})
+	}))
+	t.Cleanup(server.Close)
+
+	manifest := map[string]any{
+		"owner":      "updatecli",
+		"repository": "demo",
+	}
+	for k, v := range spec {
+		manifest[k] = v
+	}
+
+	b, err := New(manifest)
+	require.NoError(t, err)
+
+	b.client.BaseURL, err = url.Parse(server.URL + "/")
+	require.NoError(t, err)
+
+	return b
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(map[string]any{"owner": "updatecli"})
+	require.ErrorContains(t, err, "wrong bitbucket configuration")
+}
+
+func TestSource(t *testing.T) {
+	tests := []struct {
+		name            string
+		branches        []string
+		spec            map[string]any
+		wantInformation string
+		wantErr         string
+	}{
+		{
+			name:            "latest branch",
+			branches:        []string{"v1.0.0", "v2.0.0", "v1.1.0"},
+			wantInformation: "v1.1.0",
+		},
+		{
+			name:     "semver branch",
+			branches: []string{"v1.0.0", "v2.0.0-beta.1", "v1.10.0", "v1.2.0"},
+			spec: map[string]any{
+				"versionfilter": map[string]any{
+					"kind":    "semver",
+					"pattern": "~1",
+				},
+			},
+			wantInformation: "v1.10.0",
+		},
+		{
+			name:     "no matching branch",
+			branches: []string{"v1.0.0"},
+			spec: map[string]any{
+				"versionfilter": map[string]any{
+					"kind":    "semver",
+					"pattern": "~3",
+				},
+			},
+			wantErr: "no Bitbucket branch found matching pattern",
+		},
+		{
+			name:    "no branch",
+			wantErr: "no Bitbucket branch found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBitbucket(t, tt.branches, tt.spec)
+
+			gotResult := result.Source{}
+			err := b.Source(context.Background(), "", &gotResult)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantInformation, gotResult.Information)
+			assert.Equal(t, result.SUCCESS, gotResult.Result)
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	tests := []struct {
+		name     string
+		branches []string
+		spec     map[string]any
+		source   string
+		wantPass bool
+	}{
+		{
+			name:     "branch from source exists",
+			branches: []string{"v1.0.0", "v1.1.0"},
+			source:   "v1.0.0",
+			wantPass: true,
+		},
+		{
+			name:     "branch from spec exists",
+			branches: []string{"v1.0.0", "v1.1.0"},
+			spec:     map[string]any{"branch": "v1.1.0"},
+			source:   "v9.9.9",
+			wantPass: true,
+		},
+		{
+			name:     "branch doesn't exist",
+			branches: []string{"v1.0.0"},
+			source:   "v2.0.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBitbucket(t, tt.branches, tt.spec)
+
+			gotPass, _, err := b.Condition(context.Background(), tt.source, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPass, gotPass)
+		})
+	}
+}
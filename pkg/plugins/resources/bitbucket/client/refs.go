@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// refsPage is a page of the Bitbucket Cloud refs API
+type refsPage struct {
+	Values []struct {
+		Name string `json:"name"`
+	} `json:"values"`
+	Next string `json:"next"`
+}
+
+// ListRefs returns the name of every branch or tag, depending on refType, from a Bitbucket Cloud repository
+// sorted from the oldest to the most recently updated one.
+//
+// The go-scm Bitbucket driver ignores the "page" parameter when listing tags,
+// so pagination is done by following the "next" link returned by the API.
+func ListRefs(ctx context.Context, c *scm.Client, owner, repository, refType string) ([]string, error) {
+	query := url.Values{}
+	query.Set("pagelen", "100")
+	query.Set("sort", "target.date")
+
+	path := fmt.Sprintf("2.0/repositories/%s/%s/refs/%s?%s",
+		url.PathEscape(owner),
+		url.PathEscape(repository),
+		refType,
+		query.Encode())
+
+	results := []string{}
+	for path != "" {
+		resp, err := c.Do(ctx, &scm.Request{
+			Method: "GET",
+			Path:   path,
+			Header: map[string][]string{
+				"Accept": {"application/json"},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.Status >= 400 {
+			return nil, fmt.Errorf("bitbucket api returned %d for %s/%s %s: %s", resp.Status, owner, repository, refType, body)
+		}
+
+		page := refsPage{}
+		if err = json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("parsing bitbucket %s: %w", refType, err)
+		}
+
+		for _, v := range page.Values {
+			results = append(results, v.Name)
+		}
+
+		path = page.Next
+	}
+
+	return results, nil
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListRefs(t *testing.T) {
+	tags := []string{"v1.0.0", "v1.1.0", "v1.2.0", "v2.0.0", "v2.1.0"}
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2.0/repositories/updatecli/demo/refs/tags" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"type":"error","error":{"message":"Repository not found"}}`))
+			return
+		}
+
+		assert.Equal(t, "target.date", r.URL.Query().Get("sort"))
+
+		// Serve two tags per page and ignore the requested page length
+		// to exercise the pagination
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		start := min((page-1)*2, len(tags))
+		end := min(start+2, len(tags))
+
+		values := []map[string]string{}
+		for _, tag := range tags[start:end] {
+			values = append(values, map[string]string{"name": tag, "type": "tag"})
+		}
+
+		body := map[string]any{
+			"values":  values,
+			"page":    page,
+			"pagelen": 2,
+			"size":    len(tags),
+		}
+		if end < len(tags) {
+			body["next"] = fmt.Sprintf("%s%s?page=%d&pagelen=2&sort=target.date", server.URL, r.URL.Path, page+1)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	defer server.Close()
+
+	c, err := New(Spec{})
+	require.NoError(t, err)
+	c.BaseURL, err = url.Parse(server.URL + "/")
+	require.NoError(t, err)
+
+	got, err := ListRefs(context.Background(), c, "updatecli", "demo", "tags")
+	require.NoError(t, err)
+	assert.Equal(t, tags, got)
+
+	_, err = ListRefs(context.Background(), c, "updatecli", "unknown", "tags")
+	require.ErrorContains(t, err, "bitbucket api returned 404")
+}
@@ -0,0 +1,43 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks that a tag exists on a Bitbucket Cloud repository
+func (g *Bitbucket) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+
+	if scm != nil {
+		logrus.Warningf("scm not supported, ignoring")
+	}
+
+	tag := source
+	if g.spec.Tag != "" {
+		tag = g.spec.Tag
+	}
+
+	tags, err := g.SearchTags(ctx)
+	if err != nil {
+		return false, "", fmt.Errorf("looking for tags: %w", err)
+	}
+
+	if len(tags) == 0 {
+		return false, fmt.Sprintf("no Bitbucket tag found for repository %s/%s", g.spec.Owner, g.spec.Repository), nil
+	}
+
+	for _, t := range tags {
+		if t == tag {
+			return true, fmt.Sprintf("Bitbucket tag %q found for repository %s/%s", tag, g.spec.Owner, g.spec.Repository), nil
+		}
+	}
+
+	return false, fmt.Sprintf("no Bitbucket tag found matching %q for repository %s/%s",
+		tag,
+		g.spec.Owner,
+		g.spec.Repository,
+	), nil
+}
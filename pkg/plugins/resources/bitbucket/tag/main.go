@@ -0,0 +1,120 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/bitbucket/client"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines settings used to interact with Bitbucket Cloud tags
+type Spec struct {
+	client.Spec `yaml:",inline,omitempty"`
+	// [S][C] Owner specifies repository owner
+	Owner string `yaml:",omitempty" jsonschema:"required"`
+	// [S][C] Repository specifies the name of a repository for a specific owner
+	Repository string `yaml:",omitempty" jsonschema:"required"`
+	// [S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+	VersionFilter version.Filter `yaml:",omitempty"`
+	// [C] Tag specifies the tag name
+	Tag string `yaml:",omitempty"`
+}
+
+// Bitbucket contains information to interact with Bitbucket Cloud api
+type Bitbucket struct {
+	// spec contains inputs coming from updatecli configuration
+	spec Spec
+	// client handle the api authentication
+	client        *scm.Client
+	foundVersion  version.Version
+	versionFilter version.Filter
+}
+
+// New returns a new valid Bitbucket Cloud tag object.
+func New(spec interface{}) (*Bitbucket, error) {
+	var s Spec
+	var clientSpec client.Spec
+
+	// mapstructure.Decode cannot handle embedded fields
+	// hence we decode it in two steps
+	err := mapstructure.Decode(spec, &clientSpec)
+	if err != nil {
+		return &Bitbucket{}, err
+	}
+
+	err = mapstructure.Decode(spec, &s)
+	if err != nil {
+		return &Bitbucket{}, err
+	}
+
+	s.Spec = clientSpec
+
+	err = s.Validate()
+	if err != nil {
+		return &Bitbucket{}, err
+	}
+
+	c, err := client.New(clientSpec)
+	if err != nil {
+		return &Bitbucket{}, err
+	}
+
+	newFilter, err := s.VersionFilter.Init()
+	if err != nil {
+		return &Bitbucket{}, err
+	}
+	s.VersionFilter = newFilter
+
+	g := Bitbucket{
+		spec:          s,
+		client:        c,
+		versionFilter: newFilter,
+	}
+
+	return &g, nil
+}
+
+// SearchTags retrieves tags from a remote Bitbucket Cloud repository
+func (g *Bitbucket) SearchTags(ctx context.Context) ([]string, error) {
+	// Timeout api query after 30sec
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	return client.ListRefs(ctx, g.client, g.spec.Owner, g.spec.Repository, "tags")
+}
+
+func (s Spec) Validate() error {
+	missingParameters := []string{}
+
+	if len(s.Owner) == 0 {
+		missingParameters = append(missingParameters, "owner")
+	}
+
+	if len(s.Repository) == 0 {
+		missingParameters = append(missingParameters, "repository")
+	}
+
+	if len(missingParameters) > 0 {
+		logrus.Errorf("missing parameter(s) [%s]", strings.Join(missingParameters, ","))
+		return fmt.Errorf("wrong bitbucket configuration")
+	}
+
+	return nil
+}
+
+// ReportConfig returns a new configuration object with only the necessary fields
+// to identify the resource without any sensitive information or context specific data.
+func (s *Bitbucket) ReportConfig() interface{} {
+	return Spec{
+		Owner:         s.spec.Owner,
+		Repository:    s.spec.Repository,
+		Tag:           s.spec.Tag,
+		VersionFilter: s.spec.VersionFilter,
+	}
+}
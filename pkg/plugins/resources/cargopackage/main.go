@@ -43,6 +43,8 @@ type PackageVersion struct {
 	Num     string `json:"num,omitempty"`
 	Version string `json:"vers,omitempty"`
 	Yanked  bool   `json:"yanked"`
+	// CreatedAt is the release date, only reported by the crates API
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type PackageCrate struct {
@@ -116,9 +118,13 @@ func (cp *CargoPackage) getVersions(ctx context.Context) (v string, versions []s
 		return "", nil, err
 	}
 
+	releaseDates := make(map[string]time.Time)
 	for _, value := range cp.packageData.Versions {
 		if !value.Yanked {
 			versions = append(versions, value.Num)
+			if !value.CreatedAt.IsZero() {
+				releaseDates[value.Num] = value.CreatedAt
+			}
 		}
 	}
 
@@ -127,7 +133,7 @@ func (cp *CargoPackage) getVersions(ctx context.Context) (v string, versions []s
 		return "", versions, nil
 	}
 	sort.Strings(versions)
-	cp.foundVersion, err = cp.versionFilter.Search(versions)
+	cp.foundVersion, err = cp.versionFilter.SearchWithReleaseDates(versions, releaseDates)
 	if err != nil {
 		return "", nil, err
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/age"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
@@ -169,6 +170,68 @@ func TestSource(t *testing.T) {
 			mockedHeaderFormat:   "Bearer %s",
 			mockedHTTPStatusCode: existingPackageStatus,
 		},
+		{
+			name: "Passing case of retrieving latest crate-test version with a minimum age from a mocked private registry",
+			spec: Spec{
+				Registry: cargo.Registry{
+					URL: "https://crates.io/api/v1/crates",
+					Auth: cargo.InlineKeyChain{
+						Token:        "mytoken",
+						HeaderFormat: "Bearer %s",
+					},
+				},
+				Package: "crate-test",
+				VersionFilter: version.Filter{
+					Kind: "semver",
+					Age:  age.Spec{Minimum: "7d"},
+				},
+			},
+			expectedResult:       "0.2.0",
+			mockedResponse:       true,
+			mockedBody:           existingPackageData,
+			mockedUrl:            "https://crates.io/api/v1/crates",
+			mockedToken:          "mytoken",
+			mockedHeaderFormat:   "Bearer %s",
+			mockedHTTPStatusCode: existingPackageStatus,
+		},
+		{
+			name: "Failing case of retrieving crate-test version still cooling down from a mocked private registry",
+			spec: Spec{
+				Registry: cargo.Registry{
+					URL: "https://crates.io/api/v1/crates",
+					Auth: cargo.InlineKeyChain{
+						Token:        "mytoken",
+						HeaderFormat: "Bearer %s",
+					},
+				},
+				Package: "crate-test",
+				VersionFilter: version.Filter{
+					Kind: "semver",
+					Age:  age.Spec{Minimum: "100y"},
+				},
+			},
+			expectedError:        true,
+			mockedResponse:       true,
+			mockedBody:           existingPackageData,
+			mockedUrl:            "https://crates.io/api/v1/crates",
+			mockedToken:          "mytoken",
+			mockedHeaderFormat:   "Bearer %s",
+			mockedHTTPStatusCode: existingPackageStatus,
+		},
+		{
+			name: "Failing case of using a minimum age with the filesystem index",
+			spec: Spec{
+				Registry: cargo.Registry{
+					RootDir: dir,
+				},
+				Package: "crate-test",
+				VersionFilter: version.Filter{
+					Kind: "semver",
+					Age:  age.Spec{Minimum: "7d"},
+				},
+			},
+			expectedError: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -17,5 +17,6 @@ type Spec struct {
 	// [C] Defines a specific package version
 	Version string `yaml:",omitempty"`
 	// [S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+	// The versionfilter age is only supported by registries exposing the crates API, such as crates.io.
 	VersionFilter version.Filter `yaml:",omitempty"`
 }
@@ -85,12 +85,14 @@ const existingPackageData = `{
   "versions": [
     {
       "crate": "crate-test",
+      "created_at": "2023-01-15T19:00:34.723908+00:00",
       "id": 704063,
       "num": "0.2.0",
       "yanked": false
     },
     {
       "crate": "crate-test",
+      "created_at": "2023-01-12T16:51:06.647066+00:00",
       "id": 701926,
       "num": "0.1.0",
       "yanked": false
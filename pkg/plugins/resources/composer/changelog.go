@@ -0,0 +1,122 @@
+package composer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/result"
+
+	githubChangelog "github.com/updatecli/updatecli/pkg/plugins/changelog/github/v3"
+)
+
+// Changelog returns release notes for the package between the from and to versions.
+// GitHub releases are used when the package source is hosted on GitHub,
+// otherwise Updatecli lists the matching versions with their release date.
+func (c *Composer) Changelog(from, to string) *result.Changelogs {
+	versions, err := c.getVersions(context.Background())
+	if err != nil {
+		logrus.Debugf("retrieving composer package %q versions: %s", c.spec.Name, err)
+		return nil
+	}
+
+	for i := len(versions) - 1; i >= 0; i-- {
+		owner, repository, ok := parseGitHubURL(versions[i].Source.URL)
+		if !ok {
+			continue
+		}
+
+		if releases := changelogFromGitHub(owner, repository, from, to); releases != nil {
+			return releases
+		}
+
+		// Packages are usually tagged with the "v" prefix on GitHub,
+		// while the source output never has it.
+		vfrom, vto := withVPrefix(from), withVPrefix(to)
+		if vfrom != from || vto != to {
+			if releases := changelogFromGitHub(owner, repository, vfrom, vto); releases != nil {
+				return releases
+			}
+		}
+
+		break
+	}
+
+	return c.changelogFromVersions(versions, from, to)
+}
+
+// parseGitHubURL extracts the owner and the repository from a GitHub url
+// such as https://github.com/symfony/console.git
+func parseGitHubURL(rawURL string) (owner, repository string, ok bool) {
+	url := strings.TrimPrefix(rawURL, "https://")
+	url = strings.TrimPrefix(url, "http://")
+	url = strings.TrimPrefix(url, "git@")
+	url = strings.Replace(url, "github.com:", "github.com/", 1)
+	url = strings.TrimPrefix(url, "www.")
+
+	parts := strings.Split(url, "/")
+	if len(parts) < 3 || parts[0] != "github.com" || parts[1] == "" || parts[2] == "" {
+		return "", "", false
+	}
+
+	return parts[1], strings.TrimSuffix(parts[2], ".git"), true
+}
+
+// changelogFromGitHub queries the GitHub release API.
+func changelogFromGitHub(owner, repository, from, to string) *result.Changelogs {
+	changelog := githubChangelog.Changelog{
+		Owner:      owner,
+		Repository: repository,
+	}
+
+	releases, err := changelog.Search(from, to)
+	if err != nil {
+		logrus.Debugf("searching GitHub releases for %s/%s: %s", owner, repository, err)
+	}
+
+	if len(releases) == 0 {
+		return nil
+	}
+
+	return &releases
+}
+
+// changelogFromVersions builds changelog entries for the from and to versions
+// using the metadata published on the Composer repository.
+func (c *Composer) changelogFromVersions(versions []packageVersion, from, to string) *result.Changelogs {
+	var changelogs result.Changelogs
+
+	for _, v := range versions {
+		ver := strings.TrimPrefix(v.Version, "v")
+		if ver != strings.TrimPrefix(from, "v") && ver != strings.TrimPrefix(to, "v") {
+			continue
+		}
+
+		changelog := result.Changelog{
+			Title:       v.Version,
+			PublishedAt: v.Time,
+			URL:         v.Homepage,
+		}
+
+		if c.spec.URL == packagistDefaultURL {
+			changelog.URL = fmt.Sprintf("https://packagist.org/packages/%s#%s", c.spec.Name, v.Version)
+		}
+
+		changelogs = append(changelogs, changelog)
+	}
+
+	if len(changelogs) == 0 {
+		return nil
+	}
+
+	return &changelogs
+}
+
+// withVPrefix prepends "v" if the version string does not already have it.
+func withVPrefix(ver string) string {
+	if ver == "" || strings.HasPrefix(ver, "v") {
+		return ver
+	}
+	return "v" + ver
+}
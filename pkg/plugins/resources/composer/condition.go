@@ -0,0 +1,40 @@
+package composer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks that a package version is published on the Composer repository
+func (c *Composer) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		logrus.Warningf("SCM configuration is not supported for composer condition, aborting")
+	}
+
+	versionToCheck := c.spec.Version
+	if versionToCheck == "" {
+		versionToCheck = source
+	}
+
+	if versionToCheck == "" {
+		return false, "", errors.New("no version defined")
+	}
+
+	versions, err := c.getVersions(ctx)
+	if err != nil {
+		return false, "", err
+	}
+
+	for _, v := range versions {
+		if strings.TrimPrefix(v.Version, "v") == strings.TrimPrefix(versionToCheck, "v") {
+			return true, fmt.Sprintf("composer package %q version %q available", c.spec.Name, versionToCheck), nil
+		}
+	}
+
+	return false, fmt.Sprintf("composer package %q version %q doesn't exist", c.spec.Name, versionToCheck), nil
+}
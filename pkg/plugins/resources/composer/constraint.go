@@ -0,0 +1,126 @@
+package composer
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// stabilityFlagRegex matches Composer stability flags such as "@dev" or "@stable"
+	stabilityFlagRegex = regexp.MustCompile(`@[a-zA-Z]+`)
+	// orSeparatorRegex matches Composer OR separators, "||" or the deprecated "|"
+	orSeparatorRegex = regexp.MustCompile(`\s*\|\|?\s*`)
+	// hyphenRangeRegex matches Composer hyphenated ranges such as "1.0 - 2.0"
+	hyphenRangeRegex = regexp.MustCompile(`^(\S+)\s+-\s+(\S+)$`)
+	// andSeparatorRegex matches Composer AND separators, a comma or whitespaces
+	andSeparatorRegex = regexp.MustCompile(`\s*,\s*|\s+`)
+	// operatorRegex splits a single Composer constraint into its operator and version
+	operatorRegex = regexp.MustCompile(`^(\^|~|>=|<=|==|!=|<>|>|<|=)?\s*v?(\S+)$`)
+)
+
+// ConvertConstraint converts a Composer version constraint, such as "^1.2 || ~2.0",
+// to a semantic versioning constraint understood by the semver version filter.
+//
+// Most Composer operators behave like their semver counterpart, with the notable
+// exception of the tilde operator: "~1.2" means ">=1.2 <2.0" for Composer.
+// A partial upper bound of a hyphenated range is a wildcard: "1.0 - 2.0" means ">=1.0 <2.1".
+func ConvertConstraint(constraint string) (string, error) {
+	constraint = strings.TrimSpace(stabilityFlagRegex.ReplaceAllString(constraint, ""))
+	if constraint == "" {
+		return "", fmt.Errorf("empty version constraint")
+	}
+
+	var orConstraints []string
+	for _, orConstraint := range orSeparatorRegex.Split(constraint, -1) {
+		if orConstraint == "" {
+			return "", fmt.Errorf("invalid version constraint %q", constraint)
+		}
+
+		if matches := hyphenRangeRegex.FindStringSubmatch(orConstraint); matches != nil {
+			upperBound, err := hyphenUpperBound(strings.TrimPrefix(matches[2], "v"))
+			if err != nil {
+				return "", fmt.Errorf("invalid version constraint %q: %w", constraint, err)
+			}
+			orConstraints = append(orConstraints, fmt.Sprintf(">=%s, %s",
+				strings.TrimPrefix(matches[1], "v"),
+				upperBound))
+			continue
+		}
+
+		var andConstraints []string
+		for _, c := range andSeparatorRegex.Split(orConstraint, -1) {
+			converted, err := convertSingleConstraint(c)
+			if err != nil {
+				return "", fmt.Errorf("invalid version constraint %q: %w", constraint, err)
+			}
+			andConstraints = append(andConstraints, converted...)
+		}
+
+		orConstraints = append(orConstraints, strings.Join(andConstraints, ", "))
+	}
+
+	return strings.Join(orConstraints, " || "), nil
+}
+
+// convertSingleConstraint converts a constraint without any logical operator
+func convertSingleConstraint(constraint string) ([]string, error) {
+	matches := operatorRegex.FindStringSubmatch(constraint)
+	if matches == nil {
+		return nil, fmt.Errorf("unsupported constraint %q", constraint)
+	}
+
+	operator, version := matches[1], matches[2]
+
+	switch operator {
+	case "~":
+		upperBound, err := tildeUpperBound(version)
+		if err != nil {
+			return nil, err
+		}
+		return []string{">=" + version, "<" + upperBound}, nil
+	case "==":
+		return []string{"=" + version}, nil
+	case "<>":
+		return []string{"!=" + version}, nil
+	default:
+		return []string{operator + version}, nil
+	}
+}
+
+// tildeUpperBound returns the exclusive upper bound of the Composer tilde operator.
+// "~1.2" allows any version below 2.0, "~1.2.3" any version below 1.3, and "~1" any version below 2.
+func tildeUpperBound(version string) (string, error) {
+	version, _, _ = strings.Cut(version, "-")
+	segments := strings.Split(version, ".")
+
+	if len(segments) > 1 {
+		segments = segments[:len(segments)-1]
+	}
+
+	last, err := strconv.Atoi(segments[len(segments)-1])
+	if err != nil {
+		return "", fmt.Errorf("unsupported constraint \"~%s\"", version)
+	}
+	segments[len(segments)-1] = strconv.Itoa(last + 1)
+
+	return strings.Join(segments, "."), nil
+}
+
+// hyphenUpperBound returns the upper bound of a Composer hyphenated range.
+// A complete version is inclusive, while a partial one, such as "2.0", allows any "2.0.*" version.
+func hyphenUpperBound(version string) (string, error) {
+	segments := strings.Split(version, ".")
+	if len(segments) >= 3 || strings.Contains(version, "-") {
+		return "<=" + version, nil
+	}
+
+	last, err := strconv.Atoi(segments[len(segments)-1])
+	if err != nil {
+		return "", fmt.Errorf("unsupported upper bound %q", version)
+	}
+	segments[len(segments)-1] = strconv.Itoa(last + 1)
+
+	return "<" + strings.Join(segments, "."), nil
+}
@@ -0,0 +1,59 @@
+package composer
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertConstraint(t *testing.T) {
+	tests := []struct {
+		constraint string
+		expected   string
+		match      []string
+		noMatch    []string
+		wantErr    bool
+	}{
+		{constraint: "^1.2", expected: "^1.2", match: []string{"1.2.0", "1.9.9"}, noMatch: []string{"2.0.0", "1.1.0"}},
+		{constraint: "~1.2", expected: ">=1.2, <2", match: []string{"1.2.0", "1.9.0"}, noMatch: []string{"2.0.0"}},
+		{constraint: "~1.2.3", expected: ">=1.2.3, <1.3", match: []string{"1.2.9"}, noMatch: []string{"1.3.0"}},
+		{constraint: "~1", expected: ">=1, <2", match: []string{"1.9.0"}, noMatch: []string{"2.0.0"}},
+		{constraint: ">=1.0 <2.0", expected: ">=1.0, <2.0", match: []string{"1.5.0"}, noMatch: []string{"2.0.0"}},
+		{constraint: ">=1.0,<2.0", expected: ">=1.0, <2.0", match: []string{"1.5.0"}, noMatch: []string{"2.0.0"}},
+		{constraint: "^1.0 || ^2.0", expected: "^1.0 || ^2.0", match: []string{"1.5.0", "2.5.0"}, noMatch: []string{"3.0.0"}},
+		{constraint: "^1.0|^2.0", expected: "^1.0 || ^2.0", match: []string{"2.5.0"}, noMatch: []string{"3.0.0"}},
+		{constraint: "1.0 - 2.0", expected: ">=1.0, <2.1", match: []string{"1.5.0", "2.0.5"}, noMatch: []string{"2.1.0"}},
+		{constraint: "1.0 - 2", expected: ">=1.0, <3", match: []string{"2.9.0"}, noMatch: []string{"3.0.0"}},
+		{constraint: "1.0.0 - 2.1.0", expected: ">=1.0.0, <=2.1.0", match: []string{"2.1.0"}, noMatch: []string{"2.1.1"}},
+		{constraint: "1.2.*", expected: "1.2.*", match: []string{"1.2.5"}, noMatch: []string{"1.3.0"}},
+		{constraint: "v1.2.3", expected: "1.2.3", match: []string{"1.2.3"}, noMatch: []string{"1.2.4"}},
+		{constraint: "^2.0@beta", expected: "^2.0", match: []string{"2.1.0"}},
+		{constraint: "<>1.0", expected: "!=1.0"},
+		{constraint: "", wantErr: true},
+		{constraint: "~x.y", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			got, err := ConvertConstraint(tt.constraint)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+
+			c, err := semver.NewConstraint(got)
+			require.NoError(t, err)
+
+			for _, v := range tt.match {
+				assert.True(t, c.Check(semver.MustParse(v)), "%s should match %s", v, got)
+			}
+			for _, v := range tt.noMatch {
+				assert.False(t, c.Check(semver.MustParse(v)), "%s should not match %s", v, got)
+			}
+		})
+	}
+}
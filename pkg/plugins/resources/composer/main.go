@@ -0,0 +1,174 @@
+package composer
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/sirupsen/logrus"
+
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// packagistDefaultURL is the Packagist Composer repository
+	packagistDefaultURL string = "https://repo.packagist.org"
+)
+
+// Spec defines a specification for a "composer" resource
+// parsed from an updatecli manifest file
+type Spec struct {
+	// Name defines the Composer package name, using the "vendor/package" format
+	//
+	// compatible:
+	//   * source
+	//   * condition
+	//
+	// example: symfony/console
+	Name string `yaml:",omitempty" jsonschema:"required"`
+	// Version defines a specific package version
+	//
+	// compatible:
+	//   * condition
+	//
+	// default: the source output is used when no version is specified
+	Version string `yaml:",omitempty"`
+	// URL defines the Composer repository url, such as a Private Packagist or a Satis repository.
+	// The repository must expose a "packages.json" file at its root.
+	//
+	// compatible:
+	//   * source
+	//   * condition
+	//
+	// default: https://repo.packagist.org
+	URL string `yaml:",omitempty"`
+	// Username defines the username used to authenticate against a private repository using HTTP basic authentication
+	//
+	// compatible:
+	//   * source
+	//   * condition
+	//
+	// remark:
+	//   Private Packagist expects the username "token" and the authentication token as password
+	Username string `yaml:",omitempty"`
+	// Password defines the password used to authenticate against a private repository using HTTP basic authentication
+	//
+	// compatible:
+	//   * source
+	//   * condition
+	Password string `yaml:",omitempty"`
+	// Token defines the bearer token used to authenticate against a private repository
+	//
+	// compatible:
+	//   * source
+	//   * condition
+	Token string `yaml:",omitempty"`
+	// Constraint defines a Composer version constraint, such as "^1.2" or "~2.0 || ^3.0",
+	// that the retrieved version must satisfy.
+	//
+	// compatible:
+	//   * source
+	//
+	// remark:
+	//   Constraint can't be combined with versionfilter. It is converted to a "semver" versionfilter
+	//   that respects the Composer semantic, where "~1.2" means ">=1.2 <2.0".
+	Constraint string `yaml:",omitempty"`
+	// VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+	//
+	// compatible:
+	//   * source
+	//
+	// default: latest
+	VersionFilter version.Filter `yaml:",omitempty"`
+}
+
+// Composer defines a resource of kind "composer"
+type Composer struct {
+	spec Spec
+	// versionFilter holds the "valid" version.filter, that might be different than the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+	foundVersion  version.Version
+	webClient     httpclient.HTTPClient
+}
+
+// New returns a new valid Composer object.
+func New(spec interface{}) (*Composer, error) {
+	var newSpec Spec
+
+	err := mapstructure.Decode(spec, &newSpec)
+	if err != nil {
+		return &Composer{}, err
+	}
+
+	err = newSpec.Validate()
+	if err != nil {
+		return &Composer{}, err
+	}
+
+	if newSpec.URL == "" {
+		newSpec.URL = packagistDefaultURL
+	}
+	newSpec.URL = strings.TrimSuffix(newSpec.URL, "/")
+
+	filter := newSpec.VersionFilter
+	if newSpec.Constraint != "" {
+		pattern, err := ConvertConstraint(newSpec.Constraint)
+		if err != nil {
+			return &Composer{}, err
+		}
+		filter = version.Filter{
+			Kind:    version.SEMVERVERSIONKIND,
+			Pattern: pattern,
+		}
+	}
+
+	newFilter, err := filter.Init()
+	if err != nil {
+		return &Composer{}, err
+	}
+
+	return &Composer{
+		spec:          newSpec,
+		versionFilter: newFilter,
+		webClient:     httpclient.NewRetryClient(),
+	}, nil
+}
+
+// Validate run some validation on the Spec
+func (s *Spec) Validate() error {
+	var errs []error
+
+	if len(s.Name) == 0 {
+		errs = append(errs, errors.New("composer package name not defined"))
+	} else if !strings.Contains(s.Name, "/") {
+		errs = append(errs, errors.New("composer package name must use the format \"vendor/package\""))
+	}
+
+	if s.Constraint != "" && !s.VersionFilter.IsZero() {
+		errs = append(errs, errors.New("constraint and versionfilter are mutually exclusive"))
+	}
+
+	if s.Token != "" && (s.Username != "" || s.Password != "") {
+		errs = append(errs, errors.New("token and username/password are mutually exclusive"))
+	}
+
+	for _, e := range errs {
+		logrus.Errorln(e)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ReportConfig returns a new configuration object with only the necessary fields
+// to identify the resource without any sensitive information or context specific data.
+func (c *Composer) ReportConfig() interface{} {
+	return Spec{
+		Name:          c.spec.Name,
+		Version:       c.spec.Version,
+		URL:           redact.URL(c.spec.URL),
+		Constraint:    c.spec.Constraint,
+		VersionFilter: c.spec.VersionFilter,
+	}
+}
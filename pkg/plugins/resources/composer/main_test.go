@@ -0,0 +1,321 @@
+package composer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// packagistIndex mimics https://repo.packagist.org/packages.json
+const packagistIndex = `{
+  "packages": [],
+  "metadata-url": "/p2/%package%.json"
+}`
+
+// packagistMetadata mimics the minified payload returned by https://repo.packagist.org/p2/<vendor>/<package>.json,
+// where the most recent versions come first and each version only lists the fields that changed.
+const packagistMetadata = `{
+  "minified": "composer/2.0",
+  "packages": {
+    "acme/demo": [
+      {
+        "name": "acme/demo",
+        "version": "v2.0.0-RC1",
+        "time": "2024-03-01T10:00:00+00:00",
+        "homepage": "https://demo.example.com",
+        "source": {"type": "git", "url": "https://gitlab.example.com/acme/demo.git"}
+      },
+      {"version": "v1.10.0", "time": "2024-02-01T10:00:00+00:00"},
+      {"version": "v1.9.2", "time": "2024-01-01T10:00:00+00:00", "homepage": "__unset"},
+      {"version": "v1.2.0", "time": "2023-01-01T10:00:00+00:00"}
+    ]
+  }
+}`
+
+// satisIndex mimics a Satis repository, listing some versions inline and others in an include file
+const satisIndex = `{
+  "packages": {
+    "acme/demo": {
+      "1.9.2": {"name": "acme/demo", "version": "1.9.2"},
+      "dev-main": {"name": "acme/demo", "version": "dev-main"}
+    }
+  },
+  "includes": {
+    "include/all$0123.json": {"sha1": "0123"}
+  }
+}`
+
+const satisInclude = `{
+  "packages": {
+    "acme/demo": {
+      "1.10.0": {"name": "acme/demo", "version": "1.10.0"},
+      "1.x-dev": {"name": "acme/demo", "version": "1.x-dev"}
+    },
+    "acme/other": {
+      "3.0.0": {"name": "acme/other", "version": "3.0.0"}
+    }
+  }
+}`
+
+// newTestServer returns a Composer repository.
+// When authorization is set, requests must send the matching Authorization header.
+func newTestServer(t *testing.T, authorization string) *httptest.Server {
+	t.Helper()
+
+	handle := func(content string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != authorization {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(content))
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/packages.json", handle(packagistIndex))
+	mux.HandleFunc("/p2/acme/demo.json", handle(packagistMetadata))
+	mux.HandleFunc("/satis/packages.json", handle(satisIndex))
+	mux.HandleFunc("/satis/include/all$0123.json", handle(satisInclude))
+
+	return httptest.NewServer(mux)
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Spec{})
+	assert.ErrorContains(t, err, "composer package name not defined")
+
+	_, err = New(Spec{Name: "monolog"})
+	assert.ErrorContains(t, err, "vendor/package")
+
+	_, err = New(map[string]any{
+		"name":          "acme/demo",
+		"constraint":    "^1.0",
+		"versionfilter": map[string]any{"kind": "semver"},
+	})
+	assert.ErrorContains(t, err, "constraint and versionfilter are mutually exclusive")
+
+	_, err = New(Spec{Name: "acme/demo", Token: "secret", Username: "token"})
+	assert.ErrorContains(t, err, "token and username/password are mutually exclusive")
+
+	c, err := New(Spec{Name: "acme/demo"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://repo.packagist.org", c.spec.URL)
+
+	c, err = New(Spec{Name: "acme/demo", URL: "https://repo.packagist.com/acme/", Constraint: "~1.2"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://repo.packagist.com/acme", c.spec.URL)
+	assert.Equal(t, ">=1.2, <2", c.versionFilter.Pattern)
+}
+
+func TestSource(t *testing.T) {
+	tests := []struct {
+		name           string
+		spec           map[string]any
+		path           string
+		authorization  string
+		expectedResult string
+		wantErr        string
+	}{
+		{
+			name:           "latest version ignores prerelease",
+			spec:           map[string]any{"name": "acme/demo"},
+			expectedResult: "1.10.0",
+		},
+		{
+			name: "composer constraint",
+			spec: map[string]any{
+				"name":       "acme/demo",
+				"constraint": "~1.2.0 || ~1.9.0",
+			},
+			expectedResult: "1.9.2",
+		},
+		{
+			name: "semver version filter",
+			spec: map[string]any{
+				"name": "acme/demo",
+				"versionfilter": map[string]any{
+					"kind":    "semver",
+					"pattern": ">=2.0.0-0",
+				},
+			},
+			expectedResult: "2.0.0-RC1",
+		},
+		{
+			name:           "satis repository with includes",
+			spec:           map[string]any{"name": "acme/demo"},
+			path:           "/satis",
+			expectedResult: "1.10.0",
+		},
+		{
+			name: "basic authentication",
+			spec: map[string]any{
+				"name":     "acme/demo",
+				"username": "token",
+				"password": "secret",
+			},
+			authorization:  "Basic dG9rZW46c2VjcmV0",
+			expectedResult: "1.10.0",
+		},
+		{
+			name: "bearer authentication",
+			spec: map[string]any{
+				"name":  "acme/demo",
+				"token": "secret",
+			},
+			authorization:  "Bearer secret",
+			expectedResult: "1.10.0",
+		},
+		{
+			name:          "missing credentials",
+			spec:          map[string]any{"name": "acme/demo"},
+			authorization: "Bearer secret",
+			wantErr:       "unexpected status code 401",
+		},
+		{
+			name:    "unknown package",
+			spec:    map[string]any{"name": "acme/unknown"},
+			wantErr: `package not found: "acme/unknown"`,
+		},
+		{
+			name:    "unknown package in satis repository",
+			spec:    map[string]any{"name": "acme/unknown"},
+			path:    "/satis",
+			wantErr: `package not found: "acme/unknown"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, tt.authorization)
+			defer server.Close()
+
+			tt.spec["url"] = server.URL + tt.path
+			c, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = c.Source(context.Background(), "", &gotResult)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, gotResult.Information)
+			assert.Equal(t, result.SUCCESS, gotResult.Result)
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         map[string]any
+		source       string
+		expectedPass bool
+		wantErr      string
+	}{
+		{
+			name:         "version from source exists",
+			spec:         map[string]any{"name": "acme/demo"},
+			source:       "1.9.2",
+			expectedPass: true,
+		},
+		{
+			name:         "version with v prefix exists",
+			spec:         map[string]any{"name": "acme/demo", "version": "v1.10.0"},
+			expectedPass: true,
+		},
+		{
+			name:   "development branches are ignored",
+			spec:   map[string]any{"name": "acme/demo", "version": "dev-main", "url": "/satis"},
+			source: "",
+		},
+		{
+			name:   "version doesn't exist",
+			spec:   map[string]any{"name": "acme/demo"},
+			source: "3.0.0",
+		},
+		{
+			name:    "no version",
+			spec:    map[string]any{"name": "acme/demo"},
+			wantErr: "no version defined",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, "")
+			defer server.Close()
+
+			path, _ := tt.spec["url"].(string)
+			tt.spec["url"] = server.URL + path
+			c, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotPass, _, err := c.Condition(context.Background(), tt.source, nil)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPass, gotPass)
+		})
+	}
+}
+
+func TestChangelog(t *testing.T) {
+	server := newTestServer(t, "")
+	defer server.Close()
+
+	c, err := New(Spec{Name: "acme/demo", URL: server.URL})
+	require.NoError(t, err)
+
+	got := c.Changelog("1.9.2", "1.10.0")
+	require.NotNil(t, got)
+
+	assert.Equal(t, result.Changelogs{
+		{
+			Title:       "v1.9.2",
+			PublishedAt: "2024-01-01T10:00:00+00:00",
+		},
+		{
+			Title:       "v1.10.0",
+			PublishedAt: "2024-02-01T10:00:00+00:00",
+			URL:         "https://demo.example.com",
+		},
+	}, *got)
+}
+
+func TestParseGitHubURL(t *testing.T) {
+	tests := []struct {
+		url            string
+		expectedOwner  string
+		expectedRepo   string
+		expectedParsed bool
+	}{
+		{url: "https://github.com/symfony/console.git", expectedOwner: "symfony", expectedRepo: "console", expectedParsed: true},
+		{url: "git@github.com:Seldaek/monolog.git", expectedOwner: "Seldaek", expectedRepo: "monolog", expectedParsed: true},
+		{url: "https://gitlab.com/gitlab-org/gitlab"},
+		{url: "https://github.com/symfony"},
+		{url: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			owner, repo, ok := parseGitHubURL(tt.url)
+			assert.Equal(t, tt.expectedParsed, ok)
+			assert.Equal(t, tt.expectedOwner, owner)
+			assert.Equal(t, tt.expectedRepo, repo)
+		})
+	}
+}
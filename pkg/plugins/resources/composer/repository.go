@@ -0,0 +1,262 @@
+package composer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
+)
+
+// errPackageNotFound is returned when the repository doesn't know the package
+var errPackageNotFound = errors.New("package not found")
+
+// repositoryIndex is the "packages.json" file exposed at the root of every Composer repository.
+//
+// Packagist and Private Packagist advertise a "metadata-url" to retrieve each package metadata,
+// while Satis repositories list packages inline, or in files referenced by "includes".
+type repositoryIndex struct {
+	MetadataURL string                     `json:"metadata-url"`
+	Packages    json.RawMessage            `json:"packages"`
+	Includes    map[string]json.RawMessage `json:"includes"`
+}
+
+// inlinePackages lists packages, by name and by version, as found in Satis repositories
+type inlinePackages struct {
+	Packages map[string]map[string]packageVersion `json:"packages"`
+}
+
+// packageMetadata is the package metadata returned by a "metadata-url".
+// When minified, each version only lists the fields that differ from the previous one.
+type packageMetadata struct {
+	Minified string                                  `json:"minified"`
+	Packages map[string][]map[string]json.RawMessage `json:"packages"`
+}
+
+// packageVersion holds the subset of a package version metadata used by Updatecli
+type packageVersion struct {
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Time     string `json:"time"`
+	Homepage string `json:"homepage"`
+	Source   struct {
+		URL string `json:"url"`
+	} `json:"source"`
+}
+
+// unsetValue marks a field removed from a minified package version
+const unsetValue = `"__unset"`
+
+// isDevVersion reports whether a version is a development branch, such as "dev-main" or "2.x-dev"
+func isDevVersion(v string) bool {
+	return strings.HasPrefix(v, "dev-") || strings.HasSuffix(v, "-dev")
+}
+
+// isPrerelease reports whether a version isn't stable, such as "2.0.0-RC1"
+func isPrerelease(v string) bool {
+	return strings.Contains(v, "-")
+}
+
+// getVersions returns the released package versions, ordered from the oldest to the most recent one.
+func (c *Composer) getVersions(ctx context.Context) ([]packageVersion, error) {
+	var index repositoryIndex
+	if err := c.get(ctx, c.resolveURL("packages.json"), &index); err != nil {
+		return nil, fmt.Errorf("retrieving repository index: %w", err)
+	}
+
+	var versions []packageVersion
+	var err error
+
+	switch index.MetadataURL {
+	case "":
+		versions, err = c.getInlineVersions(ctx, index)
+	default:
+		versions, err = c.getMetadataVersions(ctx, index.MetadataURL)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	released := []packageVersion{}
+	for _, v := range versions {
+		if isDevVersion(v.Version) {
+			continue
+		}
+		released = append(released, v)
+	}
+
+	if len(released) == 0 {
+		return nil, fmt.Errorf("%w: %q on %q", errPackageNotFound, c.spec.Name, redact.URL(c.spec.URL))
+	}
+
+	sortVersions(released)
+
+	return released, nil
+}
+
+// getMetadataVersions retrieves the package versions from a "metadata-url"
+func (c *Composer) getMetadataVersions(ctx context.Context, metadataURL string) ([]packageVersion, error) {
+	var metadata packageMetadata
+
+	err := c.get(ctx, c.resolveURL(strings.ReplaceAll(metadataURL, "%package%", c.spec.Name)), &metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []packageVersion
+	current := map[string]json.RawMessage{}
+
+	for _, entry := range metadata.Packages[c.spec.Name] {
+		if metadata.Minified == "" {
+			current = map[string]json.RawMessage{}
+		}
+
+		for k, v := range entry {
+			if string(v) == unsetValue {
+				delete(current, k)
+				continue
+			}
+			current[k] = v
+		}
+
+		data, err := json.Marshal(current)
+		if err != nil {
+			return nil, err
+		}
+
+		var v packageVersion
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, fmt.Errorf("decoding package version: %w", err)
+		}
+
+		versions = append(versions, v)
+	}
+
+	return versions, nil
+}
+
+// getInlineVersions retrieves the package versions listed in the repository index, or in its includes
+func (c *Composer) getInlineVersions(ctx context.Context, index repositoryIndex) ([]packageVersion, error) {
+	var versions []packageVersion
+
+	collect := func(raw json.RawMessage) {
+		var packages inlinePackages
+		// Repositories without any package expose an empty array
+		if err := json.Unmarshal([]byte(fmt.Sprintf(`{"packages": %s}`, raw)), &packages); err != nil {
+			logrus.Debugf("ignoring packages list: %s", err)
+			return
+		}
+		for _, v := range packages.Packages[c.spec.Name] {
+			versions = append(versions, v)
+		}
+	}
+
+	if len(index.Packages) > 0 {
+		collect(index.Packages)
+	}
+
+	// Sorting include paths keeps requests deterministic
+	includes := make([]string, 0, len(index.Includes))
+	for include := range index.Includes {
+		includes = append(includes, include)
+	}
+	sort.Strings(includes)
+
+	for _, include := range includes {
+		var included struct {
+			Packages json.RawMessage `json:"packages"`
+		}
+		if err := c.get(ctx, c.resolveURL(include), &included); err != nil {
+			return nil, fmt.Errorf("retrieving %q: %w", include, err)
+		}
+		if len(included.Packages) > 0 {
+			collect(included.Packages)
+		}
+	}
+
+	return versions, nil
+}
+
+// sortVersions orders versions from the oldest to the most recent one.
+// Versions that can't be parsed are kept first.
+func sortVersions(versions []packageVersion) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		vi, erri := semver.NewVersion(versions[i].Version)
+		vj, errj := semver.NewVersion(versions[j].Version)
+		switch {
+		case erri != nil && errj != nil:
+			return false
+		case erri != nil:
+			return true
+		case errj != nil:
+			return false
+		}
+		return vi.LessThan(vj)
+	})
+}
+
+// resolveURL resolves a path advertised by the repository against the repository url
+func (c *Composer) resolveURL(ref string) string {
+	base, err := url.Parse(c.spec.URL + "/")
+	if err != nil {
+		return ref
+	}
+
+	target, err := url.Parse(ref)
+	if err != nil {
+		return ref
+	}
+
+	return base.ResolveReference(target).String()
+}
+
+// get queries the Composer repository and decodes the json response into data
+func (c *Composer) get(ctx context.Context, URL string, data interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL, nil)
+	if err != nil {
+		return fmt.Errorf("creating request for %q: %w", redact.URL(URL), err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	switch {
+	case c.spec.Token != "":
+		req.Header.Set("Authorization", "Bearer "+c.spec.Token)
+	case c.spec.Username != "" || c.spec.Password != "":
+		req.SetBasicAuth(c.spec.Username, c.spec.Password)
+	}
+
+	res, err := c.webClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("querying %q: %w", redact.URL(URL), err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("reading response from %q: %w", redact.URL(URL), err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %q on %q", errPackageNotFound, c.spec.Name, redact.URL(c.spec.URL))
+	}
+
+	if res.StatusCode >= 400 {
+		logrus.Debugf("\n%v\n", string(body))
+		return fmt.Errorf("querying %q: unexpected status code %d", redact.URL(URL), res.StatusCode)
+	}
+
+	if err := json.Unmarshal(body, data); err != nil {
+		return fmt.Errorf("decoding response from %q: %w", redact.URL(URL), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package composer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Source returns the latest package version matching the version filter
+func (c *Composer) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	packageVersions, err := c.getVersions(ctx)
+	if err != nil {
+		return err
+	}
+
+	versions := []string{}
+	for _, v := range packageVersions {
+		// The latest version kind doesn't know anything about prerelease
+		// so we must discard them ourselves.
+		if c.versionFilter.Kind == version.LATESTVERSIONKIND && isPrerelease(v.Version) {
+			continue
+		}
+		versions = append(versions, v.Version)
+	}
+
+	if len(versions) == 0 {
+		return fmt.Errorf("no version found for composer package %q", c.spec.Name)
+	}
+
+	c.foundVersion, err = c.versionFilter.Search(versions)
+	if err != nil {
+		return err
+	}
+
+	// Composer considers "v1.2.3" and "1.2.3" equivalent, but composer.json
+	// constraints never use the "v" prefix that many tags have.
+	found := strings.TrimPrefix(c.foundVersion.GetVersion(), "v")
+
+	resultSource.Information = found
+	resultSource.Result = result.SUCCESS
+	resultSource.Description = fmt.Sprintf("version %s found for composer package %q", found, c.spec.Name)
+
+	return nil
+}
@@ -3,6 +3,7 @@ package dockerimage
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/google/go-containerregistry/pkg/authn"
@@ -94,22 +95,7 @@ func (di *DockerImage) checkImage(ref name.Reference, arch string) (bool, error)
 	remoteOptions := di.options
 
 	if arch != "" {
-		os := "linux"
-		architecture := arch
-		variant := ""
-
-		splitArchitecture := strings.Split(arch, "/")
-
-		if len(splitArchitecture) > 1 {
-			os = splitArchitecture[0]
-			architecture = splitArchitecture[1]
-		}
-
-		if len(splitArchitecture) > 2 {
-			variant = splitArchitecture[2]
-		}
-
-		platform := v1.Platform{OS: os, Architecture: architecture, Variant: variant}
+		platform := parsePlatform(arch)
 
 		queriedPlatform = platform.String()
 
@@ -148,6 +134,63 @@ func (di *DockerImage) checkImage(ref name.Reference, arch string) (bool, error)
 	return true, nil
 }
 
+// parsePlatform returns the platform defined by an architecture such as "amd64", "linux/arm64",
+// or "linux/arm/v7". The operating system defaults to linux.
+func parsePlatform(arch string) v1.Platform {
+	os := "linux"
+	architecture := arch
+	variant := ""
+
+	splitArchitecture := strings.Split(arch, "/")
+
+	if len(splitArchitecture) > 1 {
+		os = splitArchitecture[0]
+		architecture = splitArchitecture[1]
+	}
+
+	if len(splitArchitecture) > 2 {
+		variant = splitArchitecture[2]
+	}
+
+	return v1.Platform{OS: os, Architecture: architecture, Variant: variant}
+}
+
+// minimumReleaseDate is the date before which an image creation date is considered unknown.
+var minimumReleaseDate = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// releaseDate returns the creation date of an image tag, as reported by the image configuration.
+// For a multi-platform image, the image of the first architecture is used, linux/amd64 by default.
+func (di *DockerImage) releaseDate(tag string) (time.Time, error) {
+	ref, err := di.createRef(tag)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	remoteOptions := di.options
+	if len(di.spec.Architectures) > 0 {
+		remoteOptions = append(remoteOptions, remote.WithPlatform(parsePlatform(di.spec.Architectures[0])))
+	}
+
+	img, err := remote.Image(ref, remoteOptions...)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("retrieving image %q: %w", ref.Name(), err)
+	}
+
+	config, err := img.ConfigFile()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("retrieving image %q configuration: %w", ref.Name(), err)
+	}
+
+	// Reproducible builds, such as ko, distroless, or nix images, set the creation date to the
+	// Unix epoch, which would always satisfy a minimum age
+	if config.Created.Time.Before(minimumReleaseDate) {
+		logrus.Debugf("image %q creation date %s is not a release date", ref.Name(), config.Created.Time)
+		return time.Time{}, nil
+	}
+
+	return config.Created.Time, nil
+}
+
 // ReportConfig returns a new configuration with only the necessary configuration fields
 // to identify the resource without any sensitive information or context specific data.
 func (di *DockerImage) ReportConfig() interface{} {
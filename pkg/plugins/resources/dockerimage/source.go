@@ -34,7 +34,7 @@ func (di *DockerImage) Source(_ context.Context, workingDir string, resultSource
 		tags = di.filterTags(tags)
 	}
 
-	di.foundVersion, err = di.versionFilter.Search(tags)
+	di.foundVersion, err = di.versionFilter.SearchWithReleaseDateFunc(tags, di.releaseDate)
 	if err != nil {
 		return fmt.Errorf("filtering tags: %w", err)
 	}
@@ -0,0 +1,99 @@
+package dockerimage
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/age"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestSourceWithAge(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+
+	image := strings.TrimPrefix(server.URL, "http://") + "/updatecli/test"
+
+	now := time.Now()
+	for tag, created := range map[string]time.Time{
+		"1.0.0": now.Add(-90 * 24 * time.Hour),
+		"1.1.0": now.Add(-20 * 24 * time.Hour),
+		"1.2.0": now.Add(-2 * 24 * time.Hour),
+		// Reproducible builds use the Unix epoch as creation date
+		"1.3.0": time.Unix(0, 0),
+	} {
+		img, err := random.Image(64, 1)
+		require.NoError(t, err)
+
+		img, err = mutate.CreatedAt(img, v1.Time{Time: created})
+		require.NoError(t, err)
+
+		ref, err := name.ParseReference(image + ":" + tag)
+		require.NoError(t, err)
+
+		require.NoError(t, remote.Write(ref, img))
+	}
+
+	tests := []struct {
+		name           string
+		age            age.Spec
+		expectedError  error
+		expectedResult string
+	}{
+		{
+			name:           "No age filter",
+			expectedResult: "1.3.0",
+		},
+		{
+			name:           "Minimum age",
+			age:            age.Spec{Minimum: "7d"},
+			expectedResult: "1.1.0",
+		},
+		{
+			name:           "Age window",
+			age:            age.Spec{Minimum: "7d", Maximum: "60d"},
+			expectedResult: "1.1.0",
+		},
+		{
+			name:          "Every version cooling down",
+			age:           age.Spec{Minimum: "1y"},
+			expectedError: version.ErrNoVersionMatchingAge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			di, err := New(Spec{
+				Image: image,
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "*",
+					Age:     tt.age,
+				},
+			})
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = di.Source(context.Background(), "", &gotResult)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, gotResult.Information)
+		})
+	}
+}
@@ -67,6 +67,12 @@ type Spec struct {
 	//
 	// default:
 	//   kind: latest
+	//
+	// remark:
+	//   The versionfilter age relies on the image creation date, which is retrieved for each
+	//   candidate tag until one matches. Images created before 2000, such as reproducible builds
+	//   using the Unix epoch, have no known release date and are ignored. Images created at a
+	//   fixed date, such as the one of their last commit, may still look older than they are.
 	VersionFilter version.Filter `yaml:",omitempty"`
 	// tagfilter allows to restrict tags retrieved from a remote registry by using a regular expression.
 	//
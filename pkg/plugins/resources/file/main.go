@@ -1,9 +1,12 @@
 package file
 
 import (
+	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 
+	"github.com/bmatcuk/doublestar/v4"
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/result"
@@ -34,6 +37,16 @@ type Spec struct {
 	//   remarks:
 	//       * `files` is incompatible with `file`
 	//       * feel free to look at searchpattern attribute to search for files matching a pattern
+	//       * unless searchpattern is set, a file path can be a glob pattern supporting `**`
+	//         such as `**/README.md` or `docs/**/*.adoc`, matched relative to the scm working directory.
+	//         A glob pattern must match at least one file, and can't match files outside of the scm working directory.
+	//       * a file path containing glob characters, such as `pages/[id].tsx`, is used as is when the file exists,
+	//         it's only evaluated as a glob pattern otherwise.
+	//
+	//   example:
+	//       files:
+	//         - "**/README.md"
+	//         - "docs/**/*.adoc"
 	Files []string `yaml:",omitempty"`
 	//   `line` contains the line of the file(s) to manipulate
 	//
@@ -208,15 +221,33 @@ func (f *File) initFiles(workDir string) error {
 		var foundFiles []string
 		var err error
 
-		switch f.spec.SearchPattern {
-		case true:
+		switch {
+		case f.spec.SearchPattern:
 			foundFiles, err = utils.FindFilesMatchingPathPattern(workDir, specFile)
 			if err != nil {
-				return fmt.Errorf("unable to find files matching %q: %s", f.spec.File, err)
+				return fmt.Errorf("unable to find files matching %q: %s", specFile, err)
 			}
 
-		case false:
-			foundFiles = append(foundFiles, f.spec.Files...)
+		// A path containing glob characters is used literally if the file exists,
+		// such as "pages/[id].tsx", otherwise it's evaluated as a glob pattern.
+		case isGlobPattern(specFile) && !isExistingFile(workDir, specFile):
+			if !doublestar.ValidatePattern(filepath.ToSlash(strings.TrimPrefix(specFile, "file://"))) {
+				return fmt.Errorf("file %q doesn't exist and isn't a valid glob pattern", specFile)
+			}
+
+			foundFiles, err = findFilesMatchingGlob(workDir, specFile)
+			switch {
+			case errors.Is(err, errNoGlobMatch) && f.spec.ForceCreate:
+				// The file will be created using its literal path
+				foundFiles = []string{specFile}
+			case err != nil:
+				return fmt.Errorf("unable to find files matching %q: %w", specFile, err)
+			case f.spec.Line != 0:
+				return fmt.Errorf("the attributes `spec.line` and glob patterns in `spec.files` are mutually exclusive, %q matches %d file(s)", specFile, len(foundFiles))
+			}
+
+		default:
+			foundFiles = append(foundFiles, specFile)
 		}
 
 		for _, filePath := range foundFiles {
@@ -89,6 +89,37 @@ func Test_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Passing case with 'Files' containing a glob pattern",
+			spec: Spec{
+				Files: []string{
+					"**/README.md",
+					"docs/**/*.{md,adoc}",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			// The file may exist with this literal name, so the pattern is only checked when resolving files
+			name: "Passing case with 'Files' containing an invalid glob pattern",
+			spec: Spec{
+				Files: []string{
+					"docs/[*.md",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			// The file may exist with this literal name, so the pattern is only checked when resolving files
+			name: "Passing case with 'Files' containing a glob pattern and 'Line' specified",
+			spec: Spec{
+				Files: []string{
+					"pages/[id].tsx",
+				},
+				Line: 12,
+			},
+			wantErr: false,
+		},
 		{
 			name: "Validation failure with 'Line' negative",
 			spec: Spec{
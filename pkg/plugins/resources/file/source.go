@@ -50,6 +50,10 @@ func (f *File) Source(_ context.Context, workingDir string, resultSource *result
 		return fmt.Errorf("init files: %w", err)
 	}
 
+	if len(f.files) > 1 && !f.spec.SearchPattern {
+		return fmt.Errorf("validation error in source of type 'file': the glob pattern %q matches more than one file", f.spec.Files[0])
+	}
+
 	if err := f.Read(); err != nil {
 		return fmt.Errorf("reading file: %w", err)
 	}
@@ -151,3 +151,51 @@ func TestFile_TargetTemplatePathContainment(t *testing.T) {
 	gotErr := f.Target(context.Background(), "", mockSCM, false, &result.Target{})
 	assert.Error(t, gotErr, "reading a template outside the working directory must be rejected")
 }
+
+// TestFile_TargetGlobPathContainment ensures that a glob pattern in spec.files
+// can't match files outside of the SCM working directory.
+func TestFile_TargetGlobPathContainment(t *testing.T) {
+	baseDir := t.TempDir()
+	workingDir := filepath.Join(baseDir, "checkout", "nested")
+	require.NoError(t, os.MkdirAll(workingDir, 0o700))
+
+	secretPath := filepath.Join(baseDir, "secret.txt")
+	require.NoError(t, os.WriteFile(secretPath, []byte("TOP SECRET"), 0o600))
+
+	tests := []struct {
+		name    string
+		pattern string
+	}{
+		{
+			name:    "absolute glob pattern is rejected",
+			pattern: filepath.Join(baseDir, "*.txt"),
+		},
+		{
+			name:    "dot dot traversal glob pattern is rejected",
+			pattern: filepath.Join("..", "..", "*.txt"),
+		},
+		{
+			name:    "dot dot traversal after a doublestar is rejected",
+			pattern: filepath.Join("**", "..", "..", "..", "*.txt"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := New(Spec{
+				Files:   []string{tt.pattern},
+				Content: "PWNED",
+			})
+			require.NoError(t, err)
+
+			mockSCM := &scm.MockScm{WorkingDir: workingDir}
+
+			gotErr := f.Target(context.Background(), "", mockSCM, false, &result.Target{})
+			assert.Error(t, gotErr, "a glob pattern escaping the working directory must be rejected")
+
+			content, err := os.ReadFile(secretPath)
+			require.NoError(t, err)
+			assert.Equal(t, "TOP SECRET", string(content))
+		})
+	}
+}
@@ -1123,3 +1123,178 @@ jdk_version = "11.0.12"`,
 		})
 	}
 }
+
+func TestFile_TargetGlob(t *testing.T) {
+	workingDir := t.TempDir()
+
+	files := map[string]string{
+		"README.md":                  "version: 1.0.0",
+		"charts/app/README.md":       "version: 1.0.0",
+		"docs/guide/install.adoc":    "version: 1.0.0",
+		"docs/guide/install.md":      "version: 1.0.0",
+		"docs/reference/index.adoc":  "version: 2.0.0",
+		"vendor/module/CHANGELOG.md": "version: 1.0.0",
+	}
+	for file, content := range files {
+		path := filepath.Join(workingDir, filepath.FromSlash(file))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	f, err := New(Spec{
+		Files: []string{
+			"**/README.md",
+			"docs/**/*.adoc",
+		},
+		MatchPattern:   `version: .*`,
+		ReplacePattern: "version: 2.0.0",
+	})
+	require.NoError(t, err)
+
+	gotResult := result.Target{}
+	err = f.Target(context.Background(), "", &scm.MockScm{WorkingDir: workingDir}, false, &gotResult)
+	require.NoError(t, err)
+
+	assert.True(t, gotResult.Changed)
+	assert.Equal(t, []string{
+		filepath.Join(workingDir, "README.md"),
+		filepath.Join(workingDir, "charts", "app", "README.md"),
+		filepath.Join(workingDir, "docs", "guide", "install.adoc"),
+	}, gotResult.Files)
+
+	for file, expectedContent := range map[string]string{
+		"README.md":                  "version: 2.0.0",
+		"charts/app/README.md":       "version: 2.0.0",
+		"docs/guide/install.adoc":    "version: 2.0.0",
+		"docs/guide/install.md":      "version: 1.0.0",
+		"vendor/module/CHANGELOG.md": "version: 1.0.0",
+	} {
+		content, err := os.ReadFile(filepath.Join(workingDir, filepath.FromSlash(file)))
+		require.NoError(t, err)
+		assert.Equal(t, expectedContent, string(content), file)
+	}
+
+	// A glob pattern must match at least one file
+	f, err = New(Spec{
+		Files:   []string{"**/*.rst"},
+		Content: "version: 2.0.0",
+	})
+	require.NoError(t, err)
+
+	err = f.Target(context.Background(), "", &scm.MockScm{WorkingDir: workingDir}, false, &result.Target{})
+	assert.Error(t, err)
+}
+
+func TestFile_TargetLiteralPathWithGlobCharacters(t *testing.T) {
+	workingDir := t.TempDir()
+
+	files := map[string]string{
+		"pages/[id].tsx":                "version: 1.0.0",
+		"pages/i.tsx":                   "version: 1.0.0",
+		"{{cookiecutter}}/setup.py":     "version: 1.0.0",
+		"app/[...slug]/page.tsx":        "version: 1.0.0",
+		"app/[...slug]/nested/page.tsx": "version: 1.0.0",
+	}
+	for file, content := range files {
+		path := filepath.Join(workingDir, filepath.FromSlash(file))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	f, err := New(Spec{
+		Files: []string{
+			"pages/[id].tsx",
+			"{{cookiecutter}}/setup.py",
+			"app/[...slug]/page.tsx",
+		},
+		MatchPattern:   `version: .*`,
+		ReplacePattern: "version: 2.0.0",
+	})
+	require.NoError(t, err)
+
+	gotResult := result.Target{}
+	err = f.Target(context.Background(), "", &scm.MockScm{WorkingDir: workingDir}, false, &gotResult)
+	require.NoError(t, err)
+
+	assert.True(t, gotResult.Changed)
+	assert.ElementsMatch(t, []string{
+		filepath.Join(workingDir, "pages", "[id].tsx"),
+		filepath.Join(workingDir, "{{cookiecutter}}", "setup.py"),
+		filepath.Join(workingDir, "app", "[...slug]", "page.tsx"),
+	}, gotResult.Files)
+
+	for file, expectedContent := range map[string]string{
+		"pages/[id].tsx":                "version: 2.0.0",
+		"pages/i.tsx":                   "version: 1.0.0",
+		"{{cookiecutter}}/setup.py":     "version: 2.0.0",
+		"app/[...slug]/page.tsx":        "version: 2.0.0",
+		"app/[...slug]/nested/page.tsx": "version: 1.0.0",
+	} {
+		content, err := os.ReadFile(filepath.Join(workingDir, filepath.FromSlash(file)))
+		require.NoError(t, err)
+		assert.Equal(t, expectedContent, string(content), file)
+	}
+
+	// A literal path can be combined with line
+	f, err = New(Spec{
+		Files:   []string{"pages/[id].tsx"},
+		Line:    1,
+		Content: "version: 3.0.0",
+	})
+	require.NoError(t, err)
+
+	err = f.Target(context.Background(), "", &scm.MockScm{WorkingDir: workingDir}, false, &result.Target{})
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(workingDir, "pages", "[id].tsx"))
+	require.NoError(t, err)
+	assert.Equal(t, "version: 3.0.0\n", string(content))
+
+	// A missing file is created using its literal path
+	f, err = New(Spec{
+		Files:       []string{"pages/[slug].tsx"},
+		Content:     "version: 1.0.0",
+		ForceCreate: true,
+	})
+	require.NoError(t, err)
+
+	err = f.Target(context.Background(), "", &scm.MockScm{WorkingDir: workingDir}, false, &result.Target{})
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(workingDir, "pages", "[slug].tsx"))
+}
+
+func TestFile_TargetGlobErrors(t *testing.T) {
+	workingDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(workingDir, "README.md"), []byte("version: 1.0.0"), 0o600))
+
+	tests := []struct {
+		name string
+		spec Spec
+	}{
+		{
+			name: "invalid glob pattern",
+			spec: Spec{
+				Files:   []string{"docs/[*.md"},
+				Content: "version: 2.0.0",
+			},
+		},
+		{
+			name: "glob pattern with line",
+			spec: Spec{
+				Files:   []string{"**/README.md"},
+				Line:    1,
+				Content: "version: 2.0.0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := New(tt.spec)
+			require.NoError(t, err)
+
+			err = f.Target(context.Background(), "", &scm.MockScm{WorkingDir: workingDir}, false, &result.Target{})
+			assert.Error(t, err)
+		})
+	}
+}
@@ -1,8 +1,15 @@
 package file
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/updatecli/updatecli/pkg/plugins/utils"
 )
 
 // isBinaryContent returns true if the content appears to be binary data.
@@ -12,3 +19,83 @@ func isBinaryContent(content string) bool {
 	contentType := http.DetectContentType([]byte(content))
 	return !strings.HasPrefix(contentType, "text/")
 }
+
+// errNoGlobMatch is returned when a glob pattern doesn't match any file
+var errNoGlobMatch = errors.New("no file matching the glob pattern")
+
+// isGlobPattern returns true if the file path contains glob pattern characters
+func isGlobPattern(filePath string) bool {
+	if strings.HasPrefix(filePath, "https://") || strings.HasPrefix(filePath, "http://") {
+		return false
+	}
+	return strings.ContainsAny(filePath, "*?[{")
+}
+
+// isExistingFile returns true if the file path exists as is, relative to the working directory if provided.
+// It allows file names containing glob pattern characters, such as "pages/[id].tsx", to be used literally.
+func isExistingFile(workDir, filePath string) bool {
+	filePath = strings.TrimPrefix(filePath, "file://")
+
+	if workDir != "" {
+		securePath, err := utils.SanitizeFilePathWithWorkingDirectory(filePath, workDir)
+		if err != nil {
+			return false
+		}
+		filePath = securePath
+	}
+
+	info, err := os.Stat(filePath)
+	return err == nil && !info.IsDir()
+}
+
+// findFilesMatchingGlob returns the files matching a doublestar glob pattern such as "docs/**/*.adoc".
+// The pattern is evaluated from the working directory, or from the current directory if no working directory
+// is provided, and must not escape the working directory.
+func findFilesMatchingGlob(workDir, pattern string) ([]string, error) {
+	pattern = strings.TrimPrefix(pattern, "file://")
+
+	// Reject any pattern escaping the working directory before walking the filesystem
+	if _, err := utils.SanitizeFilePathWithWorkingDirectory(pattern, workDir); err != nil {
+		return nil, err
+	}
+
+	rootDir := workDir
+	if rootDir == "" {
+		var err error
+		rootDir, err = os.Getwd()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get current working directory: %w", err)
+		}
+	}
+
+	// The static part of the pattern, such as "docs" in "docs/**/*.adoc",
+	// is used as the root directory of the glob evaluation.
+	base, globPattern := doublestar.SplitPattern(filepath.ToSlash(filepath.Clean(pattern)))
+	base = filepath.FromSlash(base)
+
+	globRootDir := base
+	if !filepath.IsAbs(base) {
+		globRootDir = filepath.Join(rootDir, base)
+	}
+
+	matches, err := doublestar.Glob(
+		os.DirFS(globRootDir),
+		globPattern,
+		doublestar.WithFilesOnly(),
+		doublestar.WithNoFollow(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("%w %q", errNoGlobMatch, pattern)
+	}
+
+	files := make([]string, 0, len(matches))
+	for _, match := range matches {
+		files = append(files, filepath.Join(base, filepath.FromSlash(match)))
+	}
+
+	return files, nil
+}
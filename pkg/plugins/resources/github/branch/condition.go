@@ -0,0 +1,33 @@
+package branch
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks that a branch exists on the GitHub repository.
+func (g *GitHub) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		logrus.Warningf("scm not supported, ignoring")
+	}
+
+	branch := source
+	if g.spec.Branch != "" {
+		branch = g.spec.Branch
+	}
+
+	branches, err := g.ghHandler.SearchBranches(ctx, 0)
+	if err != nil {
+		return false, "", fmt.Errorf("searching GitHub branches: %w", err)
+	}
+
+	if !slices.Contains(branches, branch) {
+		return false, fmt.Sprintf("GitHub branch %q not found on %s/%s", branch, g.spec.Owner, g.spec.Repository), nil
+	}
+
+	return true, fmt.Sprintf("GitHub branch %q found on %s/%s", branch, g.spec.Owner, g.spec.Repository), nil
+}
@@ -0,0 +1,59 @@
+package branch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/github"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+type mockGhHandler struct {
+	github.Github
+	branches []string
+}
+
+func (m *mockGhHandler) SearchBranches(_ context.Context, retry int) ([]string, error) {
+	return m.branches, nil
+}
+
+func TestSource(t *testing.T) {
+	versionFilter, err := version.Filter{
+		Kind:    version.REGEXSEMVERVERSIONKIND,
+		Pattern: "*",
+		Regex:   `^release/(\d+\.\d+)$`,
+	}.Init()
+	require.NoError(t, err)
+
+	g := GitHub{
+		ghHandler:     &mockGhHandler{branches: []string{"main", "release/1.10", "release/1.2", "release/1.9"}},
+		versionFilter: versionFilter,
+	}
+
+	gotResult := result.Source{}
+	err = g.Source(context.Background(), "", &gotResult)
+	require.NoError(t, err)
+	assert.Equal(t, "release/1.10", gotResult.Information)
+
+	g.ghHandler = &mockGhHandler{}
+	err = g.Source(context.Background(), "", &result.Source{})
+	require.Error(t, err)
+}
+
+func TestCondition(t *testing.T) {
+	g := GitHub{
+		ghHandler: &mockGhHandler{branches: []string{"main", "develop"}},
+	}
+
+	pass, _, err := g.Condition(context.Background(), "develop", nil)
+	require.NoError(t, err)
+	assert.True(t, pass)
+
+	g.spec.Branch = "feature"
+	pass, _, err = g.Condition(context.Background(), "develop", nil)
+	require.NoError(t, err)
+	assert.False(t, pass)
+}
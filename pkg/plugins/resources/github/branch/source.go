@@ -0,0 +1,43 @@
+package branch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// Source retrieves the latest branch matching the version filter using the GitHub API.
+func (g *GitHub) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	branches, err := g.ghHandler.SearchBranches(ctx, 0)
+	if err != nil {
+		return fmt.Errorf("searching GitHub branches: %w", err)
+	}
+
+	if len(branches) == 0 {
+		return errors.New("no GitHub branch found")
+	}
+
+	g.foundVersion, err = g.versionFilter.Search(branches)
+	if err != nil {
+		return fmt.Errorf("filtering GitHub branches: %w", err)
+	}
+
+	value := g.foundVersion.GetVersion()
+	if value == "" {
+		return fmt.Errorf("no GitHub branch found matching pattern %q of kind %q",
+			g.versionFilter.Pattern,
+			g.versionFilter.Kind,
+		)
+	}
+
+	resultSource.Result = result.SUCCESS
+	resultSource.Information = value
+	resultSource.Description = fmt.Sprintf("GitHub branch %q found matching pattern %q of kind %q",
+		value,
+		g.versionFilter.Pattern,
+		g.versionFilter.Kind)
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package tag
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/github"
+)
+
+// Changelog returns a link to the GitHub comparison between both tags
+func (g *GitHub) Changelog(from, to string) *result.Changelogs {
+	changelog, err := github.CompareChangelog(g.url, g.spec.Owner, g.spec.Repository, from, to)
+	if err != nil {
+		logrus.Debugf("ignored error, generating changelog: %s", err)
+		return nil
+	}
+	return changelog
+}
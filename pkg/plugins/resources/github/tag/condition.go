@@ -0,0 +1,33 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks that a git tag exists on the GitHub repository.
+func (g *GitHub) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		logrus.Warningf("scm not supported, ignoring")
+	}
+
+	tag := source
+	if g.spec.Tag != "" {
+		tag = g.spec.Tag
+	}
+
+	tags, err := g.ghHandler.SearchTags(ctx, 0)
+	if err != nil {
+		return false, "", fmt.Errorf("searching GitHub tags: %w", err)
+	}
+
+	if !slices.Contains(tags, tag) {
+		return false, fmt.Sprintf("GitHub tag %q not found on %s/%s", tag, g.spec.Owner, g.spec.Repository), nil
+	}
+
+	return true, fmt.Sprintf("GitHub tag %q found on %s/%s", tag, g.spec.Owner, g.spec.Repository), nil
+}
@@ -0,0 +1,138 @@
+package tag
+
+import (
+	"fmt"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/github"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/github/app"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines a specification for a "github/tag" resource
+// parsed from an updatecli manifest file
+type Spec struct {
+	// owner defines repository owner to interact with.
+	//
+	// required: true
+	//
+	// compatible:
+	//  * source
+	//  * condition
+	//
+	Owner string `yaml:",omitempty" jsonschema:"required"`
+	// repository defines the repository name to interact with.
+	//
+	// required: true
+	//
+	// compatible:
+	//  * source
+	//  * condition
+	//
+	Repository string `yaml:",omitempty" jsonschema:"required"`
+	// token defines the GitHub personal access token used to authenticate with.
+	//
+	// more information on https://docs.github.com/en/authentication/keeping-your-account-and-data-secure/managing-your-personal-access-tokens
+	//
+	// compatible:
+	//  * source
+	//  * condition
+	//
+	Token string `yaml:",omitempty"`
+	// URL defines the default github url in case of GitHub enterprise.
+	//
+	// default: https://github.com
+	//
+	// compatible:
+	//  * source
+	//  * condition
+	URL string `yaml:",omitempty"`
+	// username defines the username used to authenticate with GitHub API.
+	//
+	// compatible:
+	//  * source
+	//  * condition
+	Username string `yaml:",omitempty"`
+	// versionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+	//
+	// default: latest
+	//
+	// compatible:
+	//  * source
+	//
+	VersionFilter version.Filter `yaml:",omitempty"`
+	// tag defines the git tag to look for.
+	//
+	// default: source input
+	//
+	// compatible:
+	//   * condition
+	//
+	Tag string `yaml:",omitempty"`
+	// "app" specifies the GitHub App credentials used to authenticate with GitHub API.
+	// It is not compatible with the "token" and "username" fields.
+	// It is recommended to use the GitHub App authentication method for better security and granular permissions.
+	App *app.Spec `yaml:",omitempty"`
+}
+
+// GitHub defines a resource of kind "github/tag"
+type GitHub struct {
+	ghHandler     github.GithubHandler
+	versionFilter version.Filter
+	foundVersion  version.Version
+	spec          Spec
+	// url contains the GitHub base url used to generate compare links
+	url string
+}
+
+// New returns a new valid GitHub tag object.
+func New(spec interface{}) (*GitHub, error) {
+	newSpec := Spec{}
+
+	err := mapstructure.Decode(spec, &newSpec)
+	if err != nil {
+		return &GitHub{}, err
+	}
+
+	if newSpec.Owner == "" || newSpec.Repository == "" {
+		return &GitHub{}, fmt.Errorf("wrong github/tag configuration, \"owner\" and \"repository\" are required")
+	}
+
+	newHandler, err := github.New(github.Spec{
+		Owner:      newSpec.Owner,
+		Repository: newSpec.Repository,
+		Token:      newSpec.Token,
+		URL:        newSpec.URL,
+		Username:   newSpec.Username,
+		App:        newSpec.App,
+	}, "")
+	if err != nil {
+		return &GitHub{}, err
+	}
+
+	newFilter, err := newSpec.VersionFilter.Init()
+	if err != nil {
+		return &GitHub{}, err
+	}
+
+	return &GitHub{
+		ghHandler:     newHandler,
+		spec:          newSpec,
+		versionFilter: newFilter,
+		url:           newHandler.URL,
+	}, nil
+}
+
+// ReportConfig returns a new configuration object with only the necessary fields
+// to identify the resource without any sensitive information
+// and context specific data.
+func (g *GitHub) ReportConfig() interface{} {
+	return Spec{
+		Owner:         g.spec.Owner,
+		Repository:    g.spec.Repository,
+		VersionFilter: g.spec.VersionFilter,
+		URL:           redact.URL(g.spec.URL),
+		Tag:           g.spec.Tag,
+	}
+}
@@ -0,0 +1,127 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/github"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+type mockGhHandler struct {
+	github.Github
+	tags   []string
+	tagErr error
+}
+
+func (m *mockGhHandler) SearchTags(_ context.Context, retry int) ([]string, error) {
+	return m.tags, m.tagErr
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(map[string]any{"owner": "updatecli"})
+	require.Error(t, err)
+
+	got, err := New(map[string]any{
+		"owner":      "updatecli",
+		"repository": "updatecli",
+		"url":        "https://github.example.com",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.example.com", got.url)
+}
+
+func TestSource(t *testing.T) {
+	tests := []struct {
+		name          string
+		handler       *mockGhHandler
+		versionFilter version.Filter
+		want          string
+		wantErr       bool
+	}{
+		{
+			name:    "latest tag",
+			handler: &mockGhHandler{tags: []string{"v1.0.0", "v2.0.0-rc.1", "v1.1.0"}},
+			want:    "v1.1.0",
+		},
+		{
+			name:    "semver tag",
+			handler: &mockGhHandler{tags: []string{"v1.0.0", "v2.0.0-rc.1", "v1.10.0", "v1.2.0"}},
+			versionFilter: version.Filter{
+				Kind:    version.SEMVERVERSIONKIND,
+				Pattern: "~1",
+			},
+			want: "v1.10.0",
+		},
+		{
+			name:    "no tag",
+			handler: &mockGhHandler{},
+			wantErr: true,
+		},
+		{
+			name:    "api error",
+			handler: &mockGhHandler{tagErr: errors.New("api error")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versionFilter, err := tt.versionFilter.Init()
+			require.NoError(t, err)
+
+			g := GitHub{
+				ghHandler:     tt.handler,
+				versionFilter: versionFilter,
+			}
+
+			gotResult := result.Source{}
+			err = g.Source(context.Background(), "", &gotResult)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, gotResult.Information)
+			assert.Equal(t, result.SUCCESS, gotResult.Result)
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	g := GitHub{
+		ghHandler: &mockGhHandler{tags: []string{"v1.0.0", "v1.1.0"}},
+	}
+
+	pass, _, err := g.Condition(context.Background(), "v1.1.0", nil)
+	require.NoError(t, err)
+	assert.True(t, pass)
+
+	pass, _, err = g.Condition(context.Background(), "v2.0.0", nil)
+	require.NoError(t, err)
+	assert.False(t, pass)
+
+	g.spec.Tag = "v1.0.0"
+	pass, _, err = g.Condition(context.Background(), "v2.0.0", nil)
+	require.NoError(t, err)
+	assert.True(t, pass)
+}
+
+func TestChangelog(t *testing.T) {
+	g := GitHub{
+		spec: Spec{Owner: "updatecli", Repository: "updatecli"},
+		url:  "https://github.com",
+	}
+
+	got := g.Changelog("v1.0.0", "v1.1.0")
+	require.NotNil(t, got)
+	require.Len(t, *got, 1)
+	assert.Equal(t, "https://github.com/updatecli/updatecli/compare/v1.0.0...v1.1.0", (*got)[0].URL)
+
+	assert.Nil(t, g.Changelog("", "v1.1.0"))
+}
@@ -0,0 +1,43 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// Source retrieves the latest git tag matching the version filter using the GitHub API.
+func (g *GitHub) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	tags, err := g.ghHandler.SearchTags(ctx, 0)
+	if err != nil {
+		return fmt.Errorf("searching GitHub tags: %w", err)
+	}
+
+	if len(tags) == 0 {
+		return errors.New("no GitHub tag found")
+	}
+
+	g.foundVersion, err = g.versionFilter.Search(tags)
+	if err != nil {
+		return fmt.Errorf("filtering GitHub tags: %w", err)
+	}
+
+	value := g.foundVersion.GetVersion()
+	if value == "" {
+		return fmt.Errorf("no GitHub tag found matching pattern %q of kind %q",
+			g.versionFilter.Pattern,
+			g.versionFilter.Kind,
+		)
+	}
+
+	resultSource.Result = result.SUCCESS
+	resultSource.Information = value
+	resultSource.Description = fmt.Sprintf("GitHub tag %q found matching pattern %q of kind %q",
+		value,
+		g.versionFilter.Pattern,
+		g.versionFilter.Kind)
+
+	return nil
+}
@@ -3,9 +3,11 @@ package githubrelease
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
 // Source retrieves a specific version tag name, tag hash, or release title from GitHub Releases.
@@ -17,14 +19,21 @@ func (gr *GitHubRelease) Source(ctx context.Context, workingDir string, resultSo
 	}
 
 	var versions []string
+	releaseDates := make(map[string]time.Time)
 	for _, release := range releaseRefs {
 		versions = append(versions, release.TagName)
+		releaseDates[release.TagName] = release.PublishedAt.Time
 	}
 	if len(versions) == 0 {
 		switch gr.spec.TypeFilter.IsZero() {
 		case true:
 			logrus.Warningf("%s No GitHub Release found, we fallback to published git tags", result.ATTENTION)
 
+			// Git tags don't report any release date
+			if !gr.versionFilter.Age.IsZero() {
+				return fmt.Errorf("searching git tag: %w", version.ErrAgeFilterNotSupported)
+			}
+
 			versions, err = gr.ghHandler.SearchTags(ctx, 0)
 			if err != nil {
 				return fmt.Errorf("searching git tag: %w", err)
@@ -37,7 +46,7 @@ func (gr *GitHubRelease) Source(ctx context.Context, workingDir string, resultSo
 		}
 	}
 
-	gr.foundVersion, err = gr.versionFilter.Search(versions)
+	gr.foundVersion, err = gr.versionFilter.SearchWithReleaseDates(versions, releaseDates)
 	if err != nil {
 		return fmt.Errorf("filtering github release version: %w", err)
 	}
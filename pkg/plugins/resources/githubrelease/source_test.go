@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/github"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/age"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
@@ -142,6 +145,34 @@ func TestGitHubRelease_Source(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "3 releases found, filter with latest and minimum age",
+			mockedGhHandler: &mockGhHandler{
+				releases: []github.ReleaseNode{
+					{TagName: "1.0.0", PublishedAt: githubv4.DateTime{Time: time.Now().Add(-30 * 24 * time.Hour)}},
+					{TagName: "2.0.0", PublishedAt: githubv4.DateTime{Time: time.Now().Add(-10 * 24 * time.Hour)}},
+					{TagName: "3.0.0", PublishedAt: githubv4.DateTime{Time: time.Now().Add(-time.Hour)}},
+				},
+			},
+			versionFilter: version.Filter{
+				Kind:    "latest",
+				Pattern: "latest",
+				Age:     age.Spec{Minimum: "7d"},
+			},
+			wantValue: "2.0.0",
+		},
+		{
+			name: "Error: 0 releases found, 3 tags found, filter with minimum age",
+			mockedGhHandler: &mockGhHandler{
+				tags: []string{"1.0.0", "2.0.0", "3.0.0"},
+			},
+			versionFilter: version.Filter{
+				Kind:    "latest",
+				Pattern: "latest",
+				Age:     age.Spec{Minimum: "7d"},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
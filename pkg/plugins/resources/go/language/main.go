@@ -3,6 +3,7 @@ package language
 import (
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/age"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
@@ -26,6 +27,10 @@ func New(spec interface{}) (*Language, error) {
 		return nil, err
 	}
 
+	// The age filter relies on the git repository tags, so it's handled by the resource
+	newSpec.Age = age.Resolve(newSpec.Age, newSpec.VersionFilter.Age)
+	newSpec.VersionFilter.Age = age.Spec{}
+
 	newFilter := newSpec.VersionFilter
 	if newFilter.IsZero() {
 		// By default, golang versioning uses semantic versioning
@@ -6,6 +6,7 @@ import (
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/age"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
@@ -37,6 +38,10 @@ func New(spec interface{}) (*GoModule, error) {
 		return nil, err
 	}
 
+	// The age filter is applied per proxy, so it's handled by the resource
+	newSpec.Age = age.Resolve(newSpec.Age, newSpec.VersionFilter.Age)
+	newSpec.VersionFilter.Age = age.Spec{}
+
 	newFilter := newSpec.VersionFilter
 	if newSpec.VersionFilter.IsZero() {
 		logrus.Debugln("no versioning filtering specified, fallback to semantic versioning")
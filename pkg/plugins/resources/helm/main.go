@@ -148,6 +148,7 @@ type Spec struct {
 
 		remark:
 			* Helm chart uses semver by default.
+			* versionfilter age relies on the "created" date of the repository index, it's not supported for OCI registries.
 	*/
 	VersionFilter version.Filter `yaml:",omitempty"`
 	/*
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"helm.sh/helm/v3/pkg/repo"
@@ -37,11 +38,13 @@ func (c *Chart) Source(ctx context.Context, workingDir string, resultSource *res
 	}
 
 	versions := []string{}
+	releaseDates := make(map[string]time.Time)
 	for i := len(entriesVersion) - 1; i >= 0; i-- {
 		versions = append(versions, entriesVersion[i].Version)
+		releaseDates[entriesVersion[i].Version] = entriesVersion[i].Created
 	}
 
-	c.foundVersion, err = c.versionFilter.Search(versions)
+	c.foundVersion, err = c.versionFilter.SearchWithReleaseDates(versions, releaseDates)
 	if err != nil {
 		return fmt.Errorf("filtering version: %w", err)
 	}
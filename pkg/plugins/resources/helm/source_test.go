@@ -3,11 +3,17 @@ package helm
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/age"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
 // TestSource is an integration tests that test that retrieving source value effectively works
@@ -93,3 +99,73 @@ func TestSource(t *testing.T) {
 		})
 	}
 }
+
+func TestSourceWithAge(t *testing.T) {
+	now := time.Now().UTC()
+
+	index := fmt.Sprintf(`apiVersion: v1
+entries:
+  proxy:
+    - name: proxy
+      version: 1.2.0
+      created: %q
+    - name: proxy
+      version: 1.1.0
+      created: %q
+    - name: proxy
+      version: 1.0.0
+      created: %q
+`,
+		now.Add(-2*24*time.Hour).Format(time.RFC3339),
+		now.Add(-20*24*time.Hour).Format(time.RFC3339),
+		now.Add(-90*24*time.Hour).Format(time.RFC3339),
+	)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.yaml"), []byte(index), 0o600))
+
+	tests := []struct {
+		name          string
+		age           age.Spec
+		expected      string
+		expectedError error
+	}{
+		{
+			name:     "No age filter",
+			expected: "1.2.0",
+		},
+		{
+			name:     "Minimum age",
+			age:      age.Spec{Minimum: "7d"},
+			expected: "1.1.0",
+		},
+		{
+			name:          "Every version cooling down",
+			age:           age.Spec{Minimum: "1y"},
+			expectedError: version.ErrNoVersionMatchingAge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chart, err := New(Spec{
+				URL:  "file://" + dir,
+				Name: "proxy",
+				VersionFilter: version.Filter{
+					Age: tt.age,
+				},
+			})
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = chart.Source(context.Background(), "", &gotResult)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, gotResult.Information)
+		})
+	}
+}
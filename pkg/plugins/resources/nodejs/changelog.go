@@ -0,0 +1,36 @@
+package nodejs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
+)
+
+// Changelog returns a link to the Node.js release announcement and changelog
+func (n *Nodejs) Changelog(from, to string) *result.Changelogs {
+	if to == "" {
+		return nil
+	}
+
+	toVersion, err := semver.NewVersion(strings.TrimPrefix(to, "v"))
+	if err != nil {
+		logrus.Errorf("failing parsing to version %q - %q", to, err)
+		return nil
+	}
+
+	url := fmt.Sprintf("https://nodejs.org/en/blog/release/v%s", toVersion.String())
+	changelogURL := fmt.Sprintf("https://github.com/nodejs/node/blob/main/doc/changelogs/CHANGELOG_V%d.md#%s", toVersion.Major(), toVersion.String())
+
+	return &result.Changelogs{
+		{
+			Title: toVersion.String(),
+			Body: fmt.Sprintf("Node.js release announcement for version %q is available on %q, and the full changelog on %q",
+				toVersion.String(), redact.URL(url), redact.URL(changelogURL)),
+			URL: url,
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package nodejs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+func TestChangelog(t *testing.T) {
+	tests := []struct {
+		name           string
+		from           string
+		to             string
+		expectedResult *result.Changelogs
+	}{
+		{
+			name: "Test new version",
+			from: "22.10.0",
+			to:   "v22.11.0",
+			expectedResult: &result.Changelogs{
+				{
+					Title: "22.11.0",
+					Body:  "Node.js release announcement for version \"22.11.0\" is available on \"https://nodejs.org/en/blog/release/v22.11.0\", and the full changelog on \"https://github.com/nodejs/node/blob/main/doc/changelogs/CHANGELOG_V22.md#22.11.0\"",
+					URL:   "https://nodejs.org/en/blog/release/v22.11.0",
+				},
+			},
+		},
+		{
+			name:           "Test without input",
+			expectedResult: nil,
+		},
+	}
+
+	n, err := New(Spec{})
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedResult, n.Changelog(tt.from, tt.to))
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package nodejs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks if a specific Node.js version is published and matches the lts, codename, and age parameters
+func (n *Nodejs) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		logrus.Debugln("scm is not supported")
+	}
+
+	versionToCheck := n.spec.Version
+	if versionToCheck == "" {
+		versionToCheck = source
+	}
+	if len(versionToCheck) == 0 {
+		return false, "", fmt.Errorf("no version defined")
+	}
+
+	if _, err := n.versions(ctx); err != nil {
+		return false, "", fmt.Errorf("searching Node.js version: %w", err)
+	}
+
+	if _, ok := n.releases[strings.TrimPrefix(versionToCheck, "v")]; ok {
+		return true, fmt.Sprintf("Node.js version %q available", versionToCheck), nil
+	}
+
+	return false, fmt.Sprintf("Node.js version %q doesn't exist or doesn't match the resource parameters", versionToCheck), nil
+}
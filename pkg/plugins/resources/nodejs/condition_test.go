@@ -0,0 +1,66 @@
+package nodejs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCondition(t *testing.T) {
+	tests := []struct {
+		name           string
+		spec           Spec
+		source         string
+		expectedResult bool
+		expectedError  bool
+	}{
+		{
+			name:           "existing version",
+			spec:           Spec{Version: "22.10.0"},
+			expectedResult: true,
+		},
+		{
+			name:           "existing version from source input with a v prefix",
+			source:         "v22.10.0",
+			expectedResult: true,
+		},
+		{
+			name:           "version not part of an LTS line",
+			spec:           Spec{Version: "22.10.0", LTS: true},
+			expectedResult: false,
+		},
+		{
+			name:           "version part of the named LTS line",
+			spec:           Spec{Version: "20.17.0", Codename: "Iron"},
+			expectedResult: true,
+		},
+		{
+			name:           "non existing version",
+			spec:           Spec{Version: "1.0.0"},
+			expectedResult: false,
+		},
+		{
+			name:          "no version",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := New(tt.spec)
+			require.NoError(t, err)
+			n.webClient = getMockClient(releaseIndexData, http.StatusOK)
+
+			gotResult, _, err := n.Condition(context.Background(), tt.source, nil)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, gotResult)
+		})
+	}
+}
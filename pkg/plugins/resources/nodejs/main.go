@@ -0,0 +1,79 @@
+package nodejs
+
+import (
+	"fmt"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/age"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// nodejsDefaultIndexURL is the url of the official Node.js release index
+	nodejsDefaultIndexURL string = "https://nodejs.org/dist/index.json"
+)
+
+// Nodejs defines a resource of kind "nodejs"
+type Nodejs struct {
+	spec Spec
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+	foundVersion  version.Version
+	// releases holds the releases matching the spec, indexed by version
+	releases  map[string]release
+	webClient httpclient.HTTPClient
+}
+
+// New returns a reference to a newly initialized Nodejs object from a Spec
+// or an error if the provided Spec triggers a validation error.
+func New(spec interface{}) (*Nodejs, error) {
+	newSpec := Spec{}
+
+	err := mapstructure.Decode(spec, &newSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	// The age filter is applied while listing releases, so it also applies to conditions
+	newSpec.Age = age.Resolve(newSpec.Age, newSpec.VersionFilter.Age)
+	newSpec.VersionFilter.Age = age.Spec{}
+
+	if err := newSpec.Age.Validate(); err != nil {
+		return nil, fmt.Errorf("wrong age spec %v", err)
+	}
+
+	if newSpec.URL == "" {
+		newSpec.URL = nodejsDefaultIndexURL
+	}
+
+	newFilter := newSpec.VersionFilter
+	if newFilter.IsZero() {
+		// By default, Node.js versioning uses semantic versioning
+		newFilter.Kind = "semver"
+		newFilter.Pattern = "*"
+	}
+
+	newFilter, err = newFilter.Init()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Nodejs{
+		spec:          newSpec,
+		versionFilter: newFilter,
+		webClient:     httpclient.NewRetryClient(),
+	}, nil
+}
+
+// ReportConfig returns a new configuration without any sensitive information or context specific information.
+func (n *Nodejs) ReportConfig() interface{} {
+	return Spec{
+		Version:       n.spec.Version,
+		LTS:           n.spec.LTS,
+		Codename:      n.spec.Codename,
+		URL:           n.spec.URL,
+		VersionFilter: n.spec.VersionFilter,
+		Age:           n.spec.Age,
+	}
+}
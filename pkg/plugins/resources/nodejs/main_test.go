@@ -0,0 +1,85 @@
+package nodejs
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/age"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// releaseIndexData is a trimmed down Node.js release index, the most recent release
+// being published today so it can be used to test the age filtering.
+var releaseIndexData = fmt.Sprintf(`[
+{"version":"v23.2.0","date":%q,"files":["linux-x64"],"npm":"10.9.0","lts":false,"security":false},
+{"version":"v23.1.0","date":"2024-10-24","files":["linux-x64"],"npm":"10.9.0","lts":false,"security":false},
+{"version":"v22.11.0","date":"2024-10-29","files":["linux-x64"],"npm":"10.9.0","lts":"Jod","security":false},
+{"version":"v22.10.0","date":"2024-10-16","files":["linux-x64"],"npm":"10.9.0","lts":false,"security":false},
+{"version":"v20.18.0","date":"2024-10-03","files":["linux-x64"],"npm":"10.8.2","lts":"Iron","security":false},
+{"version":"v20.17.0","date":"2024-08-21","files":["linux-x64"],"npm":"10.8.2","lts":"Iron","security":false},
+{"version":"v18.20.4","date":"2024-07-08","files":["linux-x64"],"npm":"10.7.0","lts":"Hydrogen","security":true}
+]`, time.Now().Format(time.DateOnly))
+
+func getMockClient(body string, statusCode int) *httpclient.MockClient {
+	return &httpclient.MockClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.URL.String() != nodejsDefaultIndexURL {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       io.NopCloser(strings.NewReader("")),
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: statusCode,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    Spec
+		wantErr bool
+	}{
+		{
+			name: "default values",
+			spec: Spec{},
+		},
+		{
+			name: "invalid age",
+			spec: Spec{
+				Age: age.Spec{Minimum: "one week"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid versionfilter",
+			spec: Spec{
+				VersionFilter: version.Filter{Kind: "unknown"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.spec)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, nodejsDefaultIndexURL, got.spec.URL)
+			assert.Equal(t, "semver", got.versionFilter.Kind)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package nodejs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
+)
+
+// release represents a Node.js release listed by the release index
+type release struct {
+	// Version is the release version prefixed by "v", such as "v22.11.0"
+	Version string `json:"version"`
+	// Date is the release date, such as "2024-10-29"
+	Date string `json:"date"`
+	// LTS is either false, or the LTS line codename such as "Jod"
+	LTS interface{} `json:"lts"`
+	// Security reports whether the release contains security fixes
+	Security bool `json:"security"`
+}
+
+// codename returns the LTS line codename of the release, or an empty string
+// if the release isn't an LTS one.
+func (r release) codename() string {
+	codename, ok := r.LTS.(string)
+	if !ok {
+		return ""
+	}
+	return codename
+}
+
+// releaseDate returns the parsed release date
+func (r release) releaseDate() (time.Time, error) {
+	return time.Parse(time.DateOnly, r.Date)
+}
+
+// getReleases fetches the Node.js release index
+func (n *Nodejs) getReleases(ctx context.Context) ([]release, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, n.spec.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building request for %q: %w", redact.URL(n.spec.URL), err)
+	}
+
+	res, err := n.webClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching Node.js release index %q: %w", redact.URL(n.spec.URL), err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		return nil, fmt.Errorf("Node.js release index %q returned HTTP %d", redact.URL(n.spec.URL), res.StatusCode)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading Node.js release index response body: %w", err)
+	}
+
+	var releases []release
+	if err := json.Unmarshal(body, &releases); err != nil {
+		return nil, fmt.Errorf("unmarshalling Node.js release index: %w", err)
+	}
+
+	return releases, nil
+}
+
+// versions returns the Node.js versions matching the lts, codename, and age parameters,
+// from the oldest to the most recent one, and searches the one matching the version filter.
+func (n *Nodejs) versions(ctx context.Context) ([]string, error) {
+	releases, err := n.getReleases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n.releases = make(map[string]release)
+	versions := []string{}
+
+	for _, r := range releases {
+		v := strings.TrimPrefix(r.Version, "v")
+
+		if !n.isMatchingRelease(r) {
+			continue
+		}
+
+		versions = append(versions, v)
+		n.releases[v] = r
+	}
+
+	// The release index lists the most recent releases first
+	slices.Reverse(versions)
+
+	if len(versions) == 0 {
+		return versions, nil
+	}
+
+	n.foundVersion, err = n.versionFilter.Search(versions)
+	if err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// isMatchingRelease reports whether the release matches the lts, codename, and age parameters
+func (n *Nodejs) isMatchingRelease(r release) bool {
+	codename := r.codename()
+
+	if (n.spec.LTS || n.spec.Codename != "") && codename == "" {
+		logrus.Debugf("ignoring version %q because it isn't an LTS release\n", r.Version)
+		return false
+	}
+
+	if n.spec.Codename != "" && !strings.EqualFold(codename, n.spec.Codename) {
+		logrus.Debugf("ignoring version %q because it belongs to the LTS line %q\n", r.Version, codename)
+		return false
+	}
+
+	if n.spec.Age.IsZero() {
+		return true
+	}
+
+	releaseDate, err := r.releaseDate()
+	if err != nil {
+		logrus.Debugf("ignoring version %q due to invalid release date %q: %q\n", r.Version, r.Date, err)
+		return false
+	}
+
+	if n.spec.Age.Minimum != "" && n.spec.Age.IsOlderThan(releaseDate, nil) {
+		logrus.Debugf("ignoring version %q because its age is below %q (released on %s)\n", r.Version, n.spec.Age.Minimum, r.Date)
+		return false
+	}
+
+	if n.spec.Age.Maximum != "" && n.spec.Age.IsNewerThan(releaseDate, nil) {
+		logrus.Debugf("ignoring version %q because its age is above %q (released on %s)\n", r.Version, n.spec.Age.Maximum, r.Date)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,33 @@
+package nodejs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// Source returns the latest Node.js version matching the spec
+func (n *Nodejs) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	versions, err := n.versions(ctx)
+	if err != nil {
+		return fmt.Errorf("searching Node.js version: %w", err)
+	}
+
+	if len(versions) == 0 || n.foundVersion.GetVersion() == "" {
+		return fmt.Errorf("no Node.js version found matching pattern %q", n.versionFilter.Pattern)
+	}
+
+	foundVersion := n.foundVersion.GetVersion()
+	foundRelease := n.releases[n.foundVersion.OriginalVersion]
+
+	resultSource.Information = foundVersion
+	resultSource.Result = result.SUCCESS
+	resultSource.Description = fmt.Sprintf("Node.js version %s found, released on %s", foundVersion, foundRelease.Date)
+
+	if codename := foundRelease.codename(); codename != "" {
+		resultSource.Description = fmt.Sprintf("Node.js version %s (LTS %q) found, released on %s", foundVersion, codename, foundRelease.Date)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package nodejs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/age"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestSource(t *testing.T) {
+	tests := []struct {
+		name                string
+		spec                Spec
+		mockedBody          string
+		mockedStatusCode    int
+		expectedResult      string
+		expectedDescription string
+		expectedError       bool
+	}{
+		{
+			name:                "latest release",
+			spec:                Spec{},
+			expectedResult:      "23.2.0",
+			expectedDescription: "Node.js version 23.2.0 found, released on ",
+		},
+		{
+			name: "latest release using the latest versionfilter",
+			spec: Spec{
+				VersionFilter: version.Filter{Kind: "latest"},
+			},
+			expectedResult: "23.2.0",
+		},
+		{
+			name: "latest LTS release",
+			spec: Spec{
+				LTS: true,
+			},
+			expectedResult:      "22.11.0",
+			expectedDescription: `Node.js version 22.11.0 (LTS "Jod") found, released on 2024-10-29`,
+		},
+		{
+			name: "named LTS line",
+			spec: Spec{
+				Codename: "iron",
+			},
+			expectedResult: "20.18.0",
+		},
+		{
+			name: "named LTS line with a versionfilter",
+			spec: Spec{
+				Codename: "Iron",
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "~20.17",
+				},
+			},
+			expectedResult: "20.17.0",
+		},
+		{
+			name: "minimum age",
+			spec: Spec{
+				Age: age.Spec{Minimum: "7d"},
+			},
+			expectedResult: "23.1.0",
+		},
+		{
+			name: "unknown LTS line",
+			spec: Spec{
+				Codename: "argon",
+			},
+			expectedError: true,
+		},
+		{
+			name:             "release index not available",
+			spec:             Spec{},
+			mockedStatusCode: http.StatusInternalServerError,
+			expectedError:    true,
+		},
+		{
+			name:          "invalid release index",
+			spec:          Spec{},
+			mockedBody:    "<html></html>",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := New(tt.spec)
+			require.NoError(t, err)
+
+			mockedBody := tt.mockedBody
+			if mockedBody == "" {
+				mockedBody = releaseIndexData
+			}
+			mockedStatusCode := tt.mockedStatusCode
+			if mockedStatusCode == 0 {
+				mockedStatusCode = http.StatusOK
+			}
+			n.webClient = getMockClient(mockedBody, mockedStatusCode)
+
+			gotResult := result.Source{}
+			err = n.Source(context.Background(), "", &gotResult)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, gotResult.Information)
+			assert.Contains(t, gotResult.Description, tt.expectedDescription)
+		})
+	}
+}
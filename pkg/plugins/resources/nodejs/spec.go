@@ -0,0 +1,65 @@
+package nodejs
+
+import (
+	"github.com/updatecli/updatecli/pkg/plugins/utils/age"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines a specification for a "nodejs" resource parsed from an updatecli manifest file
+type Spec struct {
+	// Version defines a specific Node.js version, such as "22.11.0"
+	//
+	// Compatible:
+	//   * condition
+	//
+	// Default:
+	//   The source input value
+	Version string `yaml:",omitempty"`
+	// LTS restricts the releases to the Long Term Support ones.
+	//
+	// Compatible:
+	//   * source
+	//   * condition
+	//
+	// Default:
+	//   false, all releases are considered, including the "Current" ones
+	LTS bool `yaml:",omitempty"`
+	// Codename restricts the releases to a named LTS line, such as "jod" or "iron".
+	// The comparison is case insensitive and implies lts.
+	//
+	// Compatible:
+	//   * source
+	//   * condition
+	Codename string `yaml:",omitempty"`
+	// URL defines the Node.js release index url.
+	//
+	// Compatible:
+	//   * source
+	//   * condition
+	//
+	// Default:
+	//   https://nodejs.org/dist/index.json
+	//
+	// Remark:
+	//   Useful to rely on a Node.js mirror exposing the same index.json file.
+	URL string `yaml:",omitempty"`
+	// versionfilter provides parameters to specify version pattern and
+	// its type like regex, semver, or just latest.
+	//
+	// Compatible:
+	//   * source
+	//
+	// Default:
+	//   kind: semver
+	//   pattern: "*"
+	VersionFilter version.Filter `yaml:",omitempty"`
+	// age defines the minimum or maximum age of a release to be considered valid. It accepts a duration string (e.g., "24h", "7d").
+	//
+	// Compatible:
+	//   * source
+	//   * condition
+	//
+	// Remarks:
+	//   The release dates are read from the Node.js release index, so no extra API call is needed.
+	Age age.Spec `yaml:",omitempty"`
+}
@@ -122,6 +122,10 @@ func New(spec interface{}) (*Npm, error) {
 		return &Npm{}, err
 	}
 
+	// The age filter relies on the npm dist-tags, so it's handled by the resource
+	newSpec.Age = age.Resolve(newSpec.Age, newSpec.VersionFilter.Age)
+	newSpec.VersionFilter.Age = age.Spec{}
+
 	newFilter, err := newSpec.VersionFilter.Init()
 	if err != nil {
 		return &Npm{}, err
@@ -0,0 +1,197 @@
+package nuget
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
+)
+
+var (
+	// registrationTypes lists the registration resource types, by order of preference.
+	// Only the 3.6.0 flavor includes SemVer 2.0.0 packages.
+	registrationTypes = []string{
+		"RegistrationsBaseUrl/3.6.0",
+		"RegistrationsBaseUrl/3.4.0",
+		"RegistrationsBaseUrl/3.0.0-rc",
+		"RegistrationsBaseUrl/3.0.0-beta",
+		"RegistrationsBaseUrl",
+	}
+	// flatContainerType is the resource type of the package content API
+	flatContainerType = "PackageBaseAddress/3.0.0"
+
+	// errPackageNotFound is returned when the feed doesn't know the package
+	errPackageNotFound = errors.New("package not found")
+)
+
+// serviceIndex is the NuGet v3 entrypoint, listing the resources available on a feed
+type serviceIndex struct {
+	Resources []struct {
+		ID   string `json:"@id"`
+		Type string `json:"@type"`
+	} `json:"resources"`
+}
+
+// registrationIndex is the registration index of a package.
+// Pages are inlined for small packages, otherwise they must be fetched individually.
+type registrationIndex struct {
+	Items []registrationPage `json:"items"`
+}
+
+type registrationPage struct {
+	ID    string             `json:"@id"`
+	Items []registrationLeaf `json:"items"`
+}
+
+type registrationLeaf struct {
+	CatalogEntry catalogEntry `json:"catalogEntry"`
+}
+
+// catalogEntry holds the metadata of a single package version
+type catalogEntry struct {
+	Version      string `json:"version"`
+	Listed       *bool  `json:"listed"`
+	ProjectURL   string `json:"projectUrl"`
+	ReleaseNotes string `json:"releaseNotes"`
+	Published    string `json:"published"`
+}
+
+// isListed reports whether a package version is listed. Versions are listed unless stated otherwise.
+func (c catalogEntry) isListed() bool {
+	return c.Listed == nil || *c.Listed
+}
+
+// flatContainerIndex is the list of versions returned by the package content API
+type flatContainerIndex struct {
+	Versions []string `json:"versions"`
+}
+
+// getResources returns the resource urls advertised by the service index, keyed by type
+func (n *Nuget) getResources(ctx context.Context) (map[string]string, error) {
+	var index serviceIndex
+
+	if err := n.get(ctx, n.spec.URL, &index); err != nil {
+		return nil, fmt.Errorf("retrieving service index: %w", err)
+	}
+
+	resources := map[string]string{}
+	for _, r := range index.Resources {
+		if _, found := resources[r.Type]; !found {
+			resources[r.Type] = r.ID
+		}
+	}
+
+	return resources, nil
+}
+
+// getCatalogEntries returns the metadata of every package version, ordered from the oldest to the most recent one.
+// Feeds without registration resource only provide the version numbers, using the package content API.
+func (n *Nuget) getCatalogEntries(ctx context.Context) ([]catalogEntry, error) {
+	resources, err := n.getResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Package ids are case insensitive, and must be lowercased in urls
+	packageID := strings.ToLower(n.spec.Name)
+
+	for _, registrationType := range registrationTypes {
+		baseURL, found := resources[registrationType]
+		if !found {
+			continue
+		}
+
+		return n.getRegistrationEntries(ctx, joinURL(baseURL, packageID, "index.json"))
+	}
+
+	baseURL, found := resources[flatContainerType]
+	if !found {
+		return nil, fmt.Errorf("no registration or package content resource advertised by %q", redact.URL(n.spec.URL))
+	}
+
+	var index flatContainerIndex
+	if err := n.get(ctx, joinURL(baseURL, packageID, "index.json"), &index); err != nil {
+		return nil, err
+	}
+
+	entries := make([]catalogEntry, 0, len(index.Versions))
+	for _, v := range index.Versions {
+		entries = append(entries, catalogEntry{Version: v})
+	}
+
+	return entries, nil
+}
+
+// getRegistrationEntries walks the registration pages of a package
+func (n *Nuget) getRegistrationEntries(ctx context.Context, indexURL string) ([]catalogEntry, error) {
+	var index registrationIndex
+	if err := n.get(ctx, indexURL, &index); err != nil {
+		return nil, err
+	}
+
+	var entries []catalogEntry
+	for _, page := range index.Items {
+		if page.Items == nil {
+			logrus.Debugf("fetching registration page %q", redact.URL(page.ID))
+			if err := n.get(ctx, page.ID, &page); err != nil {
+				return nil, fmt.Errorf("retrieving registration page: %w", err)
+			}
+		}
+
+		for _, leaf := range page.Items {
+			entries = append(entries, leaf.CatalogEntry)
+		}
+	}
+
+	return entries, nil
+}
+
+// get queries a NuGet feed and decodes the json response into data
+func (n *Nuget) get(ctx context.Context, URL string, data interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL, nil)
+	if err != nil {
+		return fmt.Errorf("creating request for %q: %w", redact.URL(URL), err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if n.spec.Username != "" {
+		req.SetBasicAuth(n.spec.Username, n.spec.Password)
+	}
+
+	res, err := n.webClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("querying %q: %w", redact.URL(URL), err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("reading response from %q: %w", redact.URL(URL), err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %q on %q", errPackageNotFound, n.spec.Name, redact.URL(n.spec.URL))
+	}
+
+	if res.StatusCode >= 400 {
+		logrus.Debugf("\n%v\n", string(body))
+		return fmt.Errorf("querying %q: unexpected status code %d", redact.URL(URL), res.StatusCode)
+	}
+
+	if err := json.Unmarshal(body, data); err != nil {
+		return fmt.Errorf("decoding response from %q: %w", redact.URL(URL), err)
+	}
+
+	return nil
+}
+
+// joinURL joins a base url advertised by the service index with path elements
+func joinURL(baseURL string, elem ...string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/" + strings.Join(elem, "/")
+}
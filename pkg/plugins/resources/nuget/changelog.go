@@ -0,0 +1,96 @@
+package nuget
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/result"
+
+	githubChangelog "github.com/updatecli/updatecli/pkg/plugins/changelog/github/v3"
+)
+
+// Changelog returns the release notes published between the from and to versions.
+// GitHub releases are preferred when the package project url points to GitHub.
+func (n *Nuget) Changelog(from, to string) *result.Changelogs {
+	entries, err := n.getCatalogEntries(context.Background())
+	if err != nil {
+		logrus.Debugf("retrieving NuGet package %q metadata: %s", n.spec.Name, err)
+		return nil
+	}
+
+	entries = filterEntries(entries, from, to)
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if owner, repository, ok := parseGitHubURL(entries[len(entries)-1].ProjectURL); ok {
+		changelog := githubChangelog.Changelog{
+			Owner:      owner,
+			Repository: repository,
+		}
+
+		releases, err := changelog.Search(from, to)
+		if err != nil {
+			logrus.Debugf("searching GitHub releases for %s/%s: %s", owner, repository, err)
+		}
+
+		if len(releases) > 0 {
+			return &releases
+		}
+	}
+
+	var changelogs result.Changelogs
+	for _, entry := range entries {
+		if entry.ReleaseNotes == "" {
+			continue
+		}
+
+		changelogs = append(changelogs, result.Changelog{
+			Title:       entry.Version,
+			Body:        entry.ReleaseNotes,
+			PublishedAt: entry.Published,
+		})
+	}
+
+	if len(changelogs) == 0 {
+		return nil
+	}
+
+	return &changelogs
+}
+
+// filterEntries returns the entries from the "from" version, excluded, to the "to" version, included.
+func filterEntries(entries []catalogEntry, from, to string) []catalogEntry {
+	start := 0
+	end := len(entries)
+
+	for i, entry := range entries {
+		if from != "" && strings.EqualFold(entry.Version, from) {
+			start = i + 1
+		}
+		if to != "" && strings.EqualFold(entry.Version, to) {
+			end = i + 1
+		}
+	}
+
+	if start >= end {
+		return nil
+	}
+
+	return entries[start:end]
+}
+
+// parseGitHubURL extracts the owner and the repository from a GitHub url
+func parseGitHubURL(rawURL string) (owner, repository string, ok bool) {
+	url := strings.TrimPrefix(rawURL, "https://")
+	url = strings.TrimPrefix(url, "http://")
+	url = strings.TrimPrefix(url, "www.")
+
+	parts := strings.Split(url, "/")
+	if len(parts) < 3 || parts[0] != "github.com" || parts[1] == "" || parts[2] == "" {
+		return "", "", false
+	}
+
+	return parts[1], strings.TrimSuffix(parts[2], ".git"), true
+}
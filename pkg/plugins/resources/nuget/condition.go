@@ -0,0 +1,41 @@
+package nuget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks that a package version is published on the feed
+func (n *Nuget) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		logrus.Warningf("SCM configuration is not supported for nuget condition, aborting")
+	}
+
+	versionToCheck := n.spec.Version
+	if versionToCheck == "" {
+		versionToCheck = source
+	}
+
+	if versionToCheck == "" {
+		return false, "", errors.New("no version defined")
+	}
+
+	entries, err := n.getCatalogEntries(ctx)
+	if err != nil {
+		return false, "", err
+	}
+
+	for _, entry := range entries {
+		// NuGet versions are case insensitive
+		if strings.EqualFold(entry.Version, versionToCheck) {
+			return true, fmt.Sprintf("NuGet package %q version %q available", n.spec.Name, versionToCheck), nil
+		}
+	}
+
+	return false, fmt.Sprintf("NuGet package %q version %q doesn't exist", n.spec.Name, versionToCheck), nil
+}
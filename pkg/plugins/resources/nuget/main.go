@@ -0,0 +1,143 @@
+package nuget
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/sirupsen/logrus"
+
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// nugetDefaultURL is the service index of the public NuGet gallery
+	nugetDefaultURL string = "https://api.nuget.org/v3/index.json"
+)
+
+// Spec defines a specification for a "nuget" resource
+// parsed from an updatecli manifest file
+type Spec struct {
+	// Name defines the NuGet package id
+	//
+	// compatible:
+	//   * source
+	//   * condition
+	//
+	// example: Newtonsoft.Json
+	Name string `yaml:",omitempty" jsonschema:"required"`
+	// Version defines a specific package version
+	//
+	// compatible:
+	//   * condition
+	//
+	// default: the source output is used when no version is specified
+	Version string `yaml:",omitempty"`
+	// URL defines the NuGet v3 service index of the feed
+	//
+	// compatible:
+	//   * source
+	//   * condition
+	//
+	// default: https://api.nuget.org/v3/index.json
+	//
+	// example:
+	//   * https://pkgs.dev.azure.com/<organization>/_packaging/<feed>/nuget/v3/index.json
+	//   * https://nuget.pkg.github.com/<owner>/index.json
+	URL string `yaml:",omitempty"`
+	// Username defines the username used to authenticate against a private feed
+	//
+	// compatible:
+	//   * source
+	//   * condition
+	//
+	// remark:
+	//   Azure Artifacts accepts any non empty username when the password is a personal access token
+	Username string `yaml:",omitempty"`
+	// Password defines the password, or the personal access token, used to authenticate against a private feed
+	//
+	// compatible:
+	//   * source
+	//   * condition
+	Password string `yaml:",omitempty"`
+	// VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+	//
+	// compatible:
+	//   * source
+	//
+	// default: latest
+	VersionFilter version.Filter `yaml:",omitempty"`
+}
+
+// Nuget defines a resource of kind "nuget"
+type Nuget struct {
+	spec Spec
+	// versionFilter holds the "valid" version.filter, that might be different than the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+	foundVersion  version.Version
+	webClient     httpclient.HTTPClient
+}
+
+// New returns a new valid Nuget object.
+func New(spec interface{}) (*Nuget, error) {
+	var newSpec Spec
+
+	err := mapstructure.Decode(spec, &newSpec)
+	if err != nil {
+		return &Nuget{}, err
+	}
+
+	err = newSpec.Validate()
+	if err != nil {
+		return &Nuget{}, err
+	}
+
+	if newSpec.URL == "" {
+		newSpec.URL = nugetDefaultURL
+	}
+
+	newFilter, err := newSpec.VersionFilter.Init()
+	if err != nil {
+		return &Nuget{}, err
+	}
+
+	return &Nuget{
+		spec:          newSpec,
+		versionFilter: newFilter,
+		webClient:     httpclient.NewRetryClient(),
+	}, nil
+}
+
+// Validate run some validation on the Spec
+func (s *Spec) Validate() error {
+	if len(s.Name) == 0 {
+		logrus.Errorf("nuget package name not defined")
+		return errors.New("nuget package name not defined")
+	}
+
+	if s.Password != "" && s.Username == "" {
+		logrus.Errorf("nuget username required when a password is defined")
+		return errors.New("nuget username required when a password is defined")
+	}
+
+	return nil
+}
+
+// ReportConfig returns a new configuration object with only the necessary fields
+// to identify the resource without any sensitive information or context specific data.
+func (n *Nuget) ReportConfig() interface{} {
+	return Spec{
+		Name:          n.spec.Name,
+		Version:       n.spec.Version,
+		URL:           redact.URL(n.spec.URL),
+		VersionFilter: n.spec.VersionFilter,
+	}
+}
+
+// isPrerelease reports whether a NuGet version is a prerelease, such as 1.0.0-beta.1
+func isPrerelease(v string) bool {
+	v, _, _ = strings.Cut(v, "+")
+	return strings.Contains(v, "-")
+}
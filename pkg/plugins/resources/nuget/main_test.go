@@ -0,0 +1,240 @@
+package nuget
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// newTestFeed returns a fake NuGet v3 feed hosting the package "Demo.Package".
+// The first registration page is inlined, the second one must be fetched.
+// When withRegistration is false, the feed only advertises the package content API.
+func newTestFeed(t *testing.T, withRegistration bool) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	mux.HandleFunc("/v3/index.json", func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "updatecli" || password != "secret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		registration := ""
+		if withRegistration {
+			registration = fmt.Sprintf(`{"@id": "%s/v3/registration/", "@type": "RegistrationsBaseUrl/3.6.0"},`, server.URL)
+		}
+		fmt.Fprintf(w, `{"version": "3.0.0", "resources": [%s {"@id": "%s/v3/flatcontainer/", "@type": "PackageBaseAddress/3.0.0"}]}`,
+			registration, server.URL)
+	})
+
+	mux.HandleFunc("/v3/registration/demo.package/index.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"count": 2, "items": [
+  {"@id": "%[1]s/v3/registration/demo.package/page/1.0.0/1.1.0.json", "items": [
+    {"catalogEntry": {"version": "1.0.0", "listed": true, "releaseNotes": "First release", "published": "2023-01-01T00:00:00Z"}},
+    {"catalogEntry": {"version": "1.1.0", "listed": true, "releaseNotes": "Second release", "published": "2023-02-01T00:00:00Z"}}
+  ]},
+  {"@id": "%[1]s/v3/registration/demo.package/page/1.2.0/2.0.0-rc.1.json"}
+]}`, server.URL)
+	})
+
+	mux.HandleFunc("/v3/registration/demo.package/page/1.2.0/2.0.0-rc.1.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items": [
+  {"catalogEntry": {"version": "1.2.0", "listed": true, "releaseNotes": "Third release", "published": "2023-03-01T00:00:00Z"}},
+  {"catalogEntry": {"version": "1.3.0", "listed": false}},
+  {"catalogEntry": {"version": "2.0.0-rc.1", "listed": true}}
+]}`)
+	})
+
+	mux.HandleFunc("/v3/flatcontainer/demo.package/index.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"versions": ["1.0.0", "1.1.0", "1.2.0", "1.3.0", "2.0.0-rc.1"]}`)
+	})
+
+	return server
+}
+
+func newTestNuget(t *testing.T, serverURL string, spec map[string]any) *Nuget {
+	t.Helper()
+
+	manifest := map[string]any{
+		"name":     "Demo.Package",
+		"url":      serverURL + "/v3/index.json",
+		"username": "updatecli",
+		"password": "secret",
+	}
+	for k, v := range spec {
+		manifest[k] = v
+	}
+
+	n, err := New(manifest)
+	require.NoError(t, err)
+
+	return n
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Spec{})
+	assert.ErrorContains(t, err, "package name not defined")
+
+	_, err = New(Spec{Name: "Newtonsoft.Json", Password: "secret"})
+	assert.ErrorContains(t, err, "username required")
+
+	n, err := New(Spec{Name: "Newtonsoft.Json"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.nuget.org/v3/index.json", n.spec.URL)
+}
+
+func TestSource(t *testing.T) {
+	tests := []struct {
+		name             string
+		withRegistration bool
+		spec             map[string]any
+		expectedResult   string
+		wantErr          string
+	}{
+		{
+			name:             "latest listed version",
+			withRegistration: true,
+			expectedResult:   "1.2.0",
+		},
+		{
+			name:             "prerelease version",
+			withRegistration: true,
+			spec: map[string]any{
+				"versionfilter": map[string]any{
+					"kind":    "semver",
+					"pattern": ">=2.0.0-0",
+				},
+			},
+			expectedResult: "2.0.0-rc.1",
+		},
+		{
+			name:             "semver version filter",
+			withRegistration: true,
+			spec: map[string]any{
+				"versionfilter": map[string]any{
+					"kind":    "semver",
+					"pattern": "~1.1",
+				},
+			},
+			expectedResult: "1.1.0",
+		},
+		{
+			name:           "feed without registration",
+			expectedResult: "1.3.0",
+		},
+		{
+			name:             "wrong credentials",
+			withRegistration: true,
+			spec:             map[string]any{"password": "wrong"},
+			wantErr:          "unexpected status code 401",
+		},
+		{
+			name:             "unknown package",
+			withRegistration: true,
+			spec:             map[string]any{"name": "Unknown"},
+			wantErr:          "package not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestFeed(t, tt.withRegistration)
+			defer server.Close()
+
+			n := newTestNuget(t, server.URL, tt.spec)
+
+			gotResult := result.Source{}
+			err := n.Source(context.Background(), "", &gotResult)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, gotResult.Information)
+			assert.Equal(t, result.SUCCESS, gotResult.Result)
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         map[string]any
+		source       string
+		expectedPass bool
+		wantErr      string
+	}{
+		{
+			name:         "version from source exists",
+			source:       "1.1.0",
+			expectedPass: true,
+		},
+		{
+			name:         "version from spec exists",
+			spec:         map[string]any{"version": "2.0.0-RC.1"},
+			expectedPass: true,
+		},
+		{
+			name:   "version doesn't exist",
+			source: "3.0.0",
+		},
+		{
+			name:    "no version",
+			wantErr: "no version defined",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestFeed(t, true)
+			defer server.Close()
+
+			n := newTestNuget(t, server.URL, tt.spec)
+
+			gotPass, _, err := n.Condition(context.Background(), tt.source, nil)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPass, gotPass)
+		})
+	}
+}
+
+func TestChangelog(t *testing.T) {
+	server := newTestFeed(t, true)
+	defer server.Close()
+
+	n := newTestNuget(t, server.URL, nil)
+
+	got := n.Changelog("1.0.0", "1.2.0")
+	require.NotNil(t, got)
+
+	assert.Equal(t, result.Changelogs{
+		{
+			Title:       "1.1.0",
+			Body:        "Second release",
+			PublishedAt: "2023-02-01T00:00:00Z",
+		},
+		{
+			Title:       "1.2.0",
+			Body:        "Third release",
+			PublishedAt: "2023-03-01T00:00:00Z",
+		},
+	}, *got)
+
+	assert.Nil(t, n.Changelog("1.2.0", "1.2.0"))
+}
@@ -0,0 +1,47 @@
+package nuget
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Source returns the latest listed package version matching the version filter
+func (n *Nuget) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	entries, err := n.getCatalogEntries(ctx)
+	if err != nil {
+		return err
+	}
+
+	var versions []string
+	for _, entry := range entries {
+		if !entry.isListed() {
+			continue
+		}
+
+		// The latest version kind doesn't know anything about prerelease
+		// so we must discard them ourselves.
+		if n.versionFilter.Kind == version.LATESTVERSIONKIND && isPrerelease(entry.Version) {
+			continue
+		}
+
+		versions = append(versions, entry.Version)
+	}
+
+	if len(versions) == 0 {
+		return fmt.Errorf("no version found for NuGet package %q", n.spec.Name)
+	}
+
+	n.foundVersion, err = n.versionFilter.Search(versions)
+	if err != nil {
+		return err
+	}
+
+	resultSource.Information = n.foundVersion.GetVersion()
+	resultSource.Result = result.SUCCESS
+	resultSource.Description = fmt.Sprintf("version %s found for NuGet package %q", n.foundVersion.GetVersion(), n.spec.Name)
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package pypi
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/ini.v1"
+)
+
+// indexConfig contains the package index settings read from the uv or pip configuration
+type indexConfig struct {
+	URL      string
+	Username string
+	Password string
+}
+
+// uvIndex is an index defined in the uv configuration
+type uvIndex struct {
+	Name    string `toml:"name"`
+	URL     string `toml:"url"`
+	Default bool   `toml:"default"`
+}
+
+// uvConfig contains the uv settings used by Updatecli
+type uvConfig struct {
+	Index []uvIndex `toml:"index"`
+	// IndexURL is the deprecated way to define the default index
+	IndexURL string `toml:"index-url"`
+}
+
+// pyprojectConfig contains the uv settings defined in a pyproject.toml file
+type pyprojectConfig struct {
+	Tool struct {
+		UV uvConfig `toml:"uv"`
+	} `toml:"tool"`
+}
+
+// uvIndexEnvNameSeparators matches the characters replaced in uv index credentials environment variables
+var uvIndexEnvNameSeparators = regexp.MustCompile(`[^A-Z0-9]`)
+
+func fileExists(path string) bool {
+	_, err := os.Stat(filepath.Clean(path))
+	return err == nil
+}
+
+// firstExistingFile returns the first path pointing to an existing file
+func firstExistingFile(paths ...string) string {
+	for _, path := range paths {
+		if path != "" && fileExists(path) {
+			return path
+		}
+	}
+	return ""
+}
+
+// userConfigDir returns the user configuration directory following the XDG specification used by pip and uv
+func userConfigDir() string {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return dir
+	}
+	return filepath.Join(os.Getenv("HOME"), ".config")
+}
+
+// getIndexConfig returns the default index defined in the uv configuration,
+// or in the pip configuration if uv doesn't define one.
+func getIndexConfig(uvConfigPath, pipConfigPath string) (indexConfig, error) {
+	path := firstExistingFile(
+		uvConfigPath,
+		os.Getenv("UV_CONFIG_FILE"),
+		filepath.Join(userConfigDir(), "uv", "uv.toml"),
+	)
+	if path != "" {
+		index, err := getUVIndexConfig(path)
+		if err != nil {
+			return indexConfig{}, err
+		}
+		if index.URL != "" {
+			return index, nil
+		}
+	}
+
+	path = firstExistingFile(
+		pipConfigPath,
+		os.Getenv("PIP_CONFIG_FILE"),
+		filepath.Join(userConfigDir(), "pip", "pip.conf"),
+		filepath.Join(os.Getenv("HOME"), ".pip", "pip.conf"),
+	)
+	if path != "" {
+		return getPipIndexConfig(path)
+	}
+
+	return indexConfig{}, nil
+}
+
+// getUVIndexConfig reads the default index from a uv.toml or pyproject.toml file
+func getUVIndexConfig(path string) (indexConfig, error) {
+	var config uvConfig
+
+	if filepath.Base(path) == "pyproject.toml" {
+		var pyproject pyprojectConfig
+		if _, err := toml.DecodeFile(path, &pyproject); err != nil {
+			return indexConfig{}, fmt.Errorf("reading uv configuration %q: %w", path, err)
+		}
+		config = pyproject.Tool.UV
+	} else if _, err := toml.DecodeFile(path, &config); err != nil {
+		return indexConfig{}, fmt.Errorf("reading uv configuration %q: %w", path, err)
+	}
+
+	logrus.Debugf("reading package index from uv configuration %q", path)
+
+	index := uvIndex{URL: config.IndexURL}
+	for _, i := range config.Index {
+		if i.Default {
+			index = i
+			break
+		}
+	}
+
+	if index.URL == "" {
+		return indexConfig{}, nil
+	}
+
+	result := indexConfig{URL: index.URL}
+	if index.Name != "" {
+		envName := uvIndexEnvNameSeparators.ReplaceAllString(strings.ToUpper(index.Name), "_")
+		result.Username = os.Getenv(fmt.Sprintf("UV_INDEX_%s_USERNAME", envName))
+		result.Password = os.Getenv(fmt.Sprintf("UV_INDEX_%s_PASSWORD", envName))
+	}
+
+	return result, nil
+}
+
+// getPipIndexConfig reads the index url from a pip.conf file
+func getPipIndexConfig(path string) (indexConfig, error) {
+	cfg, err := ini.Load(path)
+	if err != nil {
+		return indexConfig{}, fmt.Errorf("reading pip configuration %q: %w", path, err)
+	}
+
+	logrus.Debugf("reading package index from pip configuration %q", path)
+
+	for _, section := range []string{"install", "global"} {
+		if value := cfg.Section(section).Key("index-url").String(); value != "" {
+			return indexConfig{URL: value}, nil
+		}
+	}
+
+	return indexConfig{}, nil
+}
+
+// extractURLCredentials removes the credentials embedded in a url, like pip supports,
+// and uses them unless credentials are already defined.
+func extractURLCredentials(rawURL string, username, password *string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("parsing index url: %w", err)
+	}
+
+	if u.User == nil {
+		return rawURL, nil
+	}
+
+	if *username == "" && *password == "" {
+		*username = u.User.Username()
+		*password, _ = u.User.Password()
+	}
+
+	u.User = nil
+
+	return u.String(), nil
+}
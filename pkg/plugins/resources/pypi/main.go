@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/sirupsen/logrus"
@@ -26,15 +27,62 @@ type Spec struct {
 	Version string `yaml:",omitempty"`
 	// URL defines the PyPI-compatible registry URL (defaults to https://pypi.org/).
 	URL string `yaml:",omitempty"`
+	// IndexURL defines the simple repository API index URL, such as https://pypi.org/simple/.
+	//
+	// default:
+	//   The index url defined in the uv or pip configuration, otherwise "<url>/simple/".
+	//
+	// remark:
+	//   When url is not set and the index url ends with "/simple/", the url is derived from it,
+	//   which matches how PyPI, Artifactory, devpi, and Nexus expose both APIs.
+	IndexURL string `yaml:"indexurl,omitempty"`
+	// API defines the repository API used to retrieve the package versions.
+	//
+	// accepted values:
+	//   * auto: use the PyPI JSON API, and fall back to the simple repository API when not available
+	//   * json: only use the PyPI JSON API (/pypi/<name>/json)
+	//   * simple: only use the simple repository API (PEP 503 HTML and PEP 691 JSON variants)
+	//
+	// default:
+	//   auto
+	API string `yaml:"api,omitempty"`
 	// Token defines the Bearer token for private registries.
 	Token string `yaml:",omitempty"`
+	// Username defines the username used for basic authentication on private registries.
+	//
+	// remark:
+	//   Credentials embedded in the index url, like pip allows, are used by default.
+	Username string `yaml:",omitempty"`
+	// Password defines the password used for basic authentication on private registries.
+	Password string `yaml:",omitempty"`
+	// PipConfigPath defines the path to the pip configuration file used to read the index url.
+	//
+	// default:
+	//   $PIP_CONFIG_FILE, otherwise $HOME/.config/pip/pip.conf or $HOME/.pip/pip.conf
+	PipConfigPath string `yaml:"pipconfigpath,omitempty"`
+	// UVConfigPath defines the path to the uv configuration file, either uv.toml or pyproject.toml,
+	// used to read the default index url. Index credentials are read from the
+	// UV_INDEX_<NAME>_USERNAME and UV_INDEX_<NAME>_PASSWORD environment variables.
+	//
+	// default:
+	//   $UV_CONFIG_FILE, otherwise $HOME/.config/uv/uv.toml
+	//
+	// remark:
+	//   The uv configuration takes precedence over the pip configuration.
+	UVConfigPath string `yaml:"uvconfigpath,omitempty"`
 	// VersionFilter provides parameters to specify version pattern and its type.
+	//
+	// remark:
+	//   The versionfilter age relies on the upload date of the release files, which is reported by
+	//   the PyPI JSON API and the PEP 700 JSON variant of the simple repository API.
 	VersionFilter version.Filter `yaml:",omitempty"`
 }
 
 // releaseFile represents a single distribution file for a release.
 type releaseFile struct {
 	Yanked bool `json:"yanked"`
+	// UploadTime is the ISO 8601 upload date of the file, when reported by the registry
+	UploadTime string `json:"upload_time_iso_8601"`
 }
 
 // packageInfo holds the metadata section of a PyPI JSON response.
@@ -57,10 +105,20 @@ type Pypi struct {
 	foundVersion         version.Version
 	data                 pypiData
 	webClient            httpclient.HTTPClient
-	normalizedToOriginal map[string]string // maps semver-normalized version back to original PEP 440
+	normalizedToOriginal map[string]string    // maps semver-normalized version back to original PEP 440
+	releaseDates         map[string]time.Time // maps a version, as returned by availableVersions, to its release date
 }
 
-const pypiDefaultURL = "https://pypi.org/"
+const (
+	pypiDefaultURL = "https://pypi.org/"
+
+	// apiAuto uses the PyPI JSON API, and falls back to the simple repository API
+	apiAuto = "auto"
+	// apiJSON only uses the PyPI JSON API
+	apiJSON = "json"
+	// apiSimple only uses the simple repository API
+	apiSimple = "simple"
+)
 
 // New returns a new valid Pypi resource object.
 func New(spec interface{}) (*Pypi, error) {
@@ -76,14 +134,55 @@ func New(spec interface{}) (*Pypi, error) {
 		return &Pypi{}, err
 	}
 
-	if newSpec.URL == "" {
+	if newSpec.API == "" {
+		newSpec.API = apiAuto
+	}
+
+	// The index url is only read from the uv or pip configuration when no registry is specified
+	if newSpec.URL == "" && newSpec.IndexURL == "" {
+		index, err := getIndexConfig(newSpec.UVConfigPath, newSpec.PipConfigPath)
+		if err != nil {
+			return &Pypi{}, err
+		}
+
+		newSpec.IndexURL = index.URL
+		if newSpec.Username == "" && newSpec.Password == "" {
+			newSpec.Username = index.Username
+			newSpec.Password = index.Password
+		}
+	}
+
+	if newSpec.IndexURL != "" {
+		newSpec.IndexURL, err = extractURLCredentials(newSpec.IndexURL, &newSpec.Username, &newSpec.Password)
+		if err != nil {
+			return &Pypi{}, err
+		}
+
+		if !strings.HasSuffix(newSpec.IndexURL, "/") {
+			newSpec.IndexURL += "/"
+		}
+
+		if newSpec.URL == "" && strings.HasSuffix(newSpec.IndexURL, "/simple/") {
+			newSpec.URL = strings.TrimSuffix(newSpec.IndexURL, "simple/")
+		}
+	}
+
+	if newSpec.URL == "" && newSpec.IndexURL == "" {
 		newSpec.URL = pypiDefaultURL
 	}
 
-	if !strings.HasSuffix(newSpec.URL, "/") {
+	if newSpec.URL != "" && !strings.HasSuffix(newSpec.URL, "/") {
 		newSpec.URL += "/"
 	}
 
+	if newSpec.IndexURL == "" {
+		newSpec.IndexURL = newSpec.URL + "simple/"
+	}
+
+	if newSpec.API == apiJSON && newSpec.URL == "" {
+		return &Pypi{}, fmt.Errorf("the pypi json api requires the url to be defined, the index url %q doesn't end with \"/simple/\"", redact.URL(newSpec.IndexURL))
+	}
+
 	newFilter, err := newSpec.VersionFilter.Init()
 	if err != nil {
 		return &Pypi{}, err
@@ -101,11 +200,52 @@ func (s *Spec) Validate() error {
 	if len(s.Name) == 0 {
 		return errors.New("pypi package name not defined")
 	}
+
+	switch s.API {
+	case "", apiAuto, apiJSON, apiSimple:
+	default:
+		return fmt.Errorf("unsupported pypi api %q, accepted values are %q, %q, and %q", s.API, apiAuto, apiJSON, apiSimple)
+	}
+
+	if s.Token != "" && (s.Username != "" || s.Password != "") {
+		return errors.New("pypi token and username/password are mutually exclusive")
+	}
+
 	return nil
 }
 
-// getPackageData fetches and parses the PyPI JSON API response for the package.
+// setAuthentication sets the registry credentials on a request
+func (p *Pypi) setAuthentication(req *http.Request) {
+	switch {
+	case p.spec.Token != "":
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", p.spec.Token))
+	case p.spec.Username != "" || p.spec.Password != "":
+		req.SetBasicAuth(p.spec.Username, p.spec.Password)
+	}
+}
+
+// getPackageData retrieves the package releases using the configured repository API.
 func (p *Pypi) getPackageData(ctx context.Context) (pypiData, error) {
+	switch p.spec.API {
+	case apiJSON:
+		return p.getJSONPackageData(ctx)
+	case apiSimple:
+		return p.getSimplePackageData(ctx)
+	}
+
+	if p.spec.URL != "" {
+		data, err := p.getJSONPackageData(ctx)
+		if !errors.Is(err, errJSONAPIUnavailable) {
+			return data, err
+		}
+		logrus.Debugf("%s, falling back to the simple repository API", err)
+	}
+
+	return p.getSimplePackageData(ctx)
+}
+
+// getJSONPackageData fetches and parses the PyPI JSON API response for the package.
+func (p *Pypi) getJSONPackageData(ctx context.Context) (pypiData, error) {
 	requestURL := fmt.Sprintf("%spypi/%s/json", p.spec.URL, url.PathEscape(p.spec.Name))
 
 	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
@@ -113,9 +253,7 @@ func (p *Pypi) getPackageData(ctx context.Context) (pypiData, error) {
 		return pypiData{}, fmt.Errorf("building request for %q: %w", requestURL, err)
 	}
 
-	if p.spec.Token != "" {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", p.spec.Token))
-	}
+	p.setAuthentication(req)
 
 	res, err := p.webClient.Do(req)
 	if err != nil {
@@ -124,6 +262,11 @@ func (p *Pypi) getPackageData(ctx context.Context) (pypiData, error) {
 	}
 	defer res.Body.Close()
 
+	switch res.StatusCode {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotAcceptable, http.StatusNotImplemented:
+		return pypiData{}, fmt.Errorf("%w: HTTP %d for package %q", errJSONAPIUnavailable, res.StatusCode, p.spec.Name)
+	}
+
 	if res.StatusCode >= 400 {
 		return pypiData{}, fmt.Errorf("pypi API returned HTTP %d for package %q", res.StatusCode, p.spec.Name)
 	}
@@ -135,7 +278,7 @@ func (p *Pypi) getPackageData(ctx context.Context) (pypiData, error) {
 
 	var data pypiData
 	if err = json.Unmarshal(body, &data); err != nil {
-		return pypiData{}, fmt.Errorf("unmarshalling pypi response: %w", err)
+		return pypiData{}, fmt.Errorf("%w: unmarshalling pypi response: %s", errJSONAPIUnavailable, err)
 	}
 
 	return data, nil
@@ -156,6 +299,7 @@ func (p *Pypi) availableVersions(ctx context.Context) ([]string, error) {
 	if p.versionFilter.Kind != version.PEP440VERSIONKIND {
 		p.normalizedToOriginal = make(map[string]string)
 	}
+	p.releaseDates = make(map[string]time.Time)
 	for ver, files := range p.data.Releases {
 		if isYanked(files) {
 			continue
@@ -163,6 +307,7 @@ func (p *Pypi) availableVersions(ctx context.Context) ([]string, error) {
 
 		if p.versionFilter.Kind == version.PEP440VERSIONKIND {
 			versions = append(versions, ver)
+			p.setReleaseDate(ver, files)
 			continue
 		}
 
@@ -172,6 +317,7 @@ func (p *Pypi) availableVersions(ctx context.Context) ([]string, error) {
 		}
 		p.normalizedToOriginal[normalized] = ver
 		versions = append(versions, normalized)
+		p.setReleaseDate(normalized, files)
 	}
 
 	return versions, nil
@@ -240,6 +386,31 @@ func isYanked(files []releaseFile) bool {
 	return true
 }
 
+// setReleaseDate records the release date of a version, which is the upload date
+// of its first non-yanked file. Nothing is recorded if the registry doesn't report upload dates.
+func (p *Pypi) setReleaseDate(ver string, files []releaseFile) {
+	var releaseDate time.Time
+	for _, f := range files {
+		if f.Yanked || f.UploadTime == "" {
+			continue
+		}
+
+		uploadTime, err := time.Parse(time.RFC3339, f.UploadTime)
+		if err != nil {
+			logrus.Debugf("ignoring invalid upload time %q of version %q: %s", f.UploadTime, ver, err)
+			continue
+		}
+
+		if releaseDate.IsZero() || uploadTime.Before(releaseDate) {
+			releaseDate = uploadTime
+		}
+	}
+
+	if !releaseDate.IsZero() {
+		p.releaseDates[ver] = releaseDate
+	}
+}
+
 // originalVersion maps a normalized version back to the original PEP 440 string.
 func (p *Pypi) originalVersion(normalized string) string {
 	if orig, ok := p.normalizedToOriginal[normalized]; ok {
@@ -258,14 +429,27 @@ func (p *Pypi) getVersions(ctx context.Context) (string, []string, error) {
 	if p.versionFilter.Kind == version.LATESTVERSIONKIND {
 		latest := p.data.Info.Version
 		for _, v := range versions {
-			if v == latest {
+			if v != latest {
+				continue
+			}
+
+			if p.versionFilter.Age.IsZero() {
 				return latest, versions, nil
 			}
+
+			// The latest version is still used as long as it matches the age filter,
+			// otherwise we fall back to the most recently released version that does.
+			if releaseDate, ok := p.releaseDates[v]; ok && p.versionFilter.Age.IsMatching(releaseDate, nil) {
+				return latest, versions, nil
+			}
+		}
+
+		if p.versionFilter.Age.IsZero() {
+			return "", versions, fmt.Errorf("latest version %q of package %q is yanked", latest, p.spec.Name)
 		}
-		return "", versions, fmt.Errorf("latest version %q of package %q is yanked", latest, p.spec.Name)
 	}
 
-	p.foundVersion, err = p.versionFilter.Search(versions)
+	p.foundVersion, err = p.versionFilter.SearchWithReleaseDates(versions, p.releaseDates)
 	if err != nil {
 		return "", nil, err
 	}
@@ -283,6 +467,8 @@ func (p *Pypi) ReportConfig() interface{} {
 		Name:          p.spec.Name,
 		Version:       p.spec.Version,
 		URL:           redact.URL(p.spec.URL),
+		IndexURL:      redact.URL(p.spec.IndexURL),
+		API:           p.spec.API,
 		VersionFilter: p.spec.VersionFilter,
 	}
 }
@@ -0,0 +1,244 @@
+package pypi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/html"
+
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// simpleJSONContentType is the content type of the PEP 691 JSON simple repository API
+	simpleJSONContentType = "application/vnd.pypi.simple.v1+json"
+	// simpleAcceptHeader requests the JSON variant of the simple repository API,
+	// falling back to the HTML variant for repositories which don't support PEP 691
+	simpleAcceptHeader = "application/vnd.pypi.simple.v1+json, application/vnd.pypi.simple.v1+html;q=0.2, text/html;q=0.01"
+)
+
+// errJSONAPIUnavailable is returned when a repository doesn't implement the PyPI JSON API
+var errJSONAPIUnavailable = errors.New("pypi JSON API not available")
+
+// simpleNameSeparators matches the characters normalized by PEP 503
+var simpleNameSeparators = regexp.MustCompile(`[-_.]+`)
+
+// simpleDistributionExtensions lists the distribution file extensions, longest first
+var simpleDistributionExtensions = []string{".tar.gz", ".tar.bz2", ".tar.xz", ".tar.Z", ".tgz", ".tbz", ".zip", ".whl", ".egg", ".tar"}
+
+// simpleFile represents a distribution file returned by the PEP 691 JSON simple repository API.
+type simpleFile struct {
+	Filename string `json:"filename"`
+	// Yanked is either a boolean, or a string containing the yank reason
+	Yanked interface{} `json:"yanked"`
+	// UploadTime is the ISO 8601 upload date of the file, as defined by PEP 700
+	UploadTime string `json:"upload-time"`
+}
+
+// simpleData is the top-level structure returned by the PEP 691 JSON simple repository API.
+type simpleData struct {
+	Name  string       `json:"name"`
+	Files []simpleFile `json:"files"`
+}
+
+// normalizeName returns the PEP 503 normalized form of a package name
+func normalizeName(name string) string {
+	return strings.ToLower(simpleNameSeparators.ReplaceAllString(name, "-"))
+}
+
+// getSimplePackageData fetches and parses the simple repository API page of the package,
+// in its PEP 691 JSON variant or PEP 503 HTML variant.
+func (p *Pypi) getSimplePackageData(ctx context.Context) (pypiData, error) {
+	requestURL := fmt.Sprintf("%s%s/", p.spec.IndexURL, normalizeName(p.spec.Name))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return pypiData{}, fmt.Errorf("building request for %q: %w", requestURL, err)
+	}
+
+	req.Header.Set("Accept", simpleAcceptHeader)
+	p.setAuthentication(req)
+
+	res, err := p.webClient.Do(req)
+	if err != nil {
+		logrus.Errorf("fetching pypi simple index for %q: %s", p.spec.Name, err)
+		return pypiData{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		return pypiData{}, fmt.Errorf("pypi simple repository API returned HTTP %d for package %q", res.StatusCode, p.spec.Name)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return pypiData{}, fmt.Errorf("reading pypi simple index response body: %w", err)
+	}
+
+	var releases map[string][]releaseFile
+
+	mediaType, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	switch {
+	case mediaType == simpleJSONContentType, mediaType == "" && json.Valid(body):
+		releases, err = parseSimpleJSON(p.spec.Name, body)
+	default:
+		releases, err = parseSimpleHTML(p.spec.Name, body)
+	}
+	if err != nil {
+		return pypiData{}, err
+	}
+
+	return pypiData{
+		Info: packageInfo{
+			Name:    p.spec.Name,
+			Version: latestRelease(releases),
+		},
+		Releases: releases,
+	}, nil
+}
+
+// parseSimpleJSON returns the package releases listed by a PEP 691 JSON page
+func parseSimpleJSON(packageName string, body []byte) (map[string][]releaseFile, error) {
+	var data simpleData
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, fmt.Errorf("unmarshalling pypi simple index response: %w", err)
+	}
+
+	releases := make(map[string][]releaseFile)
+	for _, file := range data.Files {
+		ver := versionFromFilename(packageName, file.Filename)
+		if ver == "" {
+			continue
+		}
+
+		var yanked bool
+		switch value := file.Yanked.(type) {
+		case bool:
+			yanked = value
+		case string:
+			// A yank reason means the file is yanked
+			yanked = true
+		}
+
+		releases[ver] = append(releases[ver], releaseFile{Yanked: yanked, UploadTime: file.UploadTime})
+	}
+
+	return releases, nil
+}
+
+// parseSimpleHTML returns the package releases listed by a PEP 503 HTML page.
+// A file is yanked when its anchor has a "data-yanked" attribute, as defined by PEP 592.
+func parseSimpleHTML(packageName string, body []byte) (map[string][]releaseFile, error) {
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("parsing pypi simple index response: %w", err)
+	}
+
+	releases := make(map[string][]releaseFile)
+
+	var parseAnchors func(*html.Node)
+	parseAnchors = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "a" {
+			var filename string
+			var yanked bool
+
+			for _, attr := range n.Attr {
+				switch attr.Key {
+				case "href":
+					filename = attr.Val
+				case "data-yanked":
+					yanked = true
+				}
+			}
+
+			if n.FirstChild != nil && n.FirstChild.Type == html.TextNode {
+				filename = n.FirstChild.Data
+			}
+
+			// The anchor text should be the filename, but we fall back to the link path
+			filename = strings.TrimSpace(filename)
+			filename, _, _ = strings.Cut(filename, "#")
+			filename, _, _ = strings.Cut(filename, "?")
+			filename = filename[strings.LastIndex(filename, "/")+1:]
+
+			if ver := versionFromFilename(packageName, filename); ver != "" {
+				releases[ver] = append(releases[ver], releaseFile{Yanked: yanked})
+			}
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			parseAnchors(c)
+		}
+	}
+	parseAnchors(doc)
+
+	return releases, nil
+}
+
+// versionFromFilename extracts the version from a distribution filename such as
+// "requests-2.31.0.tar.gz" or "requests-2.31.0-py3-none-any.whl".
+// It returns an empty string if the file doesn't belong to the package.
+func versionFromFilename(packageName, filename string) string {
+	var stem string
+	for _, extension := range simpleDistributionExtensions {
+		if strings.HasSuffix(filename, extension) {
+			stem = strings.TrimSuffix(filename, extension)
+			break
+		}
+	}
+
+	if stem == "" {
+		return ""
+	}
+
+	normalizedName := normalizeName(packageName)
+
+	// Wheel and egg filenames use "_" in place of "-" in the name, so the version is the
+	// component following the name. Sdist names may contain "-", so we look for the name prefix.
+	for i := range len(stem) {
+		if stem[i] != '-' || normalizeName(stem[:i]) != normalizedName {
+			continue
+		}
+
+		ver := stem[i+1:]
+		// PEP 440 versions, including the ones with an epoch, start with a digit
+		if ver == "" || ver[0] < '0' || ver[0] > '9' {
+			continue
+		}
+
+		if strings.HasSuffix(filename, ".whl") || strings.HasSuffix(filename, ".egg") {
+			ver, _, _ = strings.Cut(ver, "-")
+		}
+
+		return ver
+	}
+
+	return ""
+}
+
+// latestRelease returns the highest non-yanked stable release, as the JSON API does with info.version.
+func latestRelease(releases map[string][]releaseFile) string {
+	var versions []string
+	for ver, files := range releases {
+		if !isYanked(files) {
+			versions = append(versions, ver)
+		}
+	}
+
+	p := version.Pep440{Constraint: "*"}
+	if err := p.Search(versions); err != nil {
+		logrus.Debugf("searching the latest release: %s", err)
+		return ""
+	}
+
+	return p.FoundVersion.OriginalVersion
+}
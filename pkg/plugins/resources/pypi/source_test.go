@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/age"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
@@ -160,6 +161,77 @@ func TestSource(t *testing.T) {
 			// 1.0.post1 normalizes to 1.0.0, which is >= 1.0.0; original PEP 440 form returned
 			expectedResult: "1.0.post1",
 		},
+		{
+			name: "Latest version matching the minimum age",
+			spec: Spec{
+				Name:  "requests",
+				URL:   "https://pypi.example.com",
+				Token: "validtoken",
+				VersionFilter: version.Filter{
+					Kind: "latest",
+					Age:  age.Spec{Minimum: "7d"},
+				},
+			},
+			mockedBody:           releaseDatePackageData,
+			mockedURL:            "https://pypi.example.com/",
+			mockedToken:          "validtoken",
+			mockedHTTPStatusCode: 200,
+			expectedResult:       "2.29.0",
+		},
+		{
+			name: "Semver filter returns highest version matching the minimum age",
+			spec: Spec{
+				Name:  "requests",
+				URL:   "https://pypi.example.com",
+				Token: "validtoken",
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "*",
+					Age:     age.Spec{Minimum: "7d"},
+				},
+			},
+			mockedBody:           releaseDatePackageData,
+			mockedURL:            "https://pypi.example.com/",
+			mockedToken:          "validtoken",
+			mockedHTTPStatusCode: 200,
+			expectedResult:       "2.29.0",
+		},
+		{
+			name: "Every version cooling down",
+			spec: Spec{
+				Name:  "requests",
+				URL:   "https://pypi.example.com",
+				Token: "validtoken",
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "*",
+					Age:     age.Spec{Minimum: "100y"},
+				},
+			},
+			mockedBody:           releaseDatePackageData,
+			mockedURL:            "https://pypi.example.com/",
+			mockedToken:          "validtoken",
+			mockedHTTPStatusCode: 200,
+			expectedError:        true,
+		},
+		{
+			name: "Minimum age without upload dates reported",
+			spec: Spec{
+				Name:  "requests",
+				URL:   "https://pypi.example.com",
+				Token: "validtoken",
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "*",
+					Age:     age.Spec{Minimum: "7d"},
+				},
+			},
+			mockedBody:           existingPackageData,
+			mockedURL:            "https://pypi.example.com/",
+			mockedToken:          "validtoken",
+			mockedHTTPStatusCode: 200,
+			expectedError:        true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -85,6 +85,27 @@ const postReleasePackageData = `{
   }
 }`
 
+// releaseDatePackageData reports the upload date of release files, the latest version
+// being released in the future so it never matches a minimum age.
+const releaseDatePackageData = `{
+  "info": {
+    "name": "requests",
+    "version": "2.31.0",
+    "project_urls": {
+      "Source": "https://github.com/psf/requests"
+    }
+  },
+  "releases": {
+    "2.28.0": [{"yanked": false, "upload_time_iso_8601": "2022-06-29T15:12:44.175377Z"}],
+    "2.29.0": [
+      {"yanked": false, "upload_time_iso_8601": "2023-04-26T15:25:44.114379Z"},
+      {"yanked": false, "upload_time_iso_8601": "2023-04-26T15:25:46.020137Z"}
+    ],
+    "2.30.0": [{"yanked": false}],
+    "2.31.0": [{"yanked": false, "upload_time_iso_8601": "2999-01-01T00:00:00Z"}]
+  }
+}`
+
 const nonExistingPackageData = `{"message": "Not Found"}`
 
 // GetMockClient returns a MockClient that validates the URL prefix and Bearer token,
@@ -0,0 +1,122 @@
+package rubygems
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/result"
+
+	githubChangelog "github.com/updatecli/updatecli/pkg/plugins/changelog/github/v3"
+)
+
+// Changelog returns release notes for the gem between the from and to versions.
+// GitHub releases are used when the gem metadata points to a GitHub repository,
+// otherwise Updatecli falls back to the changelog url advertised by the gem.
+func (r *Rubygems) Changelog(from, to string) *result.Changelogs {
+	metadata, err := r.getMetadata(context.Background())
+	if err != nil {
+		logrus.Debugf("retrieving gem %q metadata: %s", r.spec.Name, err)
+		return nil
+	}
+
+	for _, uri := range []string{metadata.SourceCodeURI, metadata.ChangelogURI, metadata.HomepageURI} {
+		owner, repository, ok := parseGitHubURL(uri)
+		if !ok {
+			continue
+		}
+
+		if releases := changelogFromGitHub(owner, repository, from, to); releases != nil {
+			return releases
+		}
+
+		// Gem versions never have the "v" prefix but GitHub tags often do.
+		vfrom, vto := withVPrefix(from), withVPrefix(to)
+		if vfrom != from || vto != to {
+			if releases := changelogFromGitHub(owner, repository, vfrom, vto); releases != nil {
+				return releases
+			}
+		}
+
+		break
+	}
+
+	return r.changelogFromMetadata(metadata, from, to)
+}
+
+// parseGitHubURL extracts the owner and the repository from a GitHub url
+// such as https://github.com/rails/rails/tree/v7.1.0
+func parseGitHubURL(rawURL string) (owner, repository string, ok bool) {
+	url := strings.TrimPrefix(rawURL, "https://")
+	url = strings.TrimPrefix(url, "http://")
+	url = strings.TrimPrefix(url, "www.")
+
+	parts := strings.Split(url, "/")
+	if len(parts) < 3 || parts[0] != "github.com" || parts[1] == "" || parts[2] == "" {
+		return "", "", false
+	}
+
+	return parts[1], strings.TrimSuffix(parts[2], ".git"), true
+}
+
+// changelogFromGitHub queries the GitHub release API.
+func changelogFromGitHub(owner, repository, from, to string) *result.Changelogs {
+	changelog := githubChangelog.Changelog{
+		Owner:      owner,
+		Repository: repository,
+	}
+
+	releases, err := changelog.Search(from, to)
+	if err != nil {
+		logrus.Debugf("searching GitHub releases for %s/%s: %s", owner, repository, err)
+	}
+
+	if len(releases) == 0 {
+		return nil
+	}
+
+	return &releases
+}
+
+// changelogFromMetadata builds changelog entries pointing to the gem changelog,
+// or to the registry version page when the gem doesn't advertise one.
+func (r *Rubygems) changelogFromMetadata(metadata gemMetadata, from, to string) *result.Changelogs {
+	var changelogs result.Changelogs
+
+	versions := []string{from}
+	if to != from && to != "" {
+		versions = append(versions, to)
+	}
+
+	for _, ver := range versions {
+		if ver == "" {
+			continue
+		}
+
+		changelog := result.Changelog{
+			Title: ver,
+			URL:   fmt.Sprintf("%sgems/%s/versions/%s", r.spec.URL, r.spec.Name, ver),
+		}
+
+		if metadata.ChangelogURI != "" {
+			changelog.Body = fmt.Sprintf("Changelog available at %s", metadata.ChangelogURI)
+		}
+
+		changelogs = append(changelogs, changelog)
+	}
+
+	if len(changelogs) == 0 {
+		return nil
+	}
+
+	return &changelogs
+}
+
+// withVPrefix prepends "v" if the version string does not already have it.
+func withVPrefix(ver string) string {
+	if ver == "" || strings.HasPrefix(ver, "v") {
+		return ver
+	}
+	return "v" + ver
+}
@@ -0,0 +1,38 @@
+package rubygems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks that a gem version is published on the registry
+func (r *Rubygems) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		logrus.Warningf("SCM configuration is not supported for rubygems condition, aborting")
+	}
+
+	versionToCheck := r.spec.Version
+	if versionToCheck == "" {
+		versionToCheck = source
+	}
+
+	if versionToCheck == "" {
+		return false, "", errors.New("no version defined")
+	}
+
+	versions, err := r.getVersions(ctx, true)
+	if err != nil {
+		return false, "", err
+	}
+
+	if slices.Contains(versions, versionToCheck) {
+		return true, fmt.Sprintf("gem %q version %q available", r.spec.Name, versionToCheck), nil
+	}
+
+	return false, fmt.Sprintf("gem %q version %q doesn't exist", r.spec.Name, versionToCheck), nil
+}
@@ -0,0 +1,231 @@
+package rubygems
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/sirupsen/logrus"
+
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// rubygemsDefaultURL is the default RubyGems registry
+	rubygemsDefaultURL string = "https://rubygems.org/"
+)
+
+// Spec defines a specification for a "rubygems" resource
+// parsed from an updatecli manifest file
+type Spec struct {
+	// Name defines the gem name
+	//
+	// compatible:
+	//   * source
+	//   * condition
+	//
+	// example: rails
+	Name string `yaml:",omitempty" jsonschema:"required"`
+	// Version defines a specific gem version
+	//
+	// compatible:
+	//   * condition
+	//
+	// default: the source output is used when no version is specified
+	Version string `yaml:",omitempty"`
+	// URL defines the RubyGems compatible registry url
+	//
+	// compatible:
+	//   * source
+	//   * condition
+	//
+	// default: https://rubygems.org/
+	URL string `yaml:",omitempty"`
+	// Token defines the API key used to authenticate against the registry.
+	// It is sent as is in the Authorization header, as expected by the RubyGems API.
+	//
+	// compatible:
+	//   * source
+	//   * condition
+	Token string `yaml:",omitempty"`
+	// VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+	//
+	// compatible:
+	//   * source
+	//
+	// default: latest
+	VersionFilter version.Filter `yaml:",omitempty"`
+}
+
+// gemVersion is a single entry returned by the RubyGems versions API
+type gemVersion struct {
+	Number     string `json:"number"`
+	Platform   string `json:"platform"`
+	Prerelease bool   `json:"prerelease"`
+	CreatedAt  string `json:"created_at"`
+	Summary    string `json:"summary"`
+}
+
+// gemMetadata holds the subset of the RubyGems gem API used by Updatecli
+type gemMetadata struct {
+	Name          string `json:"name"`
+	Version       string `json:"version"`
+	ProjectURI    string `json:"project_uri"`
+	HomepageURI   string `json:"homepage_uri"`
+	ChangelogURI  string `json:"changelog_uri"`
+	SourceCodeURI string `json:"source_code_uri"`
+}
+
+// Rubygems defines a resource of kind "rubygems"
+type Rubygems struct {
+	spec Spec
+	// versionFilter holds the "valid" version.filter, that might be different than the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+	foundVersion  version.Version
+	webClient     httpclient.HTTPClient
+}
+
+// New returns a new valid Rubygems object.
+func New(spec interface{}) (*Rubygems, error) {
+	var newSpec Spec
+
+	err := mapstructure.Decode(spec, &newSpec)
+	if err != nil {
+		return &Rubygems{}, err
+	}
+
+	err = newSpec.Validate()
+	if err != nil {
+		return &Rubygems{}, err
+	}
+
+	if newSpec.URL == "" {
+		newSpec.URL = rubygemsDefaultURL
+	}
+
+	if !strings.HasSuffix(newSpec.URL, "/") {
+		newSpec.URL = newSpec.URL + "/"
+	}
+
+	newFilter, err := newSpec.VersionFilter.Init()
+	if err != nil {
+		return &Rubygems{}, err
+	}
+
+	return &Rubygems{
+		spec:          newSpec,
+		versionFilter: newFilter,
+		webClient:     httpclient.NewRetryClient(),
+	}, nil
+}
+
+// Validate run some validation on the Spec
+func (s *Spec) Validate() error {
+	if len(s.Name) == 0 {
+		logrus.Errorf("rubygems gem name not defined")
+		return errors.New("rubygems gem name not defined")
+	}
+	return nil
+}
+
+// ReportConfig returns a new configuration object with only the necessary fields
+// to identify the resource without any sensitive information or context specific data.
+func (r *Rubygems) ReportConfig() interface{} {
+	return Spec{
+		Name:          r.spec.Name,
+		Version:       r.spec.Version,
+		URL:           redact.URL(r.spec.URL),
+		VersionFilter: r.spec.VersionFilter,
+	}
+}
+
+// getVersions returns the gem versions, ordered from the oldest to the most recent one.
+// Platform specific builds are merged with their "ruby" counterpart.
+// Prereleases are discarded unless includePrerelease is set.
+func (r *Rubygems) getVersions(ctx context.Context, includePrerelease bool) ([]string, error) {
+	var data []gemVersion
+
+	err := r.get(ctx, fmt.Sprintf("api/v1/versions/%s.json", url.PathEscape(r.spec.Name)), &data)
+	if err != nil {
+		return nil, err
+	}
+
+	// The registry returns the most recent versions first
+	versions := []string{}
+	found := map[string]bool{}
+	for i := len(data) - 1; i >= 0; i-- {
+		if found[data[i].Number] {
+			continue
+		}
+
+		if data[i].Prerelease && !includePrerelease {
+			continue
+		}
+
+		found[data[i].Number] = true
+		versions = append(versions, data[i].Number)
+	}
+
+	return versions, nil
+}
+
+// getMetadata returns the gem metadata, for the latest version of the gem.
+func (r *Rubygems) getMetadata(ctx context.Context) (gemMetadata, error) {
+	var data gemMetadata
+
+	err := r.get(ctx, fmt.Sprintf("api/v1/gems/%s.json", url.PathEscape(r.spec.Name)), &data)
+	if err != nil {
+		return gemMetadata{}, err
+	}
+
+	return data, nil
+}
+
+// get queries the RubyGems API and decodes the json response into data
+func (r *Rubygems) get(ctx context.Context, endpoint string, data interface{}) error {
+	URL := r.spec.URL + endpoint
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL, nil)
+	if err != nil {
+		return fmt.Errorf("creating request for %q: %w", redact.URL(URL), err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if r.spec.Token != "" {
+		req.Header.Set("Authorization", r.spec.Token)
+	}
+
+	res, err := r.webClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("querying %q: %w", redact.URL(URL), err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("reading response from %q: %w", redact.URL(URL), err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("gem %q not found on %q", r.spec.Name, redact.URL(r.spec.URL))
+	}
+
+	if res.StatusCode >= 400 {
+		logrus.Debugf("\n%v\n", string(body))
+		return fmt.Errorf("querying %q: unexpected status code %d", redact.URL(URL), res.StatusCode)
+	}
+
+	if err := json.Unmarshal(body, data); err != nil {
+		return fmt.Errorf("decoding response from %q: %w", redact.URL(URL), err)
+	}
+
+	return nil
+}
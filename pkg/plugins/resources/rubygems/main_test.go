@@ -0,0 +1,233 @@
+package rubygems
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// versionsData mimics the payload returned by https://rubygems.org/api/v1/versions/<gem>.json,
+// where the most recent versions come first and precompiled builds are listed per platform.
+const versionsData = `[
+  {"number": "2.0.0.rc1", "platform": "ruby", "prerelease": true},
+  {"number": "1.16.0", "platform": "x86_64-linux", "prerelease": false},
+  {"number": "1.16.0", "platform": "ruby", "prerelease": false},
+  {"number": "1.15.2", "platform": "ruby", "prerelease": false},
+  {"number": "1.9.0", "platform": "ruby", "prerelease": false}
+]`
+
+const metadataData = `{
+  "name": "demo",
+  "version": "1.16.0",
+  "project_uri": "https://rubygems.org/gems/demo",
+  "homepage_uri": "https://demo.example.com",
+  "changelog_uri": "https://demo.example.com/CHANGELOG.md",
+  "source_code_uri": ""
+}`
+
+func newTestServer(t *testing.T, token string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/versions/demo.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != token {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(versionsData))
+	})
+	mux.HandleFunc("/api/v1/gems/demo.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(metadataData))
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Spec{})
+	assert.ErrorContains(t, err, "gem name not defined")
+
+	r, err := New(Spec{Name: "rails"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://rubygems.org/", r.spec.URL)
+
+	r, err = New(Spec{Name: "rails", URL: "https://gems.example.com/private"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://gems.example.com/private/", r.spec.URL)
+}
+
+func TestSource(t *testing.T) {
+	tests := []struct {
+		name           string
+		spec           map[string]any
+		serverToken    string
+		expectedResult string
+		wantErr        string
+	}{
+		{
+			name:           "latest version ignores prerelease",
+			spec:           map[string]any{"name": "demo"},
+			expectedResult: "1.16.0",
+		},
+		{
+			name: "semver version filter",
+			spec: map[string]any{
+				"name": "demo",
+				"versionfilter": map[string]any{
+					"kind":    "semver",
+					"pattern": "~1.15",
+				},
+			},
+			expectedResult: "1.15.2",
+		},
+		{
+			name: "authenticated registry",
+			spec: map[string]any{
+				"name":  "demo",
+				"token": "secret",
+			},
+			serverToken:    "secret",
+			expectedResult: "1.16.0",
+		},
+		{
+			name:        "missing token",
+			spec:        map[string]any{"name": "demo"},
+			serverToken: "secret",
+			wantErr:     "unexpected status code 401",
+		},
+		{
+			name:    "unknown gem",
+			spec:    map[string]any{"name": "unknown"},
+			wantErr: `gem "unknown" not found`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, tt.serverToken)
+			defer server.Close()
+
+			tt.spec["url"] = server.URL
+			r, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = r.Source(context.Background(), "", &gotResult)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, gotResult.Information)
+			assert.Equal(t, result.SUCCESS, gotResult.Result)
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         map[string]any
+		source       string
+		expectedPass bool
+		wantErr      string
+	}{
+		{
+			name:         "version from source exists",
+			spec:         map[string]any{"name": "demo"},
+			source:       "1.15.2",
+			expectedPass: true,
+		},
+		{
+			name:         "prerelease exists",
+			spec:         map[string]any{"name": "demo", "version": "2.0.0.rc1"},
+			expectedPass: true,
+		},
+		{
+			name:   "version doesn't exist",
+			spec:   map[string]any{"name": "demo"},
+			source: "3.0.0",
+		},
+		{
+			name:    "no version",
+			spec:    map[string]any{"name": "demo"},
+			wantErr: "no version defined",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, "")
+			defer server.Close()
+
+			tt.spec["url"] = server.URL
+			r, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotPass, _, err := r.Condition(context.Background(), tt.source, nil)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPass, gotPass)
+		})
+	}
+}
+
+func TestChangelog(t *testing.T) {
+	server := newTestServer(t, "")
+	defer server.Close()
+
+	r, err := New(Spec{Name: "demo", URL: server.URL})
+	require.NoError(t, err)
+
+	got := r.Changelog("1.15.2", "1.16.0")
+	require.NotNil(t, got)
+
+	assert.Equal(t, result.Changelogs{
+		{
+			Title: "1.15.2",
+			Body:  "Changelog available at https://demo.example.com/CHANGELOG.md",
+			URL:   server.URL + "/gems/demo/versions/1.15.2",
+		},
+		{
+			Title: "1.16.0",
+			Body:  "Changelog available at https://demo.example.com/CHANGELOG.md",
+			URL:   server.URL + "/gems/demo/versions/1.16.0",
+		},
+	}, *got)
+}
+
+func TestParseGitHubURL(t *testing.T) {
+	tests := []struct {
+		url            string
+		expectedOwner  string
+		expectedRepo   string
+		expectedParsed bool
+	}{
+		{url: "https://github.com/rails/rails/tree/v7.1.0", expectedOwner: "rails", expectedRepo: "rails", expectedParsed: true},
+		{url: "https://github.com/sparklemotion/nokogiri.git", expectedOwner: "sparklemotion", expectedRepo: "nokogiri", expectedParsed: true},
+		{url: "https://gitlab.com/gitlab-org/gitlab"},
+		{url: "https://github.com/rails"},
+		{url: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			owner, repo, ok := parseGitHubURL(tt.url)
+			assert.Equal(t, tt.expectedParsed, ok)
+			assert.Equal(t, tt.expectedOwner, owner)
+			assert.Equal(t, tt.expectedRepo, repo)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package rubygems
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Source returns the latest gem version matching the version filter
+func (r *Rubygems) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	// The latest version kind doesn't know anything about prerelease
+	// so we must discard them ourselves.
+	versions, err := r.getVersions(ctx, r.versionFilter.Kind != version.LATESTVERSIONKIND)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 {
+		return fmt.Errorf("no version found for gem %q", r.spec.Name)
+	}
+
+	r.foundVersion, err = r.versionFilter.Search(versions)
+	if err != nil {
+		return err
+	}
+
+	resultSource.Information = r.foundVersion.GetVersion()
+	resultSource.Result = result.SUCCESS
+	resultSource.Description = fmt.Sprintf("version %s found for gem %q", r.foundVersion.GetVersion(), r.spec.Name)
+
+	return nil
+}
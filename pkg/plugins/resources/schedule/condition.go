@@ -0,0 +1,38 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks if the current time matches one of the cron expressions or windows
+func (s *Schedule) Condition(_ context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		logrus.Debugln("scm is not supported")
+	}
+
+	if source != "" {
+		logrus.Debugf("source input %q is ignored by the schedule condition", source)
+	}
+
+	now := s.now().In(s.location)
+	formattedNow := now.Format(time.RFC1123)
+
+	for i, c := range s.crons {
+		if cronMatches(c, now) {
+			return true, fmt.Sprintf("current time %s matches the cron expression %q", formattedNow, s.spec.Cron[i]), nil
+		}
+	}
+
+	for _, w := range s.windows {
+		if w.contains(now) {
+			return true, fmt.Sprintf("current time %s is inside the window %s", formattedNow, w), nil
+		}
+	}
+
+	return false, fmt.Sprintf("current time %s is outside of the schedule", formattedNow), nil
+}
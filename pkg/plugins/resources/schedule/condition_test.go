@@ -0,0 +1,143 @@
+package schedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCondition(t *testing.T) {
+	brussels, err := time.LoadLocation("Europe/Brussels")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		spec         Spec
+		now          time.Time
+		expectedPass bool
+	}{
+		{
+			name:         "cron expression matching",
+			spec:         Spec{Cron: []string{"* 9-17 * * 1-5"}},
+			now:          time.Date(2024, time.November, 13, 10, 42, 30, 0, time.UTC),
+			expectedPass: true,
+		},
+		{
+			name: "cron expression not matching on weekend",
+			spec: Spec{Cron: []string{"* 9-17 * * 1-5"}},
+			now:  time.Date(2024, time.November, 16, 10, 42, 0, 0, time.UTC),
+		},
+		{
+			name: "cron expression not matching on the next minute",
+			spec: Spec{Cron: []string{"30 4 * * *"}},
+			now:  time.Date(2024, time.November, 13, 4, 31, 0, 0, time.UTC),
+		},
+		{
+			name:         "cron expression evaluated in the time zone",
+			spec:         Spec{Cron: []string{"* 9 * * *"}, Timezone: "Europe/Brussels"},
+			now:          time.Date(2024, time.November, 13, 8, 15, 0, 0, time.UTC),
+			expectedPass: true,
+		},
+		{
+			name:         "cron expression with its own time zone",
+			spec:         Spec{Cron: []string{"CRON_TZ=UTC * 8 * * *"}, Timezone: "Europe/Brussels"},
+			now:          time.Date(2024, time.November, 13, 8, 15, 0, 0, time.UTC),
+			expectedPass: true,
+		},
+		{
+			name:         "second cron expression matching",
+			spec:         Spec{Cron: []string{"0 0 1 1 *", "*/15 * * * *"}},
+			now:          time.Date(2024, time.November, 13, 8, 45, 59, 0, time.UTC),
+			expectedPass: true,
+		},
+		{
+			name:         "window matching",
+			spec:         Spec{Windows: []Window{{Days: []string{"wednesday"}, Start: "08:00", End: "18:00"}}},
+			now:          time.Date(2024, time.November, 13, 8, 0, 0, 0, time.UTC),
+			expectedPass: true,
+		},
+		{
+			name: "window end is excluded",
+			spec: Spec{Windows: []Window{{Days: []string{"wednesday"}, Start: "08:00", End: "18:00"}}},
+			now:  time.Date(2024, time.November, 13, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "window on another day",
+			spec: Spec{Windows: []Window{{Days: []string{"mon", "tue"}, Start: "08:00", End: "18:00"}}},
+			now:  time.Date(2024, time.November, 13, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "window every day until midnight",
+			spec:         Spec{Windows: []Window{{Start: "20:00"}}},
+			now:          time.Date(2024, time.November, 13, 23, 59, 59, 0, time.UTC),
+			expectedPass: true,
+		},
+		{
+			name:         "window evaluated in the time zone",
+			spec:         Spec{Windows: []Window{{Days: []string{"thursday"}, End: "02:00"}}, Timezone: "Europe/Brussels"},
+			now:          time.Date(2024, time.November, 13, 23, 30, 0, 0, time.UTC),
+			expectedPass: true,
+		},
+		{
+			name:         "overnight window before midnight",
+			spec:         Spec{Windows: []Window{{Days: []string{"friday"}, Start: "22:00", End: "02:00"}}},
+			now:          time.Date(2024, time.November, 15, 23, 0, 0, 0, time.UTC),
+			expectedPass: true,
+		},
+		{
+			name:         "overnight window after midnight",
+			spec:         Spec{Windows: []Window{{Days: []string{"friday"}, Start: "22:00", End: "02:00"}}},
+			now:          time.Date(2024, time.November, 16, 1, 0, 0, 0, time.UTC),
+			expectedPass: true,
+		},
+		{
+			name: "overnight window after midnight of the wrong day",
+			spec: Spec{Windows: []Window{{Days: []string{"friday"}, Start: "22:00", End: "02:00"}}},
+			now:  time.Date(2024, time.November, 15, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "overnight window during the day",
+			spec: Spec{Windows: []Window{{Start: "22:00", End: "02:00"}}},
+			now:  time.Date(2024, time.November, 15, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "overnight window from saturday to sunday",
+			spec:         Spec{Windows: []Window{{Days: []string{"sat"}, Start: "22:00", End: "02:00"}}},
+			now:          time.Date(2024, time.November, 17, 1, 0, 0, 0, brussels),
+			expectedPass: true,
+		},
+		{
+			name:         "cron expression or window",
+			spec:         Spec{Cron: []string{"0 0 1 1 *"}, Windows: []Window{{Start: "10:00", End: "11:00"}}},
+			now:          time.Date(2024, time.November, 13, 10, 30, 0, 0, time.UTC),
+			expectedPass: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := New(tt.spec)
+			require.NoError(t, err)
+
+			s.now = func() time.Time { return tt.now }
+
+			gotPass, message, err := s.Condition(context.Background(), "", nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPass, gotPass, message)
+		})
+	}
+}
+
+func TestConditionMessage(t *testing.T) {
+	s, err := New(Spec{Windows: []Window{{Days: []string{"Wed", "mon"}, Start: "08:00", End: "18:00"}}})
+	require.NoError(t, err)
+
+	s.now = func() time.Time { return time.Date(2024, time.November, 13, 9, 0, 0, 0, time.UTC) }
+
+	_, message, err := s.Condition(context.Background(), "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "current time Wed, 13 Nov 2024 09:00:00 UTC is inside the window Monday, Wednesday from 08:00 to 18:00", message)
+}
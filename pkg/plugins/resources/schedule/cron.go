@@ -0,0 +1,35 @@
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// parseCron parses a standard cron expression.
+// The expression is evaluated in the provided location, unless it defines its own with the CRON_TZ prefix.
+func parseCron(expression string, location *time.Location) (*cron.SpecSchedule, error) {
+	schedule, err := cron.ParseStandard(expression)
+	if err != nil {
+		return nil, fmt.Errorf("parsing cron expression %q: %w", expression, err)
+	}
+
+	spec, ok := schedule.(*cron.SpecSchedule)
+	if !ok {
+		return nil, fmt.Errorf("cron expression %q doesn't define a time of the day, such as @every", expression)
+	}
+
+	if location != nil && !strings.HasPrefix(expression, "CRON_TZ=") && !strings.HasPrefix(expression, "TZ=") {
+		spec.Location = location
+	}
+
+	return spec, nil
+}
+
+// cronMatches returns true if the minute of t is part of the cron schedule
+func cronMatches(schedule *cron.SpecSchedule, t time.Time) bool {
+	minute := t.In(schedule.Location).Truncate(time.Minute)
+	return schedule.Next(minute.Add(-time.Second)).Equal(minute)
+}
@@ -0,0 +1,87 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/robfig/cron/v3"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// Schedule defines a resource of kind "schedule"
+type Schedule struct {
+	spec     Spec
+	location *time.Location
+	crons    []*cron.SpecSchedule
+	windows  []window
+	// now returns the current time, it's replaced by a fake clock in tests
+	now func() time.Time
+}
+
+// New returns a reference to a newly initialized Schedule object from a Spec
+// or an error if the provided Spec triggers a validation error.
+func New(spec interface{}) (*Schedule, error) {
+	newSpec := Spec{}
+
+	err := mapstructure.Decode(spec, &newSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	err = newSpec.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := loadLocation(newSpec.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	newResource := &Schedule{
+		spec:     newSpec,
+		location: location,
+		now:      time.Now,
+	}
+
+	for _, expression := range newSpec.Cron {
+		c, err := parseCron(expression, location)
+		if err != nil {
+			return nil, err
+		}
+		newResource.crons = append(newResource.crons, c)
+	}
+
+	for _, w := range newSpec.Windows {
+		parsedWindow, err := parseWindow(w)
+		if err != nil {
+			return nil, err
+		}
+		newResource.windows = append(newResource.windows, parsedWindow)
+	}
+
+	return newResource, nil
+}
+
+// loadLocation returns the time zone identified by its IANA name, UTC by default
+func loadLocation(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return time.UTC, nil
+	}
+
+	return time.LoadLocation(timezone)
+}
+
+// Changelog returns the changelog for this resource, or an empty string if not supported
+func (s *Schedule) Changelog(from, to string) *result.Changelogs {
+	return nil
+}
+
+// ReportConfig returns a new configuration without any sensitive information or context specific information.
+func (s *Schedule) ReportConfig() interface{} {
+	return Spec{
+		Cron:     s.spec.Cron,
+		Windows:  s.spec.Windows,
+		Timezone: s.spec.Timezone,
+	}
+}
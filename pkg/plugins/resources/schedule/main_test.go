@@ -0,0 +1,85 @@
+package schedule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    Spec
+		wantErr bool
+	}{
+		{
+			name: "cron expression",
+			spec: Spec{Cron: []string{"* 9-17 * * 1-5"}},
+		},
+		{
+			name: "cron descriptor with time zone",
+			spec: Spec{Cron: []string{"@daily"}, Timezone: "Europe/Brussels"},
+		},
+		{
+			name: "window",
+			spec: Spec{Windows: []Window{{Days: []string{"Monday", "tue"}, Start: "08:00", End: "18:30"}}},
+		},
+		{
+			name: "overnight window",
+			spec: Spec{Windows: []Window{{Start: "22:00", End: "02:00"}}},
+		},
+		{
+			name:    "empty spec",
+			spec:    Spec{},
+			wantErr: true,
+		},
+		{
+			name:    "invalid cron expression",
+			spec:    Spec{Cron: []string{"* * *"}},
+			wantErr: true,
+		},
+		{
+			name:    "cron expression without a time of the day",
+			spec:    Spec{Cron: []string{"@every 1h"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid time zone",
+			spec:    Spec{Cron: []string{"@daily"}, Timezone: "Mars/Olympus_Mons"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown day",
+			spec:    Spec{Windows: []Window{{Days: []string{"caturday"}}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid time format",
+			spec:    Spec{Windows: []Window{{Start: "8am"}}},
+			wantErr: true,
+		},
+		{
+			name:    "time out of range",
+			spec:    Spec{Windows: []Window{{End: "24:30"}}},
+			wantErr: true,
+		},
+		{
+			name:    "empty window",
+			spec:    Spec{Windows: []Window{{Start: "10:00", End: "10:00"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.spec)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.spec, got.ReportConfig())
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// Source is not supported for the schedule resource
+func (s *Schedule) Source(_ context.Context, workingDir string, resultSource *result.Source) error {
+	return fmt.Errorf("Source not supported for the plugin schedule")
+}
@@ -0,0 +1,91 @@
+package schedule
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Spec defines a specification for a "schedule" resource parsed from an updatecli manifest file
+type Spec struct {
+	// Cron defines a list of cron expressions.
+	// The condition passes when the current time matches at least one of them, at the minute precision.
+	//
+	// Compatible:
+	//   * condition
+	//
+	// Example:
+	//   * "* 9-17 * * 1-5" matches every minute from 9:00 to 17:59, Monday to Friday
+	//
+	// Remark:
+	//   Expressions use the standard five fields format "minute hour day-of-month month day-of-week",
+	//   descriptors such as "@daily" are also accepted.
+	Cron []string `yaml:",omitempty"`
+	// Windows defines a list of time windows repeated every week.
+	// The condition passes when the current time is inside at least one of them.
+	//
+	// Compatible:
+	//   * condition
+	Windows []Window `yaml:",omitempty"`
+	// Timezone defines the IANA time zone used to evaluate the cron expressions and the windows, such as "Europe/Brussels".
+	//
+	// Compatible:
+	//   * condition
+	//
+	// Default:
+	//   UTC
+	Timezone string `yaml:",omitempty"`
+}
+
+// Window defines a time window repeated on specific days of the week
+type Window struct {
+	// Days defines the days of the week of the window, such as "monday" or "mon".
+	//
+	// Default:
+	//   Every day
+	Days []string `yaml:",omitempty"`
+	// Start defines the time the window starts, included, using the "HH:MM" format.
+	//
+	// Default:
+	//   "00:00"
+	Start string `yaml:",omitempty"`
+	// End defines the time the window ends, excluded, using the "HH:MM" format.
+	//
+	// Default:
+	//   "24:00"
+	//
+	// Remark:
+	//   When end is before start, the window spans midnight and days refer to the day the window starts,
+	//   so a window from "22:00" to "02:00" on "friday" ends on Saturday at 2:00.
+	End string `yaml:",omitempty"`
+}
+
+// Validate validates the specification and returns an error if it's invalid
+func (s *Spec) Validate() error {
+	var validationErrors []string
+
+	if len(s.Cron) == 0 && len(s.Windows) == 0 {
+		validationErrors = append(validationErrors, "at least one of the attributes `spec.cron` or `spec.windows` is required.")
+	}
+
+	if _, err := loadLocation(s.Timezone); err != nil {
+		validationErrors = append(validationErrors, fmt.Sprintf("the attribute `spec.timezone` is invalid: %s.", err))
+	}
+
+	for _, expression := range s.Cron {
+		if _, err := parseCron(expression, nil); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("the attribute `spec.cron` is invalid: %s.", err))
+		}
+	}
+
+	for i, w := range s.Windows {
+		if _, err := parseWindow(w); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("the attribute `spec.windows[%d]` is invalid: %s.", i, err))
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return fmt.Errorf("validation error: the provided manifest configuration had the following validation errors:\n%s", strings.Join(validationErrors, "\n\n"))
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// weekdays maps the accepted day names to their weekday
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"sun":       time.Sunday,
+	"monday":    time.Monday,
+	"mon":       time.Monday,
+	"tuesday":   time.Tuesday,
+	"tue":       time.Tuesday,
+	"wednesday": time.Wednesday,
+	"wed":       time.Wednesday,
+	"thursday":  time.Thursday,
+	"thu":       time.Thursday,
+	"friday":    time.Friday,
+	"fri":       time.Friday,
+	"saturday":  time.Saturday,
+	"sat":       time.Saturday,
+}
+
+// window is the parsed form of a Window
+type window struct {
+	// days holds the days of the week of the window, every day if empty
+	days map[time.Weekday]bool
+	// start and end are durations since midnight
+	start time.Duration
+	end   time.Duration
+}
+
+// parseWindow validates and parses a window definition
+func parseWindow(w Window) (window, error) {
+	result := window{
+		days: make(map[time.Weekday]bool),
+		end:  24 * time.Hour,
+	}
+
+	for _, day := range w.Days {
+		weekday, ok := weekdays[strings.ToLower(strings.TrimSpace(day))]
+		if !ok {
+			return window{}, fmt.Errorf("unknown day %q", day)
+		}
+		result.days[weekday] = true
+	}
+
+	var err error
+	if w.Start != "" {
+		result.start, err = parseTimeOfDay(w.Start)
+		if err != nil {
+			return window{}, fmt.Errorf("parsing start: %w", err)
+		}
+	}
+
+	if w.End != "" {
+		result.end, err = parseTimeOfDay(w.End)
+		if err != nil {
+			return window{}, fmt.Errorf("parsing end: %w", err)
+		}
+	}
+
+	if result.start == result.end {
+		return window{}, fmt.Errorf("start and end are identical")
+	}
+
+	return result, nil
+}
+
+// parseTimeOfDay parses a "HH:MM" time of the day, from "00:00" to "24:00", and returns the duration since midnight
+func parseTimeOfDay(value string) (time.Duration, error) {
+	var hours, minutes int
+	if _, err := fmt.Sscanf(value, "%d:%d", &hours, &minutes); err != nil {
+		return 0, fmt.Errorf("time %q doesn't use the HH:MM format", value)
+	}
+
+	if hours < 0 || minutes < 0 || minutes > 59 || hours > 24 || (hours == 24 && minutes > 0) {
+		return 0, fmt.Errorf("time %q is out of range", value)
+	}
+
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute, nil
+}
+
+// isDay returns true if the window applies to the weekday
+func (w window) isDay(weekday time.Weekday) bool {
+	return len(w.days) == 0 || w.days[weekday]
+}
+
+// contains returns true if t is inside the window, t being already in the expected location
+func (w window) contains(t time.Time) bool {
+	sinceMidnight := time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second
+
+	if w.start < w.end {
+		return w.isDay(t.Weekday()) && sinceMidnight >= w.start && sinceMidnight < w.end
+	}
+
+	// The window spans midnight, so the early hours belong to the window started the day before
+	if sinceMidnight >= w.start {
+		return w.isDay(t.Weekday())
+	}
+
+	if sinceMidnight < w.end {
+		return w.isDay((t.Weekday() + 6) % 7)
+	}
+
+	return false
+}
+
+// String returns a human readable description of the window
+func (w window) String() string {
+	days := "every day"
+	if len(w.days) > 0 {
+		var names []string
+		for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+			if w.days[weekday] {
+				names = append(names, weekday.String())
+			}
+		}
+		days = strings.Join(names, ", ")
+	}
+
+	return fmt.Sprintf("%s from %s to %s", days, formatTimeOfDay(w.start), formatTimeOfDay(w.end))
+}
+
+// formatTimeOfDay returns the "HH:MM" representation of a duration since midnight
+func formatTimeOfDay(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
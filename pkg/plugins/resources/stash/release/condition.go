@@ -6,18 +6,18 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
-	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (g *Stash) Condition(_ context.Context, source string, scm scm.ScmHandler, resultCondition *result.Condition) error {
+// Condition checks that a Bitbucket Server release tag exists
+func (g *Stash) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
 
 	if scm != nil {
 		logrus.Warningf("scm not supported, ignoring")
 	}
 
-	releases, err := g.SearchReleases()
+	releases, err := g.SearchReleases(ctx)
 	if err != nil {
-		return fmt.Errorf("looking for releases: %w", err)
+		return false, "", fmt.Errorf("looking for releases: %w", err)
 	}
 
 	release := source
@@ -26,29 +26,18 @@ func (g *Stash) Condition(_ context.Context, source string, scm scm.ScmHandler,
 	}
 
 	if len(releases) == 0 {
-		resultCondition.Result = result.FAILURE
-		resultCondition.Pass = false
-		resultCondition.Description = fmt.Sprintf("no Bitbucket release found for repository %s/%s", g.spec.Owner, g.spec.Repository)
-		return nil
+		return false, fmt.Sprintf("no Bitbucket release found for repository %s/%s", g.spec.Owner, g.spec.Repository), nil
 	}
 
 	for _, r := range releases {
-		if r == g.spec.Tag {
-			resultCondition.Result = result.SUCCESS
-			resultCondition.Pass = true
-			resultCondition.Description = fmt.Sprintf("bitbucket release tag %q found", release)
-			return nil
+		if r == release {
+			return true, fmt.Sprintf("Bitbucket release tag %q found", release), nil
 		}
 	}
 
-	resultCondition.Result = result.FAILURE
-	resultCondition.Pass = false
-	resultCondition.Description = fmt.Sprintf("no Bitbucket Release tag found matching %q for repository %s%s",
+	return false, fmt.Sprintf("no Bitbucket release tag found matching %q for repository %s/%s",
 		release,
 		g.spec.Owner,
 		g.spec.Repository,
-	)
-
-	return nil
-
+	), nil
 }
@@ -2,18 +2,26 @@ package release
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/url"
 	"strings"
 	"time"
 
-	"github.com/drone/go-scm/scm"
+	goscm "github.com/drone/go-scm/scm"
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/stash/client"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
-// Spec defines settings used to interact with Bitbucket release
+// Spec defines settings used to interact with Bitbucket Server releases.
+//
+// Bitbucket Server doesn't have a release concept, a release is
+// represented by an annotated git tag whose message contains the release
+// title and description. Lightweight tags are ignored, use the stash/tag
+// kind to handle them.
 type Spec struct {
 	client.Spec `yaml:",inline,omitempty"`
 	// [S][C][T] owner specifies repository owner
@@ -22,18 +30,14 @@ type Spec struct {
 	Repository string `yaml:",omitempty" jsonschema:"required"`
 	// [S] versionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
 	VersionFilter version.Filter `yaml:",omitempty"`
-	// [T] title defines the Bitbucket release title.
+	// [T] title defines the Bitbucket release title, used as the first line of the tag message.
 	Title string `yaml:",omitempty"`
 	// [C][T] tag defines the Bitbucket release tag.
 	Tag string `yaml:",omitempty"`
 	// [T] commitish defines the commit-ish such as `main`
 	Commitish string `yaml:",omitempty"`
-	// [T] description defines if the new release description
+	// [T] description defines the new release description, appended to the tag message.
 	Description string `yaml:",omitempty"`
-	// [T] draft defines if the release is a draft release
-	Draft bool `yaml:",omitempty"`
-	// [T] prerelease defines if the release is a pre-release release
-	Prerelease bool `yaml:",omitempty"`
 }
 
 const (
@@ -67,7 +71,7 @@ func New(spec interface{}) (*Stash, error) {
 
 	err = mapstructure.Decode(spec, &s)
 	if err != nil {
-		return &Stash{}, nil
+		return &Stash{}, err
 	}
 
 	err = clientSpec.Validate()
@@ -109,41 +113,88 @@ func New(spec interface{}) (*Stash, error) {
 	return &g, nil
 }
 
-// Retrieve git tags from a remote bitbucket repository
-func (g *Stash) SearchReleases() ([]string, error) {
+// tagsPage is a page of the Bitbucket Server tags API
+type tagsPage struct {
+	Values []struct {
+		DisplayID    string `json:"displayId"`
+		LatestCommit string `json:"latestCommit"`
+		// Hash is the tag object hash, only set for annotated tags
+		Hash string `json:"hash"`
+	} `json:"values"`
+	IsLastPage    bool `json:"isLastPage"`
+	NextPageStart int  `json:"nextPageStart"`
+}
 
-	ctx := context.Background()
+// SearchReleases retrieves every release tag from a remote Bitbucket Server repository,
+// sorted from the oldest to the most recent one.
+// Only annotated tags are considered as releases, lightweight tags are ignored.
+func (g *Stash) SearchReleases(ctx context.Context) ([]string, error) {
 	// Timeout api query after 30sec
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	releases, resp, err := g.client.Releases.List(
-		ctx,
-		strings.Join([]string{g.spec.Owner, g.spec.Repository}, "/"),
-		scm.ReleaseListOptions{
-			Page:   1,
-			Size:   30,
-			Open:   true,
-			Closed: true,
-		},
-	)
+	// The tags API is queried directly as the go-scm Bitbucket Server driver
+	// doesn't expose the tag object hash, needed to identify annotated tags.
+	references := []string{}
+	start := 0
+	for {
+		page, err := g.listTags(ctx, start)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range page.Values {
+			if tag.Hash == "" || tag.Hash == tag.LatestCommit {
+				logrus.Debugf("ignoring lightweight tag %q", tag.DisplayID)
+				continue
+			}
+			references = append(references, tag.DisplayID)
+		}
 
+		if page.IsLastPage || page.NextPageStart <= start {
+			break
+		}
+		start = page.NextPageStart
+	}
+
+	// Bitbucket Server returns the most recently modified tags first
+	results := []string{}
+	for i := len(references) - 1; i >= 0; i-- {
+		results = append(results, references[i])
+	}
+
+	return results, nil
+}
+
+// listTags retrieves a page of tags starting at the given index
+func (g *Stash) listTags(ctx context.Context, start int) (*tagsPage, error) {
+	resp, err := (*goscm.Client)(g.client).Do(ctx, &goscm.Request{
+		Method: "GET",
+		Path: fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/tags?start=%d&limit=100",
+			url.PathEscape(g.spec.Owner),
+			url.PathEscape(g.spec.Repository),
+			start),
+		Header: map[string][]string{
+			"Accept": {"application/json"},
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	if resp.Status > 400 {
-		logrus.Debugf("Bitbucket Api Response:\n%+v", resp)
+	if resp.Status >= 400 {
+		b, _ := io.ReadAll(resp.Body)
+		logrus.Debugf("RC: %d\nBody:\n%s", resp.Status, b)
+		return nil, fmt.Errorf("error from Bitbucket api: %v", resp.Status)
 	}
 
-	results := []string{}
-	for i := len(releases) - 1; i >= 0; i-- {
-		if !releases[i].Draft {
-			results = append(results, releases[i].Tag)
-		}
+	page := tagsPage{}
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("decoding Bitbucket tags: %w", err)
 	}
 
-	return results, nil
+	return &page, nil
 }
 
 func (s Spec) Validate() error {
@@ -187,8 +238,7 @@ func (s *Stash) ReportConfig() interface{} {
 		VersionFilter: s.spec.VersionFilter,
 		Title:         s.spec.Title,
 		Tag:           s.spec.Tag,
-		Draft:         s.spec.Draft,
-		Prerelease:    s.spec.Prerelease,
+		Commitish:     s.spec.Commitish,
 		Description:   s.spec.Description,
 	}
 }
@@ -0,0 +1,338 @@
+package release
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+const tagsEndpoint = "/rest/api/1.0/projects/updatecli/repos/demo/tags"
+
+// fakeBitbucketServer is a minimal stand-in of the Bitbucket Server tags API.
+// Tags are returned from the most recent to the oldest one, as Bitbucket Server does.
+// Tags are annotated unless listed in lightweight.
+type fakeBitbucketServer struct {
+	mu          sync.Mutex
+	tags        []string
+	lightweight map[string]bool
+	created     []createTagRequest
+}
+
+func (f *fakeBitbucketServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != tagsEndpoint {
+		http.Error(w, `{"errors":[{"message":"not found"}]}`, http.StatusNotFound)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil || limit == 0 {
+			limit = 25
+		}
+
+		end := min(start+limit, len(f.tags))
+		values := []map[string]any{}
+		for _, tag := range f.tags[min(start, end):end] {
+			value := map[string]any{
+				"id":           "refs/tags/" + tag,
+				"displayId":    tag,
+				"latestCommit": "0123456789abcdef",
+				"hash":         "fedcba9876543210",
+			}
+			if f.lightweight[tag] {
+				value["hash"] = nil
+			}
+			values = append(values, value)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"values":        values,
+			"start":         start,
+			"size":          len(values),
+			"limit":         limit,
+			"isLastPage":    end >= len(f.tags),
+			"nextPageStart": end,
+		})
+
+	case http.MethodPost:
+		var body createTagRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		for _, tag := range f.tags {
+			if tag == body.Name {
+				http.Error(w, `{"errors":[{"message":"tag already exists"}]}`, http.StatusConflict)
+				return
+			}
+		}
+
+		f.created = append(f.created, body)
+		f.tags = append([]string{body.Name}, f.tags...)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"id":        "refs/tags/" + body.Name,
+			"displayId": body.Name,
+		})
+
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestStash(t *testing.T, serverURL string, spec map[string]any) *Stash {
+	t.Helper()
+
+	manifest := map[string]any{
+		"url":        serverURL,
+		"owner":      "updatecli",
+		"repository": "demo",
+	}
+	for k, v := range spec {
+		manifest[k] = v
+	}
+
+	s, err := New(manifest)
+	require.NoError(t, err)
+
+	return s
+}
+
+func TestSource(t *testing.T) {
+	tests := []struct {
+		name            string
+		tags            []string
+		lightweight     map[string]bool
+		spec            map[string]any
+		wantInformation string
+		wantErr         string
+	}{
+		{
+			name:            "latest release",
+			tags:            []string{"v1.2.0", "v1.10.0", "v1.1.0"},
+			wantInformation: "v1.2.0",
+		},
+		{
+			name: "semver release",
+			tags: []string{"v2.0.0-rc1", "v1.10.0", "v1.2.0"},
+			spec: map[string]any{
+				"versionfilter": map[string]any{
+					"kind":    "semver",
+					"pattern": "~1",
+				},
+			},
+			wantInformation: "v1.10.0",
+		},
+		{
+			name:            "lightweight tags are ignored",
+			tags:            []string{"v1.3.0", "v1.2.0", "v1.1.0"},
+			lightweight:     map[string]bool{"v1.3.0": true},
+			wantInformation: "v1.2.0",
+		},
+		{
+			name:        "only lightweight tags",
+			tags:        []string{"v1.1.0"},
+			lightweight: map[string]bool{"v1.1.0": true},
+			wantErr:     "no Bitbucket Release found",
+		},
+		{
+			name:    "no release",
+			wantErr: "no Bitbucket Release found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(&fakeBitbucketServer{tags: tt.tags, lightweight: tt.lightweight})
+			defer server.Close()
+
+			s := newTestStash(t, server.URL, tt.spec)
+
+			gotResult := result.Source{}
+			err := s.Source(context.Background(), "", &gotResult)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantInformation, gotResult.Information)
+			assert.Equal(t, result.SUCCESS, gotResult.Result)
+		})
+	}
+}
+
+func TestSearchReleasesPagination(t *testing.T) {
+	tags := []string{}
+	for i := 149; i >= 0; i-- {
+		tags = append(tags, fmt.Sprintf("v1.%d.0", i))
+	}
+
+	server := httptest.NewServer(&fakeBitbucketServer{tags: tags})
+	defer server.Close()
+
+	s := newTestStash(t, server.URL, nil)
+
+	got, err := s.SearchReleases(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, got, 150)
+	assert.Equal(t, "v1.0.0", got[0])
+	assert.Equal(t, "v1.149.0", got[149])
+}
+
+func TestCondition(t *testing.T) {
+	tests := []struct {
+		name        string
+		tags        []string
+		lightweight map[string]bool
+		spec        map[string]any
+		source      string
+		wantPass    bool
+	}{
+		{
+			name:     "release from source exists",
+			tags:     []string{"v1.1.0", "v1.0.0"},
+			source:   "v1.0.0",
+			wantPass: true,
+		},
+		{
+			name:     "release from spec exists",
+			tags:     []string{"v1.1.0", "v1.0.0"},
+			spec:     map[string]any{"tag": "v1.1.0"},
+			source:   "v9.9.9",
+			wantPass: true,
+		},
+		{
+			name:   "release doesn't exist",
+			tags:   []string{"v1.1.0", "v1.0.0"},
+			source: "v2.0.0",
+		},
+		{
+			name:        "lightweight tag isn't a release",
+			tags:        []string{"v1.1.0", "v1.0.0"},
+			lightweight: map[string]bool{"v1.1.0": true},
+			source:      "v1.1.0",
+		},
+		{
+			name:   "no release",
+			source: "v1.0.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(&fakeBitbucketServer{tags: tt.tags, lightweight: tt.lightweight})
+			defer server.Close()
+
+			s := newTestStash(t, server.URL, tt.spec)
+
+			gotPass, gotMessage, err := s.Condition(context.Background(), tt.source, nil)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantPass, gotPass)
+			assert.NotEmpty(t, gotMessage)
+		})
+	}
+}
+
+func TestTarget(t *testing.T) {
+	tests := []struct {
+		name        string
+		tags        []string
+		spec        map[string]any
+		source      string
+		dryRun      bool
+		wantChanged bool
+		wantResult  string
+		wantCreated []createTagRequest
+		wantErr     string
+	}{
+		{
+			name:       "release already exists",
+			tags:       []string{"v1.0.0"},
+			spec:       map[string]any{"token": "secret"},
+			source:     "v1.0.0",
+			wantResult: result.SUCCESS,
+		},
+		{
+			name:        "release should be created",
+			tags:        []string{"v1.0.0"},
+			spec:        map[string]any{"token": "secret"},
+			source:      "v1.1.0",
+			dryRun:      true,
+			wantChanged: true,
+			wantResult:  result.ATTENTION,
+		},
+		{
+			name: "release is created",
+			tags: []string{"v1.0.0"},
+			spec: map[string]any{
+				"token":       "secret",
+				"title":       "Release v1.1.0",
+				"description": "Changelog",
+				"commitish":   "develop",
+			},
+			source:      "v1.1.0",
+			wantChanged: true,
+			wantResult:  result.ATTENTION,
+			wantCreated: []createTagRequest{
+				{
+					Name:       "v1.1.0",
+					StartPoint: "develop",
+					Message:    strings.Join([]string{"Release v1.1.0", "Changelog", updatecliCredits}, "\n\n"),
+				},
+			},
+		},
+		{
+			name:    "missing token",
+			tags:    []string{"v1.0.0"},
+			source:  "v1.1.0",
+			wantErr: `missing parameter "token"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeBitbucketServer{tags: tt.tags}
+			server := httptest.NewServer(fake)
+			defer server.Close()
+
+			s := newTestStash(t, server.URL, tt.spec)
+
+			gotResult := result.Target{}
+			err := s.Target(context.Background(), tt.source, nil, tt.dryRun, &gotResult)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				assert.Empty(t, fake.created)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantChanged, gotResult.Changed)
+			assert.Equal(t, tt.wantResult, gotResult.Result)
+			assert.Equal(t, tt.source, gotResult.NewInformation)
+			assert.Equal(t, tt.wantCreated, fake.created)
+		})
+	}
+}
@@ -9,8 +9,9 @@ import (
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
-func (g *Stash) Source(_ context.Context, workingDir string, resultSource *result.Source) error {
-	versions, err := g.SearchReleases()
+// Source retrieves the latest Bitbucket Server release tag matching the version filter
+func (g *Stash) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	versions, err := g.SearchReleases(ctx)
 
 	if err != nil {
 		logrus.Error(err)
@@ -1,8 +1,12 @@
 package release
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/url"
 	"strings"
 	"time"
 
@@ -12,11 +16,21 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (g Stash) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget result.Target) error {
+// createTagRequest is the payload expected by the Bitbucket Server create tag API
+type createTagRequest struct {
+	Name       string `json:"name"`
+	StartPoint string `json:"startPoint"`
+	Message    string `json:"message"`
+}
+
+// Target ensures that a specific release exists on Bitbucket Server, otherwise creates it
+func (g Stash) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
 	if len(g.spec.Tag) == 0 {
 		g.spec.Tag = source
 	}
 
+	resultTarget.NewInformation = g.spec.Tag
+
 	if len(g.spec.Title) == 0 {
 		g.spec.Title = g.spec.Tag
 	}
@@ -27,42 +41,25 @@ func (g Stash) Target(ctx context.Context, source string, scm scm.ScmHandler, dr
 	}
 
 	// Ensure that a release doesn't exist yet
-
-	// Timeout api query after 30 second
-	ctx, cancelListQuery := context.WithTimeout(ctx, 30*time.Second)
-	defer cancelListQuery()
-
-	releases, resp, err := g.client.Releases.List(
-		ctx,
-		strings.Join([]string{g.spec.Owner, g.spec.Repository}, "/"),
-		goscm.ReleaseListOptions{
-			Page:   1,
-			Size:   30,
-			Open:   true,
-			Closed: true,
-		},
-	)
+	releases, err := g.SearchReleases(ctx)
 	if err != nil {
-		logrus.Debugf("Bitbucket Api Response:\nReturn Code: %d\nBody:\n%s", resp.Status, resp.Body)
-		return err
+		return fmt.Errorf("looking for releases: %w", err)
 	}
 
-	resultTarget.NewInformation = g.spec.Tag
 	for _, r := range releases {
-		if r.Tag == g.spec.Tag {
-			resultTarget.Information = resultTarget.NewInformation
+		if r == g.spec.Tag {
+			resultTarget.Information = g.spec.Tag
 			resultTarget.Result = result.SUCCESS
-			resultTarget.Description = fmt.Sprintf("Stash release tag %q already exist", g.spec.Tag)
+			resultTarget.Description = fmt.Sprintf("Bitbucket release tag %q already exist", g.spec.Tag)
 			return nil
 		}
 	}
 
-	resultTarget.Information = ""
 	resultTarget.Result = result.ATTENTION
 	resultTarget.Changed = true
 
 	if dryRun {
-		resultTarget.Description = fmt.Sprintf("Stash release tag %q should be created", resultTarget.NewInformation)
+		resultTarget.Description = fmt.Sprintf("Bitbucket release tag %q should be created", g.spec.Tag)
 		return nil
 	}
 
@@ -72,32 +69,58 @@ func (g Stash) Target(ctx context.Context, source string, scm scm.ScmHandler, dr
 
 	// Create a new release as it doesn't exist yet
 
-	ctx = context.Background()
 	// Timeout api query after 30 second
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	release, resp, err := g.client.Releases.Create(
-		ctx,
-		strings.Join([]string{g.spec.Owner, g.spec.Repository}, "/"),
-		&goscm.ReleaseInput{
-			Title:       g.spec.Title,
-			Description: g.spec.Description + "\n" + updatecliCredits,
-			Tag:         g.spec.Tag,
-			Commitish:   g.spec.Commitish,
-			Draft:       g.spec.Draft,
-			Prerelease:  g.spec.Prerelease,
+	if err = g.createTag(ctx); err != nil {
+		return fmt.Errorf("creating Bitbucket release tag %q: %w", g.spec.Tag, err)
+	}
+
+	resultTarget.Description = fmt.Sprintf("Bitbucket release tag %q successfully created from %q", g.spec.Tag, g.spec.Commitish)
+
+	return nil
+}
+
+// createTag creates an annotated tag using the Bitbucket Server REST API
+// as the go-scm Bitbucket Server driver doesn't support tag creation.
+func (g Stash) createTag(ctx context.Context) error {
+	message := strings.Join([]string{g.spec.Title, g.spec.Description, updatecliCredits}, "\n\n")
+	if g.spec.Description == "" {
+		message = strings.Join([]string{g.spec.Title, updatecliCredits}, "\n\n")
+	}
+
+	body, err := json.Marshal(createTagRequest{
+		Name:       g.spec.Tag,
+		StartPoint: g.spec.Commitish,
+		Message:    message,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := (*goscm.Client)(g.client).Do(ctx, &goscm.Request{
+		Method: "POST",
+		Path: fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/tags",
+			url.PathEscape(g.spec.Owner),
+			url.PathEscape(g.spec.Repository)),
+		Header: map[string][]string{
+			"Accept":            {"application/json"},
+			"Content-Type":      {"application/json"},
+			"x-atlassian-token": {"no-check"},
 		},
-	)
+		Body: bytes.NewReader(body),
+	})
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 
 	if resp.Status >= 400 {
-		logrus.Debugf("RC: %d\nBody:\n%s", resp.Status, resp.Body)
+		b, _ := io.ReadAll(resp.Body)
+		logrus.Debugf("RC: %d\nBody:\n%s", resp.Status, b)
 		return fmt.Errorf("error from Bitbucket api: %v", resp.Status)
 	}
 
-	resultTarget.Description = fmt.Sprintf("Stash release %q successfully open on %q", release.Title, release.Link)
 	return nil
 }
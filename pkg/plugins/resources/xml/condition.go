@@ -40,7 +40,7 @@ func (x *XML) Condition(_ context.Context, source string, scm scm.ScmHandler) (p
 		return false, "", err
 	}
 
-	elem := doc.FindElement(x.spec.Path)
+	elem := findNode(doc, x.spec.Path)
 
 	if elem == nil {
 		return false, fmt.Sprintf("nothing found in path %q from file %q",
@@ -78,6 +78,24 @@ func TestCondition(t *testing.T) {
 			},
 			expectedResult: false,
 		},
+		{
+			name: "attribute",
+			spec: Spec{
+				File:  "testdata/data_3.xml",
+				Path:  "/Project/ItemGroup/PackageReference[@Include='Newtonsoft.Json']/@Version",
+				Value: "13.0.1",
+			},
+			expectedResult: true,
+		},
+		{
+			name: "wrong attribute value",
+			spec: Spec{
+				File:  "testdata/data_3.xml",
+				Path:  "/Project/ItemGroup/PackageReference[@Include='Newtonsoft.Json']/@Version",
+				Value: "13.0.3",
+			},
+			expectedResult: false,
+		},
 	}
 
 	for _, tt := range testData {
@@ -0,0 +1,54 @@
+package xml
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// attributeNameRegex validates the attribute name selected by a path ending with "/@attribute"
+var attributeNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.:-]*$`)
+
+// node is the result of a xpath query, either an element or one of its attributes.
+// etree only selects elements, so a path ending with "/@attribute" is split
+// into an element query and the attribute name.
+type node struct {
+	elem *etree.Element
+	attr string
+}
+
+// findNode returns the first node matching path, or nil if nothing matches.
+func findNode(doc *etree.Document, path string) *node {
+	if i := strings.LastIndex(path, "/@"); i > 0 && attributeNameRegex.MatchString(path[i+2:]) {
+		elem := doc.FindElement(path[:i])
+		if elem == nil || elem.SelectAttr(path[i+2:]) == nil {
+			return nil
+		}
+		return &node{elem: elem, attr: path[i+2:]}
+	}
+
+	elem := doc.FindElement(path)
+	if elem == nil {
+		return nil
+	}
+
+	return &node{elem: elem}
+}
+
+// Text returns the element text, or the attribute value.
+func (n *node) Text() string {
+	if n.attr != "" {
+		return n.elem.SelectAttrValue(n.attr, "")
+	}
+	return n.elem.Text()
+}
+
+// SetText updates the element text, or the attribute value.
+func (n *node) SetText(value string) {
+	if n.attr != "" {
+		n.elem.CreateAttr(n.attr, value)
+		return
+	}
+	n.elem.SetText(value)
+}
@@ -46,7 +46,7 @@ func (x *XML) Source(_ context.Context, workingDir string, resultSource *result.
 		return fmt.Errorf("loading document: %w", err)
 	}
 
-	elem := doc.FindElement(x.spec.Path)
+	elem := findNode(doc, x.spec.Path)
 
 	if elem == nil {
 		return fmt.Errorf("cannot find value for path %q from file %q",
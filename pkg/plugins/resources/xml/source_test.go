@@ -52,6 +52,23 @@ func TestSource(t *testing.T) {
 			},
 			expectedResult: "Belgian Waffles",
 		},
+		{
+			name: "scenario 4 - attribute",
+			spec: Spec{
+				File: "testdata/data_3.xml",
+				Path: "//PackageReference[@Include='Serilog']/@Version",
+			},
+			expectedResult: "3.1.1",
+		},
+		{
+			name: "scenario 5 - missing attribute",
+			spec: Spec{
+				File: "testdata/data_3.xml",
+				Path: "//PackageReference[@Include='Serilog']/@Condition",
+			},
+			wantErr:          true,
+			expectedErrorMsg: "cannot find value for path \"//PackageReference[@Include='Serilog']/@Condition\"",
+		},
 	}
 
 	for _, tt := range testData {
@@ -31,6 +31,10 @@ type Spec struct {
 			* path: "/project/parent/version"
 			* path: "//breakfast_menu/food[0]/name"
 			* path: "//book[@category='WEB']/title"
+			* path: "//PackageReference[@Include='Newtonsoft.Json']/@Version"
+
+		remark:
+			* a path ending with "/@<attribute>" selects the attribute value of the matching element
 	*/
 	Path string `yaml:",omitempty"`
 	/*
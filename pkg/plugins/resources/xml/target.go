@@ -50,7 +50,7 @@ func (x *XML) Target(_ context.Context, source string, scm scm.ScmHandler, dryRu
 		return err
 	}
 
-	elem := doc.FindElement(x.spec.Path)
+	elem := findNode(doc, x.spec.Path)
 	if elem == nil {
 		return fmt.Errorf("nothing found at path %q from file %q", x.spec.Path, resourceFile)
 	}
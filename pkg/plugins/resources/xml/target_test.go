@@ -88,6 +88,24 @@ func TestTarget(t *testing.T) {
 			expectedResult:   false,
 			expectedErrorMsg: errors.New("URL scheme is not supported for XML target: \"https://raw.githubusercontent.com/updatecli/updatecli/main/pkg/plugins/resources/xml/testdata/data_2.xml\""),
 		},
+		{
+			name: "Test 8 - attribute",
+			spec: Spec{
+				File:  "testdata/data_3.xml",
+				Path:  "//PackageReference[@Include='Newtonsoft.Json']/@Version",
+				Value: "13.0.3",
+			},
+			expectedResult: true,
+		},
+		{
+			name: "Test 9 - attribute already set",
+			spec: Spec{
+				File:  "testdata/data_3.xml",
+				Path:  "//PackageReference[@Include='Newtonsoft.Json']/@Version",
+				Value: "13.0.1",
+			},
+			expectedResult: false,
+		},
 	}
 
 	for _, tt := range testData {
@@ -17,6 +17,7 @@ import (
 	"github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/credential"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/git/commit"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/git/sign"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/git/sparse"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
 )
@@ -47,6 +48,19 @@ type Spec struct {
 	//   As a trade-off, Updatecli may not detect an already published working branch in some
 	//   edge cases, which could result in a duplicate pull request being created.
 	SingleBranch *bool `yaml:",omitempty"`
+	// SparseCheckout defines the paths checked out in the working directory,
+	// the other files of the repository are not written on disk.
+	// Paths are listed explicitly, and completed with the "file" and "files" settings
+	// of the resources using this scm when "auto" is enabled.
+	//
+	// Default: disabled (the whole repository is checked out)
+	//
+	// Remark:
+	//   Only the working directory is reduced. Blob-less partial clones are not supported
+	//   by the underlying git library, so every git object of the fetched commits is still
+	//   downloaded. Combine it with depth and singlebranch to reduce the data retrieved.
+	//   Git submodules are not cloned when sparse checkout is enabled.
+	SparseCheckout sparse.Spec `yaml:",omitempty"`
 	// "email" defines the email used to commit changes.
 	Email string `yaml:",omitempty"`
 	// "force" is used during the git push phase to run `git push --force`.
@@ -57,6 +71,11 @@ type Spec struct {
 	User string `yaml:",omitempty"`
 	// "branch" defines the git branch to work on.
 	Branch string `yaml:",omitempty"`
+	// "branches" defines a list of git branches to work on, in place of "branch".
+	// A value is either an exact branch name, a glob pattern such as "release-*",
+	// or a regular expression prefixed with "regex:". Patterns are resolved against the remote branches.
+	// Updatecli runs the pipeline once per branch.
+	Branches []string `yaml:",omitempty"`
 	// WorkingBranchPrefix defines the prefix used to create a working branch.
 	WorkingBranchPrefix *string `yaml:",omitempty"`
 	// WorkingBranchSeparator defines the separator used to create a working branch.
@@ -205,7 +224,12 @@ If you know what you are doing, please set the force option to true in your conf
 		return &AzureDevOps{}, err
 	}
 
+	if err := s.SparseCheckout.Validate(); err != nil {
+		return nil, err
+	}
+
 	nativeGitHandler := gitgeneric.GoGit{}
+	nativeGitHandler.SetSparseCheckoutDirectories(s.SparseCheckout.Directories(nil))
 
 	azdo := AzureDevOps{
 		force:                  force,
@@ -450,3 +474,14 @@ func (a *AzureDevOps) PushBranch(branch string) error {
 func (a *AzureDevOps) GetChangedFiles(workingDir string) ([]string, error) {
 	return a.nativeGitHandler.GetChangedFiles(workingDir)
 }
+
+// GetRemoteBranches returns the branches available on the remote git repository
+func (a *AzureDevOps) GetRemoteBranches() ([]string, error) {
+	return a.nativeGitHandler.RemoteBranches(a.Spec.Username, a.Spec.Token, a.GetURL())
+}
+
+// SetSparseCheckoutPaths defines the paths used by resources depending on the scm,
+// they are added to the sparse checkout when its auto mode is enabled.
+func (a *AzureDevOps) SetSparseCheckoutPaths(paths []string) {
+	a.nativeGitHandler.SetSparseCheckoutDirectories(a.Spec.SparseCheckout.Directories(paths))
+}
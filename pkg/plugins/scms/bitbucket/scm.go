@@ -234,3 +234,14 @@ func (b *Bitbucket) PushBranch(branch string) error {
 func (b *Bitbucket) GetChangedFiles(workingDir string) ([]string, error) {
 	return b.nativeGitHandler.GetChangedFiles(workingDir)
 }
+
+// GetRemoteBranches returns the branches available on the remote git repository
+func (b *Bitbucket) GetRemoteBranches() ([]string, error) {
+	return b.nativeGitHandler.RemoteBranches(b.GetUsername(), b.GetPassword(), b.GetURL())
+}
+
+// SetSparseCheckoutPaths defines the paths used by resources depending on the scm,
+// they are added to the sparse checkout when its auto mode is enabled.
+func (b *Bitbucket) SetSparseCheckoutPaths(paths []string) {
+	b.nativeGitHandler.SetSparseCheckoutDirectories(b.Spec.SparseCheckout.Directories(paths))
+}
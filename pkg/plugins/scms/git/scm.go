@@ -215,3 +215,14 @@ func (g *Git) PushTag(tag string) error {
 func (g *Git) GetChangedFiles(workingDir string) ([]string, error) {
 	return g.nativeGitHandler.GetChangedFiles(workingDir)
 }
+
+// GetRemoteBranches returns the branches available on the remote git repository
+func (g *Git) GetRemoteBranches() ([]string, error) {
+	return g.nativeGitHandler.RemoteBranches(g.spec.Username, g.spec.Password, g.GetURL())
+}
+
+// SetSparseCheckoutPaths defines the paths used by resources depending on the scm,
+// they are added to the sparse checkout when its auto mode is enabled.
+func (g *Git) SetSparseCheckoutPaths(paths []string) {
+	g.nativeGitHandler.SetSparseCheckoutDirectories(g.spec.SparseCheckout.Directories(paths))
+}
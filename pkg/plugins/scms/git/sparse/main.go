@@ -0,0 +1,109 @@
+package sparse
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+/*
+Spec defines the sparse checkout settings of a git repository.
+
+Only the configured paths are checked out in the working directory,
+which reduces the disk usage and checkout time of large repositories such as monorepos.
+
+Sparse checkout doesn't reduce the data retrieved from the remote, as blob-less partial
+clones aren't supported by go-git. Every object of the fetched commits is still downloaded,
+so it's best combined with the scm depth and singlebranch settings.
+*/
+type Spec struct {
+	/*
+		paths defines the list of directories or files, relative to the repository root, to check out.
+
+		example:
+			* charts/
+			* docker/Dockerfile
+
+		default:
+			none (the whole repository is checked out)
+	*/
+	Paths []string `yaml:",omitempty"`
+	/*
+		auto defines if the paths referenced by the "file" and "files" settings
+		of resources using this scm should be added to the sparse checkout.
+
+		remark:
+			if a resource using this scm doesn't reference any file,
+			then the sparse checkout only contains the paths defined by "paths".
+
+		default:
+			false
+	*/
+	Auto bool `yaml:",omitempty"`
+}
+
+// Validate ensures that the sparse checkout paths are relative to the repository root
+func (s Spec) Validate() error {
+	for _, p := range s.Paths {
+		if _, ok := normalizePath(p); !ok {
+			return fmt.Errorf("invalid sparse checkout path %q, it must be relative to the repository root", p)
+		}
+	}
+	return nil
+}
+
+// Directories returns the sparse checkout paths, including the resource paths if auto is enabled.
+// An empty result means that the whole repository must be checked out.
+func (s Spec) Directories(resourcePaths []string) []string {
+	var result []string
+
+	paths := s.Paths
+	if s.Auto {
+		paths = append(slices.Clone(paths), resourcePaths...)
+	}
+
+	for _, p := range paths {
+		p, ok := normalizePath(p)
+		if !ok {
+			continue
+		}
+
+		// The repository root requires the whole repository
+		if p == "" {
+			return nil
+		}
+
+		if !slices.Contains(result, p) {
+			result = append(result, p)
+		}
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
+// normalizePath cleans a path and returns false if it doesn't belong to the repository.
+// Glob patterns are truncated to their static directory prefix.
+func normalizePath(p string) (string, bool) {
+	if strings.Contains(p, "://") {
+		return "", false
+	}
+
+	if i := strings.IndexAny(p, "*?[{"); i >= 0 {
+		p = p[:strings.LastIndex(p[:i], "/")+1]
+	}
+
+	p = path.Clean(strings.TrimPrefix(p, "./"))
+
+	if path.IsAbs(p) || p == ".." || strings.HasPrefix(p, "../") {
+		return "", false
+	}
+
+	if p == "." {
+		return "", true
+	}
+
+	return p, true
+}
@@ -0,0 +1,52 @@
+package sparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDirectories(t *testing.T) {
+	tests := []struct {
+		name          string
+		spec          Spec
+		resourcePaths []string
+		expected      []string
+	}{
+		{
+			name:     "disabled",
+			spec:     Spec{},
+			expected: nil,
+		},
+		{
+			name:          "resource paths ignored without auto",
+			spec:          Spec{Paths: []string{"./charts/", "docs"}},
+			resourcePaths: []string{"values.yaml"},
+			expected:      []string{"charts", "docs"},
+		},
+		{
+			name:          "auto",
+			spec:          Spec{Paths: []string{"charts"}, Auto: true},
+			resourcePaths: []string{"charts", "docker/Dockerfile", "deploy/**/*.yaml", "https://example.com/file", "/etc/hosts", "../outside"},
+			expected:      []string{"charts", "deploy", "docker/Dockerfile"},
+		},
+		{
+			name:          "root glob requires the whole repository",
+			spec:          Spec{Paths: []string{"charts"}, Auto: true},
+			resourcePaths: []string{"*.yaml"},
+			expected:      nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.spec.Directories(tt.resourcePaths))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Spec{Paths: []string{"charts", "./docs/"}}.Validate())
+	assert.Error(t, Spec{Paths: []string{"/charts"}}.Validate())
+	assert.Error(t, Spec{Paths: []string{"../charts"}}.Validate())
+}
@@ -0,0 +1,90 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	giteasdk "code.gitea.io/sdk/gitea"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
+)
+
+// CreateCommit creates a commit on the working branch using the Gitea ChangeFiles API
+// and returns the newly created commit hash.
+func (g *Gitea) CreateCommit(ctx context.Context, workingDir string, commitMessage string) (string, error) {
+	sourceBranch, workingBranch, _ := g.GetBranches()
+
+	files, err := g.nativeGitHandler.GetChangedFiles(workingDir)
+	if err != nil {
+		return "", err
+	}
+
+	changes, err := gitgeneric.GetFileChanges(workingDir, files)
+	if err != nil {
+		return "", err
+	}
+
+	if len(changes) == 0 {
+		return "", fmt.Errorf("no changed file to commit in %q", workingDir)
+	}
+
+	operations := make([]*giteasdk.ChangeFileOperation, 0, len(changes))
+	for _, change := range changes {
+		operation := giteasdk.ChangeFileOperation{
+			Operation: change.Action,
+			Path:      change.Path,
+			SHA:       change.SHA,
+		}
+
+		if change.Action != gitgeneric.FileActionDelete {
+			operation.Content, err = utils.Base64EncodeFile(filepath.Join(workingDir, change.Path))
+			if err != nil {
+				return "", err
+			}
+		}
+
+		operations = append(operations, &operation)
+	}
+
+	if g.nativeGitHandler.IsForceReset() {
+		// Ensure that locally reset branch is pushed to remote branch
+		// before creating the commit on top of it.
+		logrus.Debugf("local branch %q was reset, pushing to remote to ensure correct state", workingBranch)
+		if _, err = g.Push(); err != nil {
+			return "", fmt.Errorf("failed to push branch %q before creating commit: %w", workingBranch, err)
+		}
+	}
+
+	exist, err := g.nativeGitHandler.IsRemoteBranchExist(workingBranch, g.Spec.Username, g.Spec.Token, workingDir)
+	if err != nil {
+		return "", err
+	}
+
+	opts := giteasdk.ChangeFilesOptions{
+		Files:   operations,
+		Message: commitMessage,
+		Branch:  workingBranch,
+	}
+
+	if !exist {
+		logrus.Debugf("Branch %q does not exist, creating it from %q", workingBranch, sourceBranch)
+		opts.Branch = sourceBranch
+		opts.NewBranch = workingBranch
+	}
+
+	g.sdkClient.SetContext(ctx)
+	response, _, err := g.sdkClient.ChangeFiles(g.Spec.Owner, g.Spec.Repository, opts)
+	if err != nil {
+		return "", fmt.Errorf("creating commit on branch %q: %w", workingBranch, err)
+	}
+
+	if response.Commit == nil {
+		return "", fmt.Errorf("creating commit on branch %q: no commit returned", workingBranch)
+	}
+
+	logrus.Debugf("commit created: %s", response.Commit.HTMLURL)
+
+	return response.Commit.SHA, nil
+}
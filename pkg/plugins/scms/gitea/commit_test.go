@@ -0,0 +1,119 @@
+package gitea
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// initTestRepository creates a git repository with a committed file then
+// updates it, creates a new one, and deletes another one.
+func initTestRepository(t *testing.T) string {
+	directory := t.TempDir()
+	repository, err := git.PlainInit(directory, false)
+	require.NoError(t, err)
+
+	worktree, err := repository.Worktree()
+	require.NoError(t, err)
+
+	for _, f := range []string{"README.md", "old.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(directory, f), []byte(f), 0o600))
+		_, err = worktree.Add(f)
+		require.NoError(t, err)
+	}
+
+	_, err = worktree.Commit("initial commit", &git.CommitOptions{
+		Author: &object.Signature{Name: "Updatecli Test", Email: "test@updatecli.io", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "README.md"), []byte("updated"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "new.txt"), []byte("created"), 0o600))
+	require.NoError(t, os.Remove(filepath.Join(directory, "old.txt")))
+
+	return directory
+}
+
+func TestCreateCommit(t *testing.T) {
+	directory := initTestRepository(t)
+
+	type operation struct {
+		Operation string `json:"operation"`
+		Path      string `json:"path"`
+		Content   string `json:"content"`
+		SHA       string `json:"sha"`
+	}
+
+	var received struct {
+		Files     []operation `json:"files"`
+		Message   string      `json:"message"`
+		Branch    string      `json:"branch"`
+		NewBranch string      `json:"new_branch"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/v1/version":
+			_, _ = w.Write([]byte(`{"version": "1.24.0"}`))
+		case "/api/v1/repos/updatecli/updatecli/contents":
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "token secret", r.Header.Get("Authorization"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"commit": {"sha": "6104942438c14ec7bd21c6cd5bd995272b3faff6", "html_url": "https://gitea.example.com/commit"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	g, err := New(map[string]interface{}{
+		"url":            server.URL,
+		"token":          "secret",
+		"owner":          "updatecli",
+		"repository":     "updatecli",
+		"branch":         "main",
+		"directory":      directory,
+		"commitusingapi": true,
+	}, "pipeline")
+	require.NoError(t, err)
+	require.True(t, g.commitUsingAPI)
+
+	commitHash, err := g.CreateCommit(t.Context(), directory, "chore: update files")
+	require.NoError(t, err)
+	assert.Equal(t, "6104942438c14ec7bd21c6cd5bd995272b3faff6", commitHash)
+
+	assert.Equal(t, "chore: update files", received.Message)
+	// The repository has no remote, so the working branch is created from the source branch
+	assert.Equal(t, "main", received.Branch)
+	assert.Equal(t, "updatecli_main_pipeline", received.NewBranch)
+
+	sort.Slice(received.Files, func(i, j int) bool { return received.Files[i].Path < received.Files[j].Path })
+	require.Len(t, received.Files, 3)
+
+	assert.Equal(t, "update", received.Files[0].Operation)
+	assert.Equal(t, "README.md", received.Files[0].Path)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("updated")), received.Files[0].Content)
+	assert.NotEmpty(t, received.Files[0].SHA)
+
+	assert.Equal(t, operation{Operation: "create", Path: "new.txt", Content: base64.StdEncoding.EncodeToString([]byte("created"))}, received.Files[1])
+
+	assert.Equal(t, "delete", received.Files[2].Operation)
+	assert.Equal(t, "old.txt", received.Files[2].Path)
+	assert.Empty(t, received.Files[2].Content)
+	assert.NotEmpty(t, received.Files[2].SHA)
+}
@@ -103,6 +103,34 @@ func (g *Gitea) Commit(ctx context.Context, message string) error {
 		return err
 	}
 
+	if g.commitUsingAPI {
+		logrus.Debugf("Creating commit using Gitea API")
+		_, workingBranch, _ := g.GetBranches()
+
+		commitHash, err := g.CreateCommit(ctx, g.GetDirectory(), commitMessage)
+		if err != nil {
+			return err
+		}
+
+		if err = gitgeneric.SyncRemoteCommit(
+			g.nativeGitHandler,
+			g.Spec.Username,
+			g.Spec.Token,
+			g.GetDirectory(),
+			workingBranch,
+			g.Spec.Depth,
+			commitHash,
+		); err != nil {
+			return err
+		}
+
+		if g.Spec.CommitMessage.IsSquash() {
+			logrus.Warningf("Squash commit is not supported when using Gitea API to create the commit. Ignoring the squash option.")
+		}
+
+		return nil
+	}
+
 	err = g.nativeGitHandler.Commit(g.Spec.User, g.Spec.Email, commitMessage, g.GetDirectory(), g.Spec.GPG.SigningKey, g.Spec.GPG.Passphrase)
 	if err != nil {
 		return err
@@ -217,3 +245,14 @@ func (g *Gitea) PushBranch(branch string) error {
 func (g *Gitea) GetChangedFiles(workingDir string) ([]string, error) {
 	return g.nativeGitHandler.GetChangedFiles(workingDir)
 }
+
+// GetRemoteBranches returns the branches available on the remote git repository
+func (g *Gitea) GetRemoteBranches() ([]string, error) {
+	return g.nativeGitHandler.RemoteBranches(g.Spec.Username, g.Spec.Token, g.GetURL())
+}
+
+// SetSparseCheckoutPaths defines the paths used by resources depending on the scm,
+// they are added to the sparse checkout when its auto mode is enabled.
+func (g *Gitea) SetSparseCheckoutPaths(paths []string) {
+	g.nativeGitHandler.SetSparseCheckoutDirectories(g.Spec.SparseCheckout.Directories(paths))
+}
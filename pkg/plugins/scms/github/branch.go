@@ -72,3 +72,8 @@ func ListBranches(c client.Client, owner, repo string, retry int, ctx context.Co
 
 	return branches, nil
 }
+
+// SearchBranches returns every branch from the GitHub repository.
+func (g *Github) SearchBranches(ctx context.Context, retry int) (branches []string, err error) {
+	return ListBranches(g.client, g.Spec.Owner, g.Spec.Repository, retry, ctx)
+}
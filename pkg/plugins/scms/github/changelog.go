@@ -1,8 +1,11 @@
 package github
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
 
+	"github.com/updatecli/updatecli/pkg/core/result"
 	githubChangelog "github.com/updatecli/updatecli/pkg/plugins/changelog/github/v3"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/github/token"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
@@ -41,3 +44,31 @@ func (g *Github) Changelog(version version.Version) (string, error) {
 		releases[0].URL,
 		releases[0].Body), nil
 }
+
+// CompareChangelog returns a changelog pointing to the GitHub comparison between two git references
+func CompareChangelog(baseURL, owner, repository, from, to string) (*result.Changelogs, error) {
+	if from == "" || to == "" {
+		return nil, errors.New("both references are required to compare them")
+	}
+
+	if from == to {
+		return nil, nil
+	}
+
+	if baseURL == "" {
+		baseURL = "https://github.com"
+	}
+
+	compareURL, err := url.JoinPath(baseURL, owner, repository, "compare", from+"..."+to)
+	if err != nil {
+		return nil, fmt.Errorf("generating GitHub compare url: %w", err)
+	}
+
+	return &result.Changelogs{
+		{
+			Title: to,
+			Body:  fmt.Sprintf("Changes between %q and %q are available on %s", from, to, compareURL),
+			URL:   compareURL,
+		},
+	}, nil
+}
@@ -0,0 +1,69 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+func TestCompareChangelog(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		from    string
+		to      string
+		want    *result.Changelogs
+		wantErr bool
+	}{
+		{
+			name: "github.com",
+			from: "v1.0.0",
+			to:   "v1.1.0",
+			want: &result.Changelogs{
+				{
+					Title: "v1.1.0",
+					Body:  `Changes between "v1.0.0" and "v1.1.0" are available on https://github.com/updatecli/updatecli/compare/v1.0.0...v1.1.0`,
+					URL:   "https://github.com/updatecli/updatecli/compare/v1.0.0...v1.1.0",
+				},
+			},
+		},
+		{
+			name:    "github enterprise",
+			baseURL: "https://github.example.com",
+			from:    "release/1.0",
+			to:      "release/1.1",
+			want: &result.Changelogs{
+				{
+					Title: "release/1.1",
+					Body:  `Changes between "release/1.0" and "release/1.1" are available on https://github.example.com/updatecli/updatecli/compare/release/1.0...release/1.1`,
+					URL:   "https://github.example.com/updatecli/updatecli/compare/release/1.0...release/1.1",
+				},
+			},
+		},
+		{
+			name: "same reference",
+			from: "v1.0.0",
+			to:   "v1.0.0",
+		},
+		{
+			name:    "missing reference",
+			to:      "v1.0.0",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CompareChangelog(tt.baseURL, "updatecli", "updatecli", tt.from, tt.to)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -13,5 +13,6 @@ type GithubHandler interface {
 	SearchReleasesByTagHash(ctx context.Context, releaseType ReleaseType) (releases []string, err error)
 	SearchReleasesByTitle(ctx context.Context, releaseType ReleaseType) (releases []string, err error)
 	SearchTags(ctx context.Context, retry int) (tags []string, err error)
+	SearchBranches(ctx context.Context, retry int) (branches []string, err error)
 	Changelog(version.Version) (string, error)
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/tmp"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/git/commit"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/git/sign"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/git/sparse"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/github/app"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/github/client"
 
@@ -46,6 +47,24 @@ type Spec struct {
 	//   The working branch created by Updatecli looks like "updatecli_<pipelineID>".
 	//   The working branch can be disabled using the "workingBranch" parameter set to false.
 	Branch string `yaml:",omitempty"`
+	// "branches" defines a list of git branches to work on, in place of "branch".
+	//
+	// compatible:
+	//   * scm
+	//
+	// example:
+	//   * main
+	//   * release-*
+	//   * regex:^release-[0-9]+\.x$
+	//
+	// remark:
+	//   Updatecli runs the pipeline once per branch, each run having its own working branch,
+	//   pull request, and report entry. The scm branch is available to the manifest with the template
+	//   function {{ scmBranch "<scmid>" }}, which allows to define per-branch version constraints.
+	//
+	//   A value is either an exact branch name, a glob pattern such as "release-*",
+	//   or a regular expression prefixed with "regex:". Patterns are resolved against the remote branches.
+	Branches []string `yaml:",omitempty"`
 	// WorkingBranchPrefix defines the prefix used to create a working branch.
 	//
 	// compatible:
@@ -105,6 +124,19 @@ type Spec struct {
 	//   As a trade-off, Updatecli may not detect an already published working branch in some
 	//   edge cases, which could result in a duplicate pull request being created.
 	SingleBranch *bool `yaml:",omitempty"`
+	// SparseCheckout defines the paths checked out in the working directory,
+	// the other files of the repository are not written on disk.
+	// Paths are listed explicitly, and completed with the "file" and "files" settings
+	// of the resources using this scm when "auto" is enabled.
+	//
+	// Default: disabled (the whole repository is checked out)
+	//
+	// Remark:
+	//   Only the working directory is reduced. Blob-less partial clones are not supported
+	//   by the underlying git library, so every git object of the fetched commits is still
+	//   downloaded. Combine it with depth and singlebranch to reduce the data retrieved.
+	//   Git submodules are not cloned when sparse checkout is enabled.
+	SparseCheckout sparse.Spec `yaml:",omitempty"`
 	// "email" defines the email used to commit changes.
 	//
 	// compatible:
@@ -270,7 +302,12 @@ func New(s Spec, pipelineID string) (*Github, error) {
 		s.Directory = path.Join(tmp.Directory, "github", s.Owner, s.Repository)
 	}
 
+	if err := s.SparseCheckout.Validate(); err != nil {
+		return nil, err
+	}
+
 	nativeGitHandler := gitgeneric.GoGit{}
+	nativeGitHandler.SetSparseCheckoutDirectories(s.SparseCheckout.Directories(nil))
 
 	// By default, we create a working branch but if for some reason we don't want to create it
 	// Then we also need to update the force safeguard to avoid force pushing on the main branch.
@@ -66,6 +66,8 @@ type ReleaseNode struct {
 	IsDraft      bool
 	IsLatest     bool
 	IsPrerelease bool
+	// PublishedAt is the release publication date, it's not set for draft releases
+	PublishedAt githubv4.DateTime
 }
 type TagCommit struct {
 	Oid string
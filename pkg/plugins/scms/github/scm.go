@@ -498,3 +498,19 @@ func (g *Github) PushBranch(branch string) error {
 func (g *Github) GetChangedFiles(workingDir string) ([]string, error) {
 	return g.nativeGitHandler.GetChangedFiles(workingDir)
 }
+
+// GetRemoteBranches returns the branches available on the remote git repository
+func (g *Github) GetRemoteBranches() ([]string, error) {
+	accessToken, err := token.GetAccessToken(g.token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access token: %w", err)
+	}
+
+	return g.nativeGitHandler.RemoteBranches(g.username, accessToken, g.GetURL())
+}
+
+// SetSparseCheckoutPaths defines the paths used by resources depending on the scm,
+// they are added to the sparse checkout when its auto mode is enabled.
+func (g *Github) SetSparseCheckoutPaths(paths []string) {
+	g.nativeGitHandler.SetSparseCheckoutDirectories(g.Spec.SparseCheckout.Directories(paths))
+}
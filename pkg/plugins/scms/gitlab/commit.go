@@ -0,0 +1,93 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// CreateCommit creates a commit on the working branch using the GitLab commits API
+// and returns the newly created commit hash.
+func (g *Gitlab) CreateCommit(ctx context.Context, workingDir string, commitMessage string) (string, error) {
+	sourceBranch, workingBranch, _ := g.GetBranches()
+
+	files, err := g.nativeGitHandler.GetChangedFiles(workingDir)
+	if err != nil {
+		return "", err
+	}
+
+	changes, err := gitgeneric.GetFileChanges(workingDir, files)
+	if err != nil {
+		return "", err
+	}
+
+	if len(changes) == 0 {
+		return "", fmt.Errorf("no changed file to commit in %q", workingDir)
+	}
+
+	actions := make([]*gitlab.CommitActionOptions, 0, len(changes))
+	for _, change := range changes {
+		action := gitlab.CommitActionOptions{
+			FilePath: gitlab.Ptr(change.Path),
+		}
+
+		switch change.Action {
+		case gitgeneric.FileActionCreate:
+			action.Action = gitlab.Ptr(gitlab.FileCreate)
+		case gitgeneric.FileActionUpdate:
+			action.Action = gitlab.Ptr(gitlab.FileUpdate)
+		case gitgeneric.FileActionDelete:
+			action.Action = gitlab.Ptr(gitlab.FileDelete)
+		}
+
+		if change.Action != gitgeneric.FileActionDelete {
+			content, err := utils.Base64EncodeFile(filepath.Join(workingDir, change.Path))
+			if err != nil {
+				return "", err
+			}
+			action.Content = gitlab.Ptr(content)
+			action.Encoding = gitlab.Ptr("base64")
+		}
+
+		actions = append(actions, &action)
+	}
+
+	if g.nativeGitHandler.IsForceReset() {
+		// Ensure that locally reset branch is pushed to remote branch
+		// before creating the commit on top of it.
+		logrus.Debugf("local branch %q was reset, pushing to remote to ensure correct state", workingBranch)
+		if _, err = g.Push(); err != nil {
+			return "", fmt.Errorf("failed to push branch %q before creating commit: %w", workingBranch, err)
+		}
+	}
+
+	exist, err := g.nativeGitHandler.IsRemoteBranchExist(workingBranch, g.Spec.Username, g.Spec.Token, workingDir)
+	if err != nil {
+		return "", err
+	}
+
+	opts := gitlab.CreateCommitOptions{
+		Branch:        gitlab.Ptr(workingBranch),
+		CommitMessage: gitlab.Ptr(commitMessage),
+		Actions:       actions,
+	}
+
+	if !exist {
+		logrus.Debugf("Branch %q does not exist, creating it from %q", workingBranch, sourceBranch)
+		opts.StartBranch = gitlab.Ptr(sourceBranch)
+	}
+
+	commit, _, err := g.client.Commits.CreateCommit(g.GetPID(), &opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("creating commit on branch %q: %w", workingBranch, err)
+	}
+
+	logrus.Debugf("commit created: %s", commit.WebURL)
+
+	return commit.ID, nil
+}
@@ -0,0 +1,103 @@
+package gitlab
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// initTestRepository creates a git repository with a committed file then
+// updates it, creates a new one, and deletes another one.
+func initTestRepository(t *testing.T) string {
+	directory := t.TempDir()
+	repository, err := git.PlainInit(directory, false)
+	require.NoError(t, err)
+
+	worktree, err := repository.Worktree()
+	require.NoError(t, err)
+
+	for _, f := range []string{"README.md", "old.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(directory, f), []byte(f), 0o600))
+		_, err = worktree.Add(f)
+		require.NoError(t, err)
+	}
+
+	_, err = worktree.Commit("initial commit", &git.CommitOptions{
+		Author: &object.Signature{Name: "Updatecli Test", Email: "test@updatecli.io", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "README.md"), []byte("updated"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "new.txt"), []byte("created"), 0o600))
+	require.NoError(t, os.Remove(filepath.Join(directory, "old.txt")))
+
+	return directory
+}
+
+func TestCreateCommit(t *testing.T) {
+	directory := initTestRepository(t)
+
+	type action struct {
+		Action   string `json:"action"`
+		FilePath string `json:"file_path"`
+		Content  string `json:"content"`
+		Encoding string `json:"encoding"`
+	}
+
+	var received struct {
+		Branch        string   `json:"branch"`
+		CommitMessage string   `json:"commit_message"`
+		StartBranch   string   `json:"start_branch"`
+		Actions       []action `json:"actions"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v4/projects/updatecli%2Fupdatecli/repository/commits", r.URL.EscapedPath())
+		assert.Equal(t, "secret", r.Header.Get("PRIVATE-TOKEN"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "6104942438c14ec7bd21c6cd5bd995272b3faff6", "web_url": "https://gitlab.example.com/commit"}`))
+	}))
+	defer server.Close()
+
+	g, err := New(map[string]interface{}{
+		"url":            server.URL,
+		"token":          "secret",
+		"owner":          "updatecli",
+		"repository":     "updatecli",
+		"branch":         "main",
+		"directory":      directory,
+		"commitusingapi": true,
+	}, "pipeline")
+	require.NoError(t, err)
+	require.True(t, g.commitUsingAPI)
+
+	commitHash, err := g.CreateCommit(t.Context(), directory, "chore: update files")
+	require.NoError(t, err)
+	assert.Equal(t, "6104942438c14ec7bd21c6cd5bd995272b3faff6", commitHash)
+
+	assert.Equal(t, "updatecli_main_pipeline", received.Branch)
+	assert.Equal(t, "chore: update files", received.CommitMessage)
+	// The repository has no remote, so the working branch is created from the source branch
+	assert.Equal(t, "main", received.StartBranch)
+
+	sort.Slice(received.Actions, func(i, j int) bool { return received.Actions[i].FilePath < received.Actions[j].FilePath })
+	assert.Equal(t, []action{
+		{Action: "update", FilePath: "README.md", Content: base64.StdEncoding.EncodeToString([]byte("updated")), Encoding: "base64"},
+		{Action: "create", FilePath: "new.txt", Content: base64.StdEncoding.EncodeToString([]byte("created")), Encoding: "base64"},
+		{Action: "delete", FilePath: "old.txt"},
+	}, received.Actions)
+}
@@ -107,6 +107,34 @@ func (g *Gitlab) Commit(ctx context.Context, message string) error {
 		return err
 	}
 
+	if g.commitUsingAPI {
+		logrus.Debugf("Creating commit using GitLab API")
+		_, workingBranch, _ := g.GetBranches()
+
+		commitHash, err := g.CreateCommit(ctx, g.GetDirectory(), commitMessage)
+		if err != nil {
+			return err
+		}
+
+		if err = gitgeneric.SyncRemoteCommit(
+			g.nativeGitHandler,
+			g.Spec.Username,
+			g.Spec.Token,
+			g.GetDirectory(),
+			workingBranch,
+			g.Spec.Depth,
+			commitHash,
+		); err != nil {
+			return err
+		}
+
+		if g.Spec.CommitMessage.IsSquash() {
+			logrus.Warningf("Squash commit is not supported when using GitLab API to create the commit. Ignoring the squash option.")
+		}
+
+		return nil
+	}
+
 	err = g.nativeGitHandler.Commit(
 		g.Spec.User,
 		g.Spec.Email,
@@ -230,3 +258,14 @@ func (g *Gitlab) PushBranch(branch string) error {
 func (g *Gitlab) GetChangedFiles(workingDir string) ([]string, error) {
 	return g.nativeGitHandler.GetChangedFiles(workingDir)
 }
+
+// GetRemoteBranches returns the branches available on the remote git repository
+func (g *Gitlab) GetRemoteBranches() ([]string, error) {
+	return g.nativeGitHandler.RemoteBranches(g.Spec.Username, g.Spec.Token, g.GetURL())
+}
+
+// SetSparseCheckoutPaths defines the paths used by resources depending on the scm,
+// they are added to the sparse checkout when its auto mode is enabled.
+func (g *Gitlab) SetSparseCheckoutPaths(paths []string) {
+	g.nativeGitHandler.SetSparseCheckoutDirectories(g.Spec.SparseCheckout.Directories(paths))
+}
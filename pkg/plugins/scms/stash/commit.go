@@ -0,0 +1,174 @@
+package stash
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
+)
+
+// fileEditResponse is the subset of the commit returned by the Bitbucket Server file edit API
+type fileEditResponse struct {
+	ID string `json:"id"`
+}
+
+// CreateCommit commits every changed file on the working branch using the Bitbucket Server
+// file edit API and returns the latest created commit hash.
+func (s *Stash) CreateCommit(ctx context.Context, workingDir string, commitMessage string) (string, error) {
+	sourceBranch, workingBranch, _ := s.GetBranches()
+
+	files, err := s.nativeGitHandler.GetChangedFiles(workingDir)
+	if err != nil {
+		return "", err
+	}
+
+	changes, err := gitgeneric.GetFileChanges(workingDir, files)
+	if err != nil {
+		return "", err
+	}
+
+	if len(changes) == 0 {
+		return "", fmt.Errorf("no changed file to commit in %q", workingDir)
+	}
+
+	// Sorting files makes the sequence of created commits predictable
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+
+	for _, change := range changes {
+		if change.Action == gitgeneric.FileActionDelete {
+			return "", fmt.Errorf("deleting file %q is not supported by the Bitbucket Server API", change.Path)
+		}
+	}
+
+	if s.nativeGitHandler.IsForceReset() {
+		// Ensure that locally reset branch is pushed to remote branch
+		// before creating the commit on top of it.
+		logrus.Debugf("local branch %q was reset, pushing to remote to ensure correct state", workingBranch)
+		if _, err = s.Push(); err != nil {
+			return "", fmt.Errorf("failed to push branch %q before creating commit: %w", workingBranch, err)
+		}
+	}
+
+	exist, err := s.nativeGitHandler.IsRemoteBranchExist(workingBranch, s.Spec.Username, s.Spec.Token, workingDir)
+	if err != nil {
+		return "", err
+	}
+
+	parentCommit, err := s.nativeGitHandler.GetLatestCommitHash(workingDir)
+	if err != nil {
+		return "", err
+	}
+
+	for _, change := range changes {
+		branchSource := ""
+		if !exist {
+			logrus.Debugf("Branch %q does not exist, creating it from %q", workingBranch, sourceBranch)
+			branchSource = sourceBranch
+			exist = true
+		}
+
+		sourceCommit := ""
+		if change.Action == gitgeneric.FileActionUpdate {
+			sourceCommit = parentCommit
+		}
+
+		parentCommit, err = s.editFile(ctx, workingDir, change.Path, workingBranch, branchSource, sourceCommit, commitMessage)
+		if err != nil {
+			return "", fmt.Errorf("creating commit for %q on branch %q: %w", change.Path, workingBranch, err)
+		}
+
+		logrus.Debugf("commit %q created for file %q", parentCommit, change.Path)
+	}
+
+	return parentCommit, nil
+}
+
+// editFile creates or updates a single file using the Bitbucket Server file edit API
+func (s *Stash) editFile(ctx context.Context, workingDir, filePath, branch, sourceBranch, sourceCommit, message string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(workingDir, filePath))
+	if err != nil {
+		return "", err
+	}
+
+	body := bytes.Buffer{}
+	writer := multipart.NewWriter(&body)
+
+	fields := [][2]string{
+		{"branch", branch},
+		{"message", message},
+		{"sourceBranch", sourceBranch},
+		{"sourceCommitId", sourceCommit},
+	}
+
+	for _, field := range fields {
+		if field[1] == "" {
+			continue
+		}
+		if err := writer.WriteField(field[0], field[1]); err != nil {
+			return "", err
+		}
+	}
+
+	part, err := writer.CreateFormFile("content", filepath.Base(filePath))
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := part.Write(content); err != nil {
+		return "", err
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	escapedPath := []string{}
+	for _, segment := range strings.Split(filepath.ToSlash(filePath), "/") {
+		escapedPath = append(escapedPath, url.PathEscape(segment))
+	}
+
+	c := (*scm.Client)(s.client)
+	res, err := c.Do(ctx, &scm.Request{
+		Method: "PUT",
+		Path: fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/browse/%s",
+			url.PathEscape(s.Spec.Owner),
+			url.PathEscape(s.Spec.Repository),
+			strings.Join(escapedPath, "/")),
+		Header: map[string][]string{
+			"Content-Type": {writer.FormDataContentType()},
+			"Accept":       {"application/json"},
+		},
+		Body: &body,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if res.Status >= 300 {
+		return "", fmt.Errorf("unexpected response status %d: %s", res.Status, string(data))
+	}
+
+	var commit fileEditResponse
+	if err := json.Unmarshal(data, &commit); err != nil {
+		return "", fmt.Errorf("parsing response: %w", err)
+	}
+
+	return commit.ID, nil
+}
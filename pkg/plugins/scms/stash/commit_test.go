@@ -0,0 +1,136 @@
+package stash
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// initTestRepository creates a git repository with committed files
+// and returns its directory alongside the HEAD commit hash.
+func initTestRepository(t *testing.T) (string, string) {
+	directory := t.TempDir()
+	repository, err := git.PlainInit(directory, false)
+	require.NoError(t, err)
+
+	worktree, err := repository.Worktree()
+	require.NoError(t, err)
+
+	for _, f := range []string{"README.md", "old.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(directory, f), []byte(f), 0o600))
+		_, err = worktree.Add(f)
+		require.NoError(t, err)
+	}
+
+	hash, err := worktree.Commit("initial commit", &git.CommitOptions{
+		Author: &object.Signature{Name: "Updatecli Test", Email: "test@updatecli.io", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	return directory, hash.String()
+}
+
+func TestCreateCommit(t *testing.T) {
+	directory, headCommit := initTestRepository(t)
+
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "README.md"), []byte("updated"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(directory, "docs"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "docs", "new file.txt"), []byte("created"), 0o600))
+
+	type request struct {
+		path           string
+		branch         string
+		message        string
+		sourceBranch   string
+		sourceCommitID string
+		content        string
+	}
+
+	var received []request
+	commits := []string{"1111111111111111111111111111111111111111", "2222222222222222222222222222222222222222"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+
+		file, _, err := r.FormFile("content")
+		require.NoError(t, err)
+		content, err := io.ReadAll(file)
+		require.NoError(t, err)
+
+		received = append(received, request{
+			path:           r.URL.EscapedPath(),
+			branch:         r.FormValue("branch"),
+			message:        r.FormValue("message"),
+			sourceBranch:   r.FormValue("sourceBranch"),
+			sourceCommitID: r.FormValue("sourceCommitId"),
+			content:        string(content),
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "` + commits[len(received)-1] + `"}`))
+	}))
+	defer server.Close()
+
+	s, err := New(map[string]interface{}{
+		"url":            server.URL,
+		"token":          "secret",
+		"owner":          "updatecli",
+		"repository":     "updatecli",
+		"branch":         "main",
+		"directory":      directory,
+		"commitusingapi": true,
+	}, "pipeline")
+	require.NoError(t, err)
+	require.True(t, s.commitUsingAPI)
+
+	commitHash, err := s.CreateCommit(t.Context(), directory, "chore: update files")
+	require.NoError(t, err)
+	assert.Equal(t, commits[1], commitHash)
+
+	assert.Equal(t, []request{
+		{
+			path:           "/rest/api/1.0/projects/updatecli/repos/updatecli/browse/README.md",
+			branch:         "updatecli_main_pipeline",
+			message:        "chore: update files",
+			sourceBranch:   "main",
+			sourceCommitID: headCommit,
+			content:        "updated",
+		},
+		{
+			path:    "/rest/api/1.0/projects/updatecli/repos/updatecli/browse/docs/new%20file.txt",
+			branch:  "updatecli_main_pipeline",
+			message: "chore: update files",
+			content: "created",
+		},
+	}, received)
+}
+
+func TestCreateCommitDeletedFile(t *testing.T) {
+	directory, _ := initTestRepository(t)
+	require.NoError(t, os.Remove(filepath.Join(directory, "old.txt")))
+
+	s, err := New(map[string]interface{}{
+		"url":            "http://127.0.0.1:0",
+		"token":          "secret",
+		"owner":          "updatecli",
+		"repository":     "updatecli",
+		"branch":         "main",
+		"directory":      directory,
+		"commitusingapi": true,
+	}, "pipeline")
+	require.NoError(t, err)
+
+	_, err = s.CreateCommit(t.Context(), directory, "chore: delete file")
+	require.ErrorContains(t, err, `deleting file "old.txt" is not supported`)
+}
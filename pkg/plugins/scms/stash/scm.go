@@ -103,6 +103,34 @@ func (s *Stash) Commit(ctx context.Context, message string) error {
 		return err
 	}
 
+	if s.commitUsingAPI {
+		logrus.Debugf("Creating commit using Bitbucket Server API")
+		_, workingBranch, _ := s.GetBranches()
+
+		commitHash, err := s.CreateCommit(ctx, s.GetDirectory(), commitMessage)
+		if err != nil {
+			return err
+		}
+
+		if err = gitgeneric.SyncRemoteCommit(
+			s.nativeGitHandler,
+			s.Spec.Username,
+			s.Spec.Token,
+			s.GetDirectory(),
+			workingBranch,
+			s.Spec.Depth,
+			commitHash,
+		); err != nil {
+			return err
+		}
+
+		if s.Spec.CommitMessage.IsSquash() {
+			logrus.Warningf("Squash commit is not supported when using Bitbucket Server API to create the commit. Ignoring the squash option.")
+		}
+
+		return nil
+	}
+
 	err = s.nativeGitHandler.Commit(s.Spec.User, s.Spec.Email, commitMessage, s.GetDirectory(), s.Spec.GPG.SigningKey, s.Spec.GPG.Passphrase)
 	if err != nil {
 		return err
@@ -217,3 +245,14 @@ func (s *Stash) PushBranch(branch string) error {
 func (s *Stash) GetChangedFiles(workingDir string) ([]string, error) {
 	return s.nativeGitHandler.GetChangedFiles(workingDir)
 }
+
+// GetRemoteBranches returns the branches available on the remote git repository
+func (s *Stash) GetRemoteBranches() ([]string, error) {
+	return s.nativeGitHandler.RemoteBranches(s.Spec.Username, s.Spec.Token, s.GetURL())
+}
+
+// SetSparseCheckoutPaths defines the paths used by resources depending on the scm,
+// they are added to the sparse checkout when its auto mode is enabled.
+func (s *Stash) SetSparseCheckoutPaths(paths []string) {
+	s.nativeGitHandler.SetSparseCheckoutDirectories(s.Spec.SparseCheckout.Directories(paths))
+}
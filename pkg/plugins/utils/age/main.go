@@ -123,3 +123,60 @@ func (a Spec) IsNewerThan(t time.Time, since *time.Time) bool {
 func (a Spec) IsZero() bool {
 	return a.Minimum == "" && a.Maximum == ""
 }
+
+// IsMatching returns true if the release date falls inside the age window.
+func (a Spec) IsMatching(releaseDate time.Time, since *time.Time) bool {
+	if a.Minimum != "" && a.IsOlderThan(releaseDate, since) {
+		return false
+	}
+
+	if a.Maximum != "" && a.IsNewerThan(releaseDate, since) {
+		return false
+	}
+
+	return true
+}
+
+// Filter returns the versions released inside the age window, preserving their order.
+// releaseDates maps a version to its release date. Versions without a known release date
+// are discarded, as we can't tell if they match the age window.
+func (a Spec) Filter(versions []string, releaseDates map[string]time.Time) []string {
+	if a.IsZero() {
+		return versions
+	}
+
+	result := []string{}
+	for _, v := range versions {
+		releaseDate, found := releaseDates[v]
+		if !found || releaseDate.IsZero() {
+			logrus.Debugf("ignoring version %q because no release date is known for it\n", v)
+			continue
+		}
+
+		if !a.IsMatching(releaseDate, nil) {
+			logrus.Debugf("ignoring version %q because its release date %s is outside of the age window (minimum %q, maximum %q)\n",
+				v, releaseDate, a.Minimum, a.Maximum)
+			continue
+		}
+
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// Resolve returns the age window of a resource handling release dates on its own,
+// which is defined either by the resource spec or by its versionfilter.
+// The resource spec takes precedence.
+func Resolve(specAge, versionFilterAge Spec) Spec {
+	if versionFilterAge.IsZero() {
+		return specAge
+	}
+
+	if !specAge.IsZero() {
+		logrus.Warningln("age and versionfilter.age are mutually exclusive, ignoring versionfilter.age")
+		return specAge
+	}
+
+	return versionFilterAge
+}
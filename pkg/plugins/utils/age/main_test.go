@@ -334,3 +334,125 @@ func TestSpecIsZero(t *testing.T) {
 		})
 	}
 }
+
+func TestSpecIsMatching(t *testing.T) {
+	since := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		spec        Spec
+		releaseTime time.Time
+		want        bool
+	}{
+		{
+			name:        "no age window always matches",
+			spec:        Spec{},
+			releaseTime: since.Add(-time.Hour),
+			want:        true,
+		},
+		{
+			name:        "release still cooling down",
+			spec:        Spec{Minimum: "7d", Maximum: "30d"},
+			releaseTime: since.Add(-3 * 24 * time.Hour),
+			want:        false,
+		},
+		{
+			name:        "release inside the window",
+			spec:        Spec{Minimum: "7d", Maximum: "30d"},
+			releaseTime: since.Add(-10 * 24 * time.Hour),
+			want:        true,
+		},
+		{
+			name:        "release too old",
+			spec:        Spec{Minimum: "7d", Maximum: "30d"},
+			releaseTime: since.Add(-60 * 24 * time.Hour),
+			want:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.spec.IsMatching(tt.releaseTime, &since))
+		})
+	}
+}
+
+func TestSpecFilter(t *testing.T) {
+	now := time.Now()
+
+	releaseDates := map[string]time.Time{
+		"1.0.0": now.Add(-90 * 24 * time.Hour),
+		"1.1.0": now.Add(-20 * 24 * time.Hour),
+		"1.2.0": now.Add(-2 * 24 * time.Hour),
+	}
+	versions := []string{"1.0.0", "1.1.0", "1.2.0", "1.3.0"}
+
+	tests := []struct {
+		name string
+		spec Spec
+		want []string
+	}{
+		{
+			name: "no age window keeps every version",
+			spec: Spec{},
+			want: versions,
+		},
+		{
+			name: "minimum age discards the recent releases and unknown dates",
+			spec: Spec{Minimum: "7d"},
+			want: []string{"1.0.0", "1.1.0"},
+		},
+		{
+			name: "age window",
+			spec: Spec{Minimum: "7d", Maximum: "30d"},
+			want: []string{"1.1.0"},
+		},
+		{
+			name: "every release cooling down",
+			spec: Spec{Minimum: "1y"},
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.spec.Filter(versions, releaseDates))
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name             string
+		specAge          Spec
+		versionFilterAge Spec
+		want             Spec
+	}{
+		{
+			name: "no age defined",
+			want: Spec{},
+		},
+		{
+			name:    "resource age only",
+			specAge: Spec{Minimum: "7d"},
+			want:    Spec{Minimum: "7d"},
+		},
+		{
+			name:             "versionfilter age only",
+			versionFilterAge: Spec{Minimum: "3d"},
+			want:             Spec{Minimum: "3d"},
+		},
+		{
+			name:             "resource age takes precedence",
+			specAge:          Spec{Minimum: "7d"},
+			versionFilterAge: Spec{Minimum: "3d"},
+			want:             Spec{Minimum: "7d"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Resolve(tt.specAge, tt.versionFilterAge))
+		})
+	}
+}
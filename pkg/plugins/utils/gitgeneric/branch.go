@@ -97,6 +97,42 @@ func (g GoGit) Branches(workingDir string) (branches []string, err error) {
 
 }
 
+// RemoteBranches returns the list of branches available on a remote git repository, like `git ls-remote --heads`
+func (g GoGit) RemoteBranches(username, password, URL string) ([]string, error) {
+	remote := git.NewRemote(nil, &config.RemoteConfig{
+		Name: "origin",
+		URLs: []string{URL},
+	})
+
+	listOptions := &git.ListOptions{}
+
+	auth := transportHttp.BasicAuth{
+		Username: username, // anything except an empty string
+		Password: password,
+	}
+
+	if !isAuthEmpty(&auth) {
+		listOptions.Auth = &auth
+	}
+
+	refs, err := remote.List(listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("listing remote references: %w", err)
+	}
+
+	branches := []string{}
+	for _, ref := range refs {
+		if !ref.Name().IsBranch() {
+			continue
+		}
+		branches = append(branches, ref.Name().Short())
+	}
+
+	sort.Strings(branches)
+
+	return branches, nil
+}
+
 // NewBranch create a tag then return a boolean to indicate if
 // the tag was created or not.
 func (g GoGit) NewBranch(branch, workingDir string) (bool, error) {
@@ -212,7 +248,7 @@ func isBranchCommonAncestor(newBranch, basedBranch plumbing.ReferenceName, gitRe
 
 // resetNewBranchToBaseBranch reset the new branch to the latest commit of the based branch
 // if they don't have common ancestor
-func resetNewBranchToBaseBranch(newBranch, basedBranch plumbing.ReferenceName, gitRepositoryPath string) (bool, error) {
+func (g GoGit) resetNewBranchToBaseBranch(newBranch, basedBranch plumbing.ReferenceName, gitRepositoryPath string) (bool, error) {
 
 	ok, err := isBranchCommonAncestor(newBranch, basedBranch, gitRepositoryPath)
 	if err != nil {
@@ -241,7 +277,7 @@ func resetNewBranchToBaseBranch(newBranch, basedBranch plumbing.ReferenceName, g
 	if err != nil {
 		return false, fmt.Errorf("loading work tree: %s", err)
 	}
-	err = worktree.Reset(&git.ResetOptions{
+	err = g.resetWorktree(repository, worktree, &git.ResetOptions{
 		Commit: ref.Hash(),
 		Mode:   git.HardReset,
 	})
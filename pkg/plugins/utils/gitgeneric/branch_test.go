@@ -4,6 +4,11 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Test that we can correctly retrieve a list of tags from a remote git repository
@@ -32,3 +37,28 @@ func TestBranchIntegration(t *testing.T) {
 	}
 	os.Remove(workingDir)
 }
+
+func TestRemoteBranches(t *testing.T) {
+	remote := t.TempDir()
+	_, err := git.PlainInit(remote, false)
+	require.NoError(t, err)
+	commitFiles(t, remote, map[string]string{"README.md": "readme"})
+
+	repository, err := git.PlainOpen(remote)
+	require.NoError(t, err)
+	head, err := repository.Head()
+	require.NoError(t, err)
+
+	for _, branch := range []string{"release-2.x", "release-1.x"} {
+		require.NoError(t, repository.Storer.SetReference(
+			plumbing.NewHashReference(plumbing.NewBranchReferenceName(branch), head.Hash())))
+	}
+	require.NoError(t, repository.Storer.SetReference(
+		plumbing.NewHashReference(plumbing.NewTagReferenceName("v1.0.0"), head.Hash())))
+
+	g := GoGit{}
+	branches, err := g.RemoteBranches("", "", remote)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{head.Name().Short(), "release-1.x", "release-2.x"}, branches)
+}
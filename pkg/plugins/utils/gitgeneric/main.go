@@ -48,8 +48,10 @@ type GitHandler interface {
 	Push(username string, password string, workingDir string, force bool) (bool, error)
 	PushTag(tag string, username string, password string, workingDir string, force bool) error
 	PushBranch(branch string, username string, password string, workingDir string, force bool) error
+	RemoteBranches(username, password, URL string) ([]string, error)
 	RemoteURLs(workingDir string) (map[string]string, error)
 	SanitizeBranchName(branch string) string
+	SetSparseCheckoutDirectories(directories []string)
 	SquashCommit(repoDir, baseBranch, featureBranch string, options SquashCommitOptions) error
 	Tags(workingDir string) (tags []string, err error)
 	TagHashes(workingDir string) (hashes []string, err error)
@@ -62,6 +64,11 @@ type GitHandler interface {
 type GoGit struct {
 	// ForceReset is set to true if the branch has been reset to the latest commit of the based branch.
 	ForceReset bool
+	// sparseCheckoutDirectories defines the paths checked out in the worktree.
+	sparseCheckoutDirectories []string
+	// sparseCheckoutConfigured is set to true once the sparse checkout directories are defined,
+	// otherwise the sparse checkout persisted in the repository is used.
+	sparseCheckoutConfigured bool
 }
 
 /*
@@ -458,12 +465,7 @@ func (g *GoGit) Checkout(username, password, basedBranch, newBranch, gitReposito
 	// Checkout source branch without creating it yet
 	// If newBranch already exist, use it
 	// otherwise use the one define in the spec
-	err = worktree.Checkout(&git.CheckoutOptions{
-		Branch: plumbing.NewBranchReferenceName(newBranch),
-		Create: false,
-		Keep:   false,
-		Force:  true,
-	})
+	err = g.checkout(repository, worktree, newBranch, false)
 
 	switch err {
 	case plumbing.ErrReferenceNotFound:
@@ -472,23 +474,13 @@ func (g *GoGit) Checkout(username, password, basedBranch, newBranch, gitReposito
 		logrus.Debugf("new branch %q doesn't exist, creating it from branch %q", newBranch, basedBranch)
 
 		// First we need to checkout the based branch
-		err = worktree.Checkout(&git.CheckoutOptions{
-			Branch: plumbing.NewBranchReferenceName(basedBranch),
-			Create: false,
-			Keep:   false,
-			Force:  true,
-		})
+		err = g.checkout(repository, worktree, basedBranch, false)
 		if err != nil {
 			return fmt.Errorf("checking out branch %q - %s", basedBranch, err)
 		}
 
 		// Then we create the new branch
-		err = worktree.Checkout(&git.CheckoutOptions{
-			Branch: plumbing.NewBranchReferenceName(newBranch),
-			Create: true,
-			Keep:   false,
-			Force:  true,
-		})
+		err = g.checkout(repository, worktree, newBranch, true)
 		if err != nil {
 			return fmt.Errorf("checking out branch %q - %s", newBranch, err)
 		}
@@ -536,10 +528,14 @@ func (g *GoGit) Checkout(username, password, basedBranch, newBranch, gitReposito
 			return err
 		}
 
+		if err = g.applySparseCheckout(repository); err != nil {
+			return err
+		}
+
 		if forceReset {
 			logrus.Debugf("Checking if branch %q diverged from %q:", newBranch, basedBranch)
 			// If the newBranch diverged from the basedBranch, we need to reset it
-			resetBranch, err := resetNewBranchToBaseBranch(
+			resetBranch, err := g.resetNewBranchToBaseBranch(
 				plumbing.NewBranchReferenceName(newBranch),
 				plumbing.NewBranchReferenceName(basedBranch),
 				gitRepositoryPath,
@@ -601,7 +597,14 @@ func (g GoGit) Commit(user, email, message, workingDir string, signingKey string
 
 	logrus.Debugf("status: %q\n", status)
 
-	if len(signingKey) > 0 {
+	switch {
+	case len(signingKey) > 0 && sign.IsSSHKey(signingKey):
+		signer, err := sign.GetCommitSSHSigner(signingKey, passphrase)
+		if err != nil {
+			return fmt.Errorf("getting commit ssh signer: %w", err)
+		}
+		commitOptions.Signer = signer
+	case len(signingKey) > 0:
 		key, err := sign.GetCommitSignKey(signingKey, passphrase)
 		if err != nil {
 			return fmt.Errorf("getting commit sign key: %w", err)
@@ -659,6 +662,11 @@ func (g GoGit) Clone(username, password, URL, workingDir string, withSubmodules
 		cloneOptions.Auth = &auth
 	}
 
+	// The worktree is populated once the sparse checkout is configured
+	if len(g.sparseCheckoutDirectories) > 0 {
+		cloneOptions.NoCheckout = true
+	}
+
 	fmt.Fprintf(&b, "cloning git repository: %s in %s\n", URL, workingDir)
 	repo, err := git.PlainClone(workingDir, false, &cloneOptions)
 
@@ -667,6 +675,22 @@ func (g GoGit) Clone(username, password, URL, workingDir string, withSubmodules
 	}
 	b.Reset()
 
+	if err == nil && len(g.sparseCheckoutDirectories) > 0 {
+		head, err := repo.Head()
+		if err != nil {
+			return fmt.Errorf("get branch head: %w", err)
+		}
+
+		w, err := repo.Worktree()
+		if err != nil {
+			return fmt.Errorf("opening %q git worktree: %w", workingDir, err)
+		}
+
+		if err = g.checkout(repo, w, head.Name().Short(), false); err != nil {
+			return fmt.Errorf("checking out branch %q: %w", head.Name().Short(), err)
+		}
+	}
+
 	if err == git.ErrRepositoryAlreadyExists {
 
 		logrus.Debugf("repository already exists, trying to pull changes")
@@ -717,6 +741,10 @@ func (g GoGit) Clone(username, password, URL, workingDir string, withSubmodules
 			return fmt.Errorf("pulling: %w", err)
 		}
 
+		if err = g.applySparseCheckout(repo); err != nil {
+			return err
+		}
+
 	} else if err != nil &&
 		err != git.NoErrAlreadyUpToDate {
 		// It's a common error to specify a password without a username
@@ -1180,6 +1208,10 @@ func (g GoGit) Pull(username, password, gitRepositoryPath, branch string, single
 		return fmt.Errorf("pulling remote branch %s", err)
 	}
 
+	if err = g.applySparseCheckout(repository); err != nil {
+		return err
+	}
+
 	ref, err = repository.Head()
 	if err != nil {
 		return fmt.Errorf("get branch head: %s", err)
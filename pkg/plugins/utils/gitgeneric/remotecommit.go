@@ -0,0 +1,115 @@
+package gitgeneric
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// FileActionCreate is used when a changed file doesn't exist in the HEAD commit
+	FileActionCreate = "create"
+	// FileActionUpdate is used when a changed file exists both locally and in the HEAD commit
+	FileActionUpdate = "update"
+	// FileActionDelete is used when a file from the HEAD commit was removed locally
+	FileActionDelete = "delete"
+)
+
+// FileChange describes a local change relative to the HEAD commit,
+// as expected by the different git hosting APIs to create a commit.
+type FileChange struct {
+	// Path is the file path relative to the repository root
+	Path string
+	// Action is one of FileActionCreate, FileActionUpdate, or FileActionDelete
+	Action string
+	// SHA is the blob hash of the file in the HEAD commit, empty for created files
+	SHA string
+}
+
+// GetFileChanges returns the action needed to publish each changed file, files being
+// usually retrieved from GetChangedFiles.
+func GetFileChanges(workingDir string, files []string) ([]FileChange, error) {
+	gitRepository, err := git.PlainOpen(workingDir)
+	if err != nil {
+		return nil, fmt.Errorf("opening %q git directory: %w", workingDir, err)
+	}
+
+	head, err := gitRepository.Head()
+	if err != nil {
+		return nil, fmt.Errorf("getting HEAD: %w", err)
+	}
+
+	commit, err := gitRepository.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("getting HEAD commit: %w", err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("getting HEAD tree: %w", err)
+	}
+
+	changes := make([]FileChange, 0, len(files))
+	for _, f := range files {
+		headSHA := ""
+		headFile, err := tree.File(f)
+		switch {
+		case err == nil:
+			headSHA = headFile.Hash.String()
+		case errors.Is(err, object.ErrFileNotFound):
+		default:
+			return nil, fmt.Errorf("looking for %q in HEAD: %w", f, err)
+		}
+
+		_, err = os.Stat(filepath.Join(workingDir, f))
+		existLocally := err == nil
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("checking %q: %w", f, err)
+		}
+
+		switch {
+		case existLocally && headSHA == "":
+			changes = append(changes, FileChange{Path: f, Action: FileActionCreate})
+		case existLocally:
+			changes = append(changes, FileChange{Path: f, Action: FileActionUpdate, SHA: headSHA})
+		case headSHA != "":
+			changes = append(changes, FileChange{Path: f, Action: FileActionDelete, SHA: headSHA})
+		default:
+			logrus.Debugf("ignoring %q as it exists neither locally nor in HEAD", f)
+		}
+	}
+
+	return changes, nil
+}
+
+// SyncRemoteCommit updates the local branch with a commit created through a git hosting API.
+// As the commit may not be immediately available, the branch is pulled a few times
+// until the local HEAD matches the expected commit.
+func SyncRemoteCommit(g GitHandler, username, password, workingDir, branch string, depth *int, commit string) error {
+	maxRetry := 3
+	for counter := 0; counter < maxRetry; counter++ {
+		if err := g.Pull(username, password, workingDir, branch, true, true, depth); err != nil {
+			return err
+		}
+
+		localCommit, err := g.GetLatestCommitHash(workingDir)
+		if err != nil {
+			return err
+		}
+
+		if localCommit == commit {
+			return nil
+		}
+
+		logrus.Debugf("Latest local commit %q should have been %q, retrying to pull it", localCommit, commit)
+	}
+
+	logrus.Debugf("Giving up trying to pull the newly created commit %q", commit)
+
+	return nil
+}
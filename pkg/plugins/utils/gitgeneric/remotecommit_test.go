@@ -0,0 +1,69 @@
+package gitgeneric
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFileChanges(t *testing.T) {
+	directory := t.TempDir()
+	repository, err := git.PlainInit(directory, false)
+	require.NoError(t, err)
+
+	worktree, err := repository.Worktree()
+	require.NoError(t, err)
+
+	for _, f := range []string{"updated.txt", "deleted.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(directory, f), []byte(f), 0o600))
+		_, err = worktree.Add(f)
+		require.NoError(t, err)
+	}
+
+	_, err = worktree.Commit("initial commit", &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  "Updatecli Test",
+			Email: "test@updatecli.io",
+			When:  time.Now(),
+		},
+	})
+	require.NoError(t, err)
+
+	head, err := repository.Head()
+	require.NoError(t, err)
+	commit, err := repository.CommitObject(head.Hash())
+	require.NoError(t, err)
+	tree, err := commit.Tree()
+	require.NoError(t, err)
+	updated, err := tree.File("updated.txt")
+	require.NoError(t, err)
+	deleted, err := tree.File("deleted.txt")
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "updated.txt"), []byte("new content"), 0o600))
+	require.NoError(t, os.Remove(filepath.Join(directory, "deleted.txt")))
+	require.NoError(t, os.MkdirAll(filepath.Join(directory, "dir"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "dir", "created.txt"), []byte("created"), 0o600))
+
+	files, err := GoGit{}.GetChangedFiles(directory)
+	require.NoError(t, err)
+	files = append(files, "unknown.txt")
+
+	changes, err := GetFileChanges(directory, files)
+	require.NoError(t, err)
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+
+	assert.Equal(t, []FileChange{
+		{Path: "deleted.txt", Action: FileActionDelete, SHA: deleted.Hash.String()},
+		{Path: "dir/created.txt", Action: FileActionCreate},
+		{Path: "updated.txt", Action: FileActionUpdate, SHA: updated.Hash.String()},
+	}, changes)
+}
@@ -0,0 +1,55 @@
+package gitgeneric
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestCommitSSHSigned(t *testing.T) {
+	gitBinary, err := exec.LookPath("git")
+	if err != nil {
+		t.Skip("git not found, skipping commit signature verification")
+	}
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
+	require.NoError(t, err)
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	require.NoError(t, err)
+
+	directory := t.TempDir()
+	_, err = git.PlainInit(directory, false)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "README.md"), []byte("updatecli"), 0o600))
+
+	repository, err := git.PlainOpen(directory)
+	require.NoError(t, err)
+	worktree, err := repository.Worktree()
+	require.NoError(t, err)
+	_, err = worktree.Add("README.md")
+	require.NoError(t, err)
+
+	err = GoGit{}.Commit("Updatecli Test", "test@updatecli.io", "chore: ssh signed commit", directory, string(pem.EncodeToMemory(block)), "")
+	require.NoError(t, err)
+
+	allowedSigners := filepath.Join(t.TempDir(), "allowed_signers")
+	require.NoError(t, os.WriteFile(allowedSigners, []byte("test@updatecli.io "+string(ssh.MarshalAuthorizedKey(sshPublicKey))), 0o600))
+
+	cmd := exec.Command(gitBinary, "-c", "gpg.ssh.allowedSignersFile="+allowedSigners, "verify-commit", "HEAD")
+	cmd.Dir = directory
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+	assert.True(t, strings.Contains(string(output), `Good "git" signature for test@updatecli.io`), string(output))
+}
@@ -0,0 +1,178 @@
+package gitgeneric
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// sparseCheckoutConfigSection is the git config section used to persist the sparse checkout directories
+	sparseCheckoutConfigSection = "updatecli"
+	// sparseCheckoutConfigOption is the git config option used to persist the sparse checkout directories
+	sparseCheckoutConfigOption = "sparseCheckout"
+)
+
+// SetSparseCheckoutDirectories defines the paths checked out in the worktree.
+// An empty list means that the whole repository is checked out.
+func (g *GoGit) SetSparseCheckoutDirectories(directories []string) {
+	g.sparseCheckoutDirectories = directories
+	g.sparseCheckoutConfigured = true
+}
+
+/*
+getSparseCheckoutDirectories returns the sparse checkout directories of a repository.
+
+go-git doesn't persist sparse checkout settings, so the directories configured on the git handler
+are stored in the repository git config. A git handler without sparse checkout configuration,
+like the ones used by the gittag or gitbranch resources, reuses the persisted directories.
+*/
+func (g GoGit) getSparseCheckoutDirectories(repository *git.Repository) ([]string, error) {
+	cfg, err := repository.Config()
+	if err != nil {
+		return nil, fmt.Errorf("reading git config: %w", err)
+	}
+
+	directories := cfg.Raw.Section(sparseCheckoutConfigSection).OptionAll(sparseCheckoutConfigOption)
+
+	if !g.sparseCheckoutConfigured || slices.Equal(directories, g.sparseCheckoutDirectories) {
+		return directories, nil
+	}
+
+	section := cfg.Raw.Section(sparseCheckoutConfigSection)
+	section.RemoveOption(sparseCheckoutConfigOption)
+	for _, directory := range g.sparseCheckoutDirectories {
+		section.AddOption(sparseCheckoutConfigOption, directory)
+	}
+
+	if err := repository.SetConfig(cfg); err != nil {
+		return nil, fmt.Errorf("persisting sparse checkout configuration: %w", err)
+	}
+
+	return g.sparseCheckoutDirectories, nil
+}
+
+/*
+applySparseCheckout aligns the worktree of a repository with its sparse checkout directories.
+
+Git operations such as pull or reset are not aware of the sparse checkout,
+so after each of them we update the skip-worktree flag of the index entries,
+remove the files excluded from the sparse checkout, and restore the ones that were previously excluded.
+*/
+func (g GoGit) applySparseCheckout(repository *git.Repository) error {
+	directories, err := g.getSparseCheckoutDirectories(repository)
+	if err != nil {
+		return err
+	}
+
+	idx, err := repository.Storer.Index()
+	if err != nil {
+		return fmt.Errorf("reading git index: %w", err)
+	}
+
+	var skipped, restored []string
+	for _, entry := range idx.Entries {
+		skip := len(directories) > 0 && !isInSparseCheckout(entry.Name, directories)
+
+		switch {
+		case skip:
+			skipped = append(skipped, entry.Name)
+		case entry.SkipWorktree:
+			restored = append(restored, entry.Name)
+		default:
+			continue
+		}
+
+		entry.SkipWorktree = skip
+	}
+
+	if len(skipped) == 0 && len(restored) == 0 {
+		return nil
+	}
+
+	logrus.Debugf("applying sparse checkout %v", directories)
+
+	if err := repository.Storer.SetIndex(idx); err != nil {
+		return fmt.Errorf("updating git index: %w", err)
+	}
+
+	worktree, err := repository.Worktree()
+	if err != nil {
+		return fmt.Errorf("opening git worktree: %w", err)
+	}
+
+	root := worktree.Filesystem.Root()
+	for _, file := range skipped {
+		err := os.Remove(filepath.Join(root, filepath.FromSlash(file)))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("removing file %q excluded from the sparse checkout: %w", file, err)
+		}
+	}
+
+	if len(restored) == 0 {
+		return nil
+	}
+
+	head, err := repository.Head()
+	if err != nil {
+		return fmt.Errorf("get branch head: %w", err)
+	}
+
+	if err := worktree.Reset(&git.ResetOptions{
+		Commit: head.Hash(),
+		Mode:   git.HardReset,
+		Files:  restored,
+	}); err != nil {
+		return fmt.Errorf("restoring files included in the sparse checkout: %w", err)
+	}
+
+	return nil
+}
+
+// isInSparseCheckout returns true if the file is part of the sparse checkout directories,
+// using the same matching as go-git
+func isInSparseCheckout(file string, directories []string) bool {
+	for _, directory := range directories {
+		if strings.HasPrefix(file, directory) {
+			return true
+		}
+	}
+	return false
+}
+
+// resetWorktree resets the worktree to a commit while preserving the sparse checkout
+func (g GoGit) resetWorktree(repository *git.Repository, worktree *git.Worktree, opts *git.ResetOptions) error {
+	if err := worktree.Reset(opts); err != nil {
+		return err
+	}
+
+	return g.applySparseCheckout(repository)
+}
+
+// checkout runs git checkout on a branch, only populating the sparse checkout directories if any.
+func (g GoGit) checkout(repository *git.Repository, worktree *git.Worktree, branch string, create bool) error {
+	directories, err := g.getSparseCheckoutDirectories(repository)
+	if err != nil {
+		return err
+	}
+
+	err = worktree.Checkout(&git.CheckoutOptions{
+		Branch:                    plumbing.NewBranchReferenceName(branch),
+		Create:                    create,
+		Keep:                      false,
+		Force:                     true,
+		SparseCheckoutDirectories: directories,
+	})
+	if err != nil {
+		return err
+	}
+
+	return g.applySparseCheckout(repository)
+}
@@ -0,0 +1,114 @@
+package gitgeneric
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func commitFiles(t *testing.T, directory string, files map[string]string) {
+	t.Helper()
+
+	repository, err := git.PlainOpen(directory)
+	require.NoError(t, err)
+	worktree, err := repository.Worktree()
+	require.NoError(t, err)
+
+	for file, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(directory, file)), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(directory, file), []byte(content), 0o600))
+		_, err = worktree.Add(file)
+		require.NoError(t, err)
+	}
+
+	_, err = worktree.Commit("update files", &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  "Updatecli Test",
+			Email: "test@updatecli.io",
+			When:  time.Now(),
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestSparseCheckout(t *testing.T) {
+	remote := t.TempDir()
+	_, err := git.PlainInit(remote, false)
+	require.NoError(t, err)
+	commitFiles(t, remote, map[string]string{
+		"README.md":              "readme",
+		"charts/app/values.yaml": "image: 1.0.0",
+		"docs/index.md":          "docs",
+	})
+
+	remoteRepository, err := git.PlainOpen(remote)
+	require.NoError(t, err)
+	head, err := remoteRepository.Head()
+	require.NoError(t, err)
+	branch := head.Name().Short()
+
+	directory := filepath.Join(t.TempDir(), "clone")
+	g := GoGit{}
+	g.SetSparseCheckoutDirectories([]string{"charts/app"})
+
+	require.NoError(t, g.Clone("", "", remote, directory, nil, nil, branch, false))
+
+	assert.FileExists(t, filepath.Join(directory, "charts", "app", "values.yaml"))
+	assert.NoFileExists(t, filepath.Join(directory, "README.md"))
+	assert.NoFileExists(t, filepath.Join(directory, "docs", "index.md"))
+
+	// A git handler without sparse checkout directories, like the one used by resources,
+	// must keep the sparse checkout configuration of the repository
+	other := GoGit{}
+	require.NoError(t, other.Checkout("", "", branch, "updatecli_"+branch, directory, false, nil))
+	assert.NoFileExists(t, filepath.Join(directory, "README.md"))
+
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "charts", "app", "values.yaml"), []byte("image: 2.0.0"), 0o600))
+
+	changedFiles, err := other.GetChangedFiles(directory)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"charts/app/values.yaml"}, changedFiles)
+
+	require.NoError(t, other.Commit("Updatecli Test", "test@updatecli.io", "chore: update", directory, "", ""))
+
+	repository, err := git.PlainOpen(directory)
+	require.NoError(t, err)
+	head, err = repository.Head()
+	require.NoError(t, err)
+	commit, err := repository.CommitObject(head.Hash())
+	require.NoError(t, err)
+	tree, err := commit.Tree()
+	require.NoError(t, err)
+
+	// Files outside of the sparse checkout are not deleted by the commit
+	for _, file := range []string{"README.md", "docs/index.md", "charts/app/values.yaml"} {
+		_, err := tree.File(file)
+		assert.NoError(t, err, file)
+	}
+
+	// Pulling changes made outside of the sparse checkout must not populate the worktree
+	commitFiles(t, remote, map[string]string{"docs/index.md": "new docs"})
+	require.NoError(t, other.Pull("", "", directory, branch, false, true, nil))
+	assert.NoFileExists(t, filepath.Join(directory, "docs", "index.md"))
+
+	// Another scm working on the same directory can use different directories
+	docs := GoGit{}
+	docs.SetSparseCheckoutDirectories([]string{"docs"})
+	require.NoError(t, docs.Checkout("", "", branch, branch, directory, false, nil))
+	assert.FileExists(t, filepath.Join(directory, "docs", "index.md"))
+	assert.NoFileExists(t, filepath.Join(directory, "charts", "app", "values.yaml"))
+
+	// Or the whole repository
+	full := GoGit{}
+	full.SetSparseCheckoutDirectories(nil)
+	require.NoError(t, full.Checkout("", "", branch, branch, directory, false, nil))
+	for _, file := range []string{"README.md", "docs/index.md", "charts/app/values.yaml"} {
+		assert.FileExists(t, filepath.Join(directory, file))
+	}
+}
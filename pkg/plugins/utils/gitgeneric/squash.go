@@ -127,21 +127,36 @@ func (g GoGit) SquashCommit(repoDir, baseBranch, featureBranch string, options S
 	if len(options.SigninKey) > 0 {
 
 		if err := validateGPGKey(options.SigninKey, options.SigninPassphrase); err != nil {
-			return fmt.Errorf("validating GPG key: %w", err)
+			return fmt.Errorf("validating signing key: %w", err)
 		}
 
 		logrus.Debugf("Signing squashed commit with provided key\n")
 
-		// Get the signing key using your existing function
-		signer, err := sign.GetCommitSignKey(options.SigninKey, options.SigninPassphrase)
-		if err != nil {
-			return fmt.Errorf("getting commit sign key: %w", err)
-		}
+		var signedCommit *object.Commit
 
-		// Sign the commit using the new SignCommit function
-		signedCommit, err := sign.SignCommit(newCommit, signer)
-		if err != nil {
-			return fmt.Errorf("failed to sign commit: %w", err)
+		switch sign.IsSSHKey(options.SigninKey) {
+		case true:
+			sshSigner, err := sign.GetCommitSSHSigner(options.SigninKey, options.SigninPassphrase)
+			if err != nil {
+				return fmt.Errorf("getting commit ssh signer: %w", err)
+			}
+
+			signedCommit, err = sign.SSHSignCommit(newCommit, sshSigner)
+			if err != nil {
+				return fmt.Errorf("failed to sign commit: %w", err)
+			}
+		default:
+			// Get the signing key using your existing function
+			signer, err := sign.GetCommitSignKey(options.SigninKey, options.SigninPassphrase)
+			if err != nil {
+				return fmt.Errorf("getting commit sign key: %w", err)
+			}
+
+			// Sign the commit using the new SignCommit function
+			signedCommit, err = sign.SignCommit(newCommit, signer)
+			if err != nil {
+				return fmt.Errorf("failed to sign commit: %w", err)
+			}
 		}
 
 		// Use the signed commit
@@ -176,7 +191,7 @@ func (g GoGit) SquashCommit(repoDir, baseBranch, featureBranch string, options S
 			return fmt.Errorf("failed to get worktree: %w", err)
 		}
 
-		if err := worktree.Reset(&git.ResetOptions{
+		if err := g.resetWorktree(repo, worktree, &git.ResetOptions{
 			Commit: commitHash,
 			Mode:   git.HardReset,
 		}); err != nil {
@@ -326,16 +341,11 @@ func validateSquashOperation(workingDir, baseBranch, featureBranch string) error
 	return nil
 }
 
-// validateGPGKey validates that the GPG key can be used for signing
-func validateGPGKey(armoredKeyRing, passphrase string) error {
-	if armoredKeyRing == "" {
+// validateGPGKey validates that the GPG or SSH key can be used for signing
+func validateGPGKey(signingKey, passphrase string) error {
+	if signingKey == "" {
 		return nil
 	}
 
-	_, err := sign.GetCommitSignKey(armoredKeyRing, passphrase)
-	if err != nil {
-		return fmt.Errorf("failed to load GPG key: %w", err)
-	}
-
-	return nil
+	return sign.ValidateSigningKey(signingKey, passphrase)
 }
@@ -16,6 +16,10 @@ var (
 	ErrNoValidDateFound error = errors.New("no valid date found")
 	// ErrNoValidPep440VersionFound return a error when no valid PEP 440 version could be found
 	ErrNoValidPep440VersionFound error = errors.New("no valid PEP 440 version found")
+	// ErrNoVersionMatchingAge return a error when versions exist but none of them matches the age filter
+	ErrNoVersionMatchingAge error = errors.New("no version matching the age filter")
+	// ErrAgeFilterNotSupported return a error when an age filter is defined for a resource not reporting release dates
+	ErrAgeFilterNotSupported error = errors.New("the versionfilter age isn't supported by this resource")
 )
 
 // ErrNoVersionFoundForPattern returns when a given pattern does not find any version
@@ -5,8 +5,10 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/age"
 
 	sv "github.com/Masterminds/semver/v3"
 )
@@ -69,6 +71,19 @@ type Filter struct {
 	// replaceAll applies a regex replacement to version strings before filtering.
 	// This is useful for transforming versions (e.g., curl-8_15_0 to curl-8.15.0) before regex extraction.
 	ReplaceAll ReplaceAll `yaml:",omitempty"`
+	// age defines the minimum or maximum age of a release to be considered valid.
+	// It accepts a duration string (e.g., "24h", "7d", "3w", "1mo", "1y").
+	//
+	// A minimum age delays the adoption of freshly published versions,
+	// such as a cooldown period for supply-chain security policies.
+	//
+	// remark:
+	//   Only resources reporting a release date for each version support the age filter.
+	//   Versions without a known release date are ignored.
+	//   Some resources use a date set at build time, such as the container image creation date,
+	//   which may be older than the actual release.
+	//   When every version is filtered out by the age filter, the source is skipped.
+	Age age.Spec `yaml:",omitempty"`
 }
 
 // Init returns a new (copy) valid instantiated filter
@@ -119,6 +134,10 @@ func (f Filter) Validate() error {
 		}
 	}
 
+	if err := f.Age.Validate(); err != nil {
+		return fmt.Errorf("invalid age: %w", err)
+	}
+
 	return nil
 }
 
@@ -136,8 +155,100 @@ func (f *Filter) applyReplaceAll(version string) (string, error) {
 	return re.ReplaceAllString(version, f.ReplaceAll.Replacement), nil
 }
 
-// Search returns a value matching pattern
+// Search returns a value matching pattern.
+// It fails if an age filter is defined, as it requires the release dates.
 func (f *Filter) Search(versions []string) (Version, error) {
+	if !f.Age.IsZero() {
+		return Version{}, ErrAgeFilterNotSupported
+	}
+
+	return f.search(versions)
+}
+
+// SearchWithReleaseDates returns a value matching pattern among the versions
+// released inside the age window.
+// releaseDates maps a version, as provided in versions, to its release date.
+// An empty releaseDates means the release dates are unknown, so an age filter can't be applied.
+//
+// When an age filter is defined, the remaining versions are sorted by release date, oldest first,
+// so the version kinds relying on the order of versions, such as latest, find the most recently released one.
+func (f *Filter) SearchWithReleaseDates(versions []string, releaseDates map[string]time.Time) (Version, error) {
+	if f.Age.IsZero() {
+		return f.search(versions)
+	}
+
+	if len(versions) == 0 {
+		return Version{}, ErrNoVersionFound
+	}
+
+	// The resource couldn't retrieve any release date, such as from a registry not exposing them
+	if len(releaseDates) == 0 {
+		return Version{}, ErrAgeFilterNotSupported
+	}
+
+	matchingVersions := f.Age.Filter(versions, releaseDates)
+	if len(matchingVersions) == 0 {
+		return Version{}, ErrNoVersionMatchingAge
+	}
+
+	slices.SortStableFunc(matchingVersions, func(a, b string) int {
+		return releaseDates[a].Compare(releaseDates[b])
+	})
+
+	return f.search(matchingVersions)
+}
+
+// SearchWithReleaseDateFunc returns a value matching pattern among the versions released inside
+// the age window, for resources retrieving the release date one version at a time, such as container registries.
+// The release date is only retrieved for the version found, which is discarded and replaced by the next
+// best one until a version matches the age window.
+func (f *Filter) SearchWithReleaseDateFunc(versions []string, releaseDate func(version string) (time.Time, error)) (Version, error) {
+	if f.Age.IsZero() {
+		return f.search(versions)
+	}
+
+	candidates := slices.Clone(versions)
+	discarded := 0
+
+	for len(candidates) > 0 {
+		found, err := f.search(slices.Clone(candidates))
+		if err != nil {
+			if discarded > 0 {
+				logrus.Debugf("no other version found after discarding %d version(s) not matching the age filter: %s", discarded, err)
+				return Version{}, ErrNoVersionMatchingAge
+			}
+			return Version{}, err
+		}
+
+		date, err := releaseDate(found.OriginalVersion)
+		switch {
+		case err != nil:
+			logrus.Debugf("ignoring version %q because its release date couldn't be retrieved: %s\n", found.OriginalVersion, err)
+		case date.IsZero():
+			logrus.Debugf("ignoring version %q because no release date is known for it\n", found.OriginalVersion)
+		case !f.Age.IsMatching(date, nil):
+			logrus.Debugf("ignoring version %q because its release date %s is outside of the age window (minimum %q, maximum %q)\n",
+				found.OriginalVersion, date, f.Age.Minimum, f.Age.Maximum)
+		default:
+			return found, nil
+		}
+
+		previousLen := len(candidates)
+		candidates = slices.DeleteFunc(candidates, func(v string) bool {
+			return v == found.OriginalVersion
+		})
+		// Should never happen, but we don't want to loop forever on a version we can't discard
+		if len(candidates) == previousLen {
+			return Version{}, fmt.Errorf("discarding version %q not matching the age filter", found.OriginalVersion)
+		}
+		discarded++
+	}
+
+	return Version{}, ErrNoVersionMatchingAge
+}
+
+// search returns a value matching pattern
+func (f *Filter) search(versions []string) (Version, error) {
 	logrus.Infof("Searching for version matching pattern %q", f.Pattern)
 
 	foundVersion := Version{}
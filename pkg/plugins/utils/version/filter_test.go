@@ -3,9 +3,11 @@ package version
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/age"
 )
 
 func TestSearch(t *testing.T) {
@@ -381,6 +383,141 @@ func TestSearch(t *testing.T) {
 	}
 }
 
+func TestSearchWithReleaseDates(t *testing.T) {
+	now := time.Now()
+	releaseDates := map[string]time.Time{
+		"1.0.0": now.Add(-90 * 24 * time.Hour),
+		"1.1.0": now.Add(-20 * 24 * time.Hour),
+		"1.0.1": now.Add(-10 * 24 * time.Hour),
+		"1.2.0": now.Add(-2 * 24 * time.Hour),
+	}
+	versions := []string{"1.0.0", "1.0.1", "1.1.0", "1.2.0"}
+
+	tests := []struct {
+		name     string
+		filter   Filter
+		versions []string
+		want     Version
+		wantErr  error
+	}{
+		{
+			name:     "No age filter",
+			filter:   Filter{Kind: SEMVERVERSIONKIND, Pattern: "*"},
+			versions: versions,
+			want:     Version{ParsedVersion: "1.2.0", OriginalVersion: "1.2.0"},
+		},
+		{
+			name:     "Minimum age with semver",
+			filter:   Filter{Kind: SEMVERVERSIONKIND, Pattern: "*", Age: age.Spec{Minimum: "7d"}},
+			versions: versions,
+			want:     Version{ParsedVersion: "1.1.0", OriginalVersion: "1.1.0"},
+		},
+		{
+			name:     "Minimum age with latest picks the most recently released version",
+			filter:   Filter{Kind: LATESTVERSIONKIND, Pattern: LATESTVERSIONKIND, Age: age.Spec{Minimum: "7d"}},
+			versions: versions,
+			want:     Version{ParsedVersion: "1.0.1", OriginalVersion: "1.0.1"},
+		},
+		{
+			name:     "Age window",
+			filter:   Filter{Kind: SEMVERVERSIONKIND, Pattern: "*", Age: age.Spec{Minimum: "7d", Maximum: "60d"}},
+			versions: []string{"1.0.0", "1.2.0"},
+			wantErr:  ErrNoVersionMatchingAge,
+		},
+		{
+			name:     "Empty versions list",
+			filter:   Filter{Kind: SEMVERVERSIONKIND, Pattern: "*", Age: age.Spec{Minimum: "7d"}},
+			versions: []string{},
+			wantErr:  ErrNoVersionFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.filter.SearchWithReleaseDates(tt.versions, releaseDates)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSearchWithReleaseDateFunc(t *testing.T) {
+	now := time.Now()
+	releaseDates := map[string]time.Time{
+		"1.0.0": now.Add(-90 * 24 * time.Hour),
+		"1.1.0": now.Add(-20 * 24 * time.Hour),
+		"1.2.0": now.Add(-2 * 24 * time.Hour),
+	}
+	versions := []string{"1.0.0", "1.1.0", "1.2.0", "1.3.0"}
+
+	tests := []struct {
+		name              string
+		filter            Filter
+		want              Version
+		wantErr           error
+		wantLookedUpDates []string
+	}{
+		{
+			name:   "No age filter",
+			filter: Filter{Kind: SEMVERVERSIONKIND, Pattern: "*"},
+			want:   Version{ParsedVersion: "1.3.0", OriginalVersion: "1.3.0"},
+		},
+		{
+			name:              "Minimum age discards the versions until one matches",
+			filter:            Filter{Kind: SEMVERVERSIONKIND, Pattern: "*", Age: age.Spec{Minimum: "7d"}},
+			want:              Version{ParsedVersion: "1.1.0", OriginalVersion: "1.1.0"},
+			wantLookedUpDates: []string{"1.3.0", "1.2.0", "1.1.0"},
+		},
+		{
+			name:              "Every version cooling down",
+			filter:            Filter{Kind: SEMVERVERSIONKIND, Pattern: "~1.2", Age: age.Spec{Minimum: "7d"}},
+			wantErr:           ErrNoVersionMatchingAge,
+			wantLookedUpDates: []string{"1.2.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lookedUpDates []string
+			got, err := tt.filter.SearchWithReleaseDateFunc(versions, func(v string) (time.Time, error) {
+				lookedUpDates = append(lookedUpDates, v)
+				if d, ok := releaseDates[v]; ok {
+					return d, nil
+				}
+				return time.Time{}, errors.New("not found")
+			})
+
+			assert.Equal(t, tt.wantLookedUpDates, lookedUpDates)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSearchWithAgeNotSupported(t *testing.T) {
+	filter := Filter{Kind: SEMVERVERSIONKIND, Pattern: "*", Age: age.Spec{Minimum: "7d"}}
+
+	_, err := filter.Search([]string{"1.0.0"})
+	assert.ErrorIs(t, err, ErrAgeFilterNotSupported)
+
+	_, err = filter.SearchWithReleaseDates([]string{"1.0.0"}, nil)
+	assert.ErrorIs(t, err, ErrAgeFilterNotSupported)
+}
+
 func TestValidate(t *testing.T) {
 	tests := []struct {
 		name    string